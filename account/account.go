@@ -20,6 +20,7 @@ type accountData struct {
 	Number   int            `cbor:"2,keyasint"`
 	Sequence int            `cbor:"3,keyasint"`
 	Balance  int64          `cbor:"4,keyasint"`
+	Vestings []Vesting      `cbor:"5,keyasint,omitempty"`
 }
 
 // NewAccount constructs a new account object
@@ -36,6 +37,24 @@ func (acc Account) Address() crypto.Address { return acc.data.Address }
 func (acc Account) Number() int             { return acc.data.Number }
 func (acc Account) Sequence() int           { return acc.data.Sequence }
 func (acc Account) Balance() int64          { return acc.data.Balance }
+func (acc Account) Vestings() []Vesting     { return acc.data.Vestings }
+
+// LockedBalance returns the part of the balance that is still locked at the given height
+func (acc Account) LockedBalance(height int) int64 {
+	locked := int64(0)
+	for _, v := range acc.data.Vestings {
+		locked += v.Locked(height)
+	}
+	if locked > acc.data.Balance {
+		return acc.data.Balance
+	}
+	return locked
+}
+
+// SpendableBalance returns the part of the balance that can be spent at the given height
+func (acc Account) SpendableBalance(height int) int64 {
+	return acc.data.Balance - acc.LockedBalance(height)
+}
 
 func (acc *Account) SubtractFromBalance(amt int64) {
 	acc.data.Balance -= amt
@@ -45,6 +64,38 @@ func (acc *Account) AddToBalance(amt int64) {
 	acc.data.Balance += amt
 }
 
+// AddVesting locks a part of the balance with the vesting schedule.
+// Vestings that are fully unlocked at the given height are removed and
+// a vesting with the same schedule as an existing one is merged into it.
+// The account is not changed if it returns an error.
+func (acc *Account) AddVesting(v Vesting, height int) error {
+	if v.Amount < MinVestingAmount {
+		return fmt.Errorf("vesting amount is less than %v", MinVestingAmount)
+	}
+	vestings := make([]Vesting, 0, len(acc.data.Vestings)+1)
+	merged := false
+	for _, old := range acc.data.Vestings {
+		if old.Locked(height) == 0 {
+			continue
+		}
+		if old.StartHeight == v.StartHeight &&
+			old.CliffHeight == v.CliffHeight &&
+			old.EndHeight == v.EndHeight {
+			old.Amount += v.Amount
+			merged = true
+		}
+		vestings = append(vestings, old)
+	}
+	if !merged {
+		if len(vestings) >= MaxVestings {
+			return fmt.Errorf("account has reached the maximum number of vestings: %v", MaxVestings)
+		}
+		vestings = append(vestings, v)
+	}
+	acc.data.Vestings = vestings
+	return nil
+}
+
 func (acc *Account) IncSequence() {
 	acc.data.Sequence++
 }
@@ -65,6 +116,14 @@ func (acc *Account) Decode(bs []byte) error {
 	return cbor.Unmarshal(bs, &acc.data)
 }
 
+func (acc *Account) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(acc.data)
+}
+
+func (acc *Account) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &acc.data)
+}
+
 func (acc Account) MarshalJSON() ([]byte, error) {
 	return json.Marshal(acc.data)
 }
@@ -74,3 +74,81 @@ func TestSubtractBalance(t *testing.T) {
 	assert.Equal(t, acc.Balance(), bal-1)
 
 }
+
+func TestVesting(t *testing.T) {
+	linear := Vesting{Amount: 1000, StartHeight: 100, CliffHeight: 150, EndHeight: 200}
+	assert.Equal(t, linear.Unlocked(0), int64(0))
+	assert.Equal(t, linear.Unlocked(149), int64(0))
+	assert.Equal(t, linear.Unlocked(150), int64(500))
+	assert.Equal(t, linear.Unlocked(175), int64(750))
+	assert.Equal(t, linear.Unlocked(200), int64(1000))
+	assert.Equal(t, linear.Locked(175), int64(250))
+
+	cliff := Vesting{Amount: 1000, StartHeight: 100, CliffHeight: 200, EndHeight: 200}
+	assert.Equal(t, cliff.Unlocked(199), int64(0))
+	assert.Equal(t, cliff.Unlocked(200), int64(1000))
+
+	large := Vesting{Amount: 21 * 1e14, StartHeight: 0, CliffHeight: 0, EndHeight: 10000000}
+	assert.Equal(t, large.Unlocked(5000000), int64(21*1e14/2))
+
+	// Large amount over a long span shouldn't overflow
+	long := Vesting{Amount: 21*1e14 - 1, StartHeight: 0, CliffHeight: 0, EndHeight: 1e10}
+	assert.Equal(t, long.Unlocked(9e9), int64(1889999999999999))
+	assert.Equal(t, long.Locked(9e9), long.Amount-1889999999999999)
+	for _, h := range []int{1, 1e9, 5e9, 1e10 - 1} {
+		assert.GreaterOrEqual(t, long.Unlocked(h), int64(0))
+		assert.LessOrEqual(t, long.Unlocked(h), long.Amount)
+	}
+
+	acc, _ := GenerateTestAccount(util.RandInt(10000))
+	balance := acc.Balance()
+	linear.Amount = MinVestingAmount
+	cliff.Amount = MinVestingAmount
+	acc.AddToBalance(2 * MinVestingAmount)
+	assert.NoError(t, acc.AddVesting(linear, 100))
+	assert.NoError(t, acc.AddVesting(cliff, 100))
+	assert.Equal(t, acc.LockedBalance(175), MinVestingAmount+MinVestingAmount/4)
+	assert.Equal(t, acc.SpendableBalance(175), balance+MinVestingAmount*3/4)
+	assert.Equal(t, acc.SpendableBalance(200), balance+2*MinVestingAmount)
+
+	// Unlocked vestings should be removed
+	assert.NoError(t, acc.AddVesting(cliff, 200))
+	assert.Equal(t, len(acc.Vestings()), 1)
+	acc2 := new(Account)
+	bs, _ := acc.Encode()
+	require.NoError(t, acc2.Decode(bs))
+	assert.Equal(t, acc, acc2)
+}
+
+func TestAddVesting(t *testing.T) {
+	acc, _ := GenerateTestAccount(util.RandInt(10000))
+
+	t.Run("Should fail, amount is too low", func(t *testing.T) {
+		v := Vesting{Amount: MinVestingAmount - 1, StartHeight: 100, CliffHeight: 100, EndHeight: 200}
+		assert.Error(t, acc.AddVesting(v, 100))
+		assert.Empty(t, acc.Vestings())
+	})
+
+	t.Run("Vestings with the same schedule should be merged", func(t *testing.T) {
+		v := Vesting{Amount: MinVestingAmount, StartHeight: 100, CliffHeight: 100, EndHeight: 200}
+		assert.NoError(t, acc.AddVesting(v, 100))
+		assert.NoError(t, acc.AddVesting(v, 100))
+		assert.Equal(t, len(acc.Vestings()), 1)
+		assert.Equal(t, acc.Vestings()[0].Amount, 2*MinVestingAmount)
+	})
+
+	t.Run("Should fail, too many vestings", func(t *testing.T) {
+		for i := 1; i < MaxVestings; i++ {
+			v := Vesting{Amount: MinVestingAmount, StartHeight: 100, CliffHeight: 100, EndHeight: 200 + i}
+			assert.NoError(t, acc.AddVesting(v, 100))
+		}
+		v := Vesting{Amount: MinVestingAmount, StartHeight: 100, CliffHeight: 100, EndHeight: 1000}
+		assert.Error(t, acc.AddVesting(v, 100))
+		assert.Equal(t, len(acc.Vestings()), MaxVestings)
+
+		// Merging is still possible
+		v.EndHeight = 200
+		assert.NoError(t, acc.AddVesting(v, 100))
+		assert.Equal(t, len(acc.Vestings()), MaxVestings)
+	})
+}
@@ -0,0 +1,44 @@
+package account
+
+import "math/bits"
+
+const (
+	// MaxVestings is the maximum number of vestings that an account can have at the same time
+	MaxVestings = 16
+	// MinVestingAmount is the minimum amount that can be locked by a vesting
+	MinVestingAmount = int64(1e8)
+)
+
+// Vesting defines the schedule of a locked amount.
+// Nothing is unlocked before the cliff height. After the cliff, the amount is unlocked
+// linearly from the start height until the end height, when it is fully unlocked.
+// A vesting with the same cliff and end height unlocks all at once.
+type Vesting struct {
+	Amount      int64 `cbor:"1,keyasint"`
+	StartHeight int   `cbor:"2,keyasint"`
+	CliffHeight int   `cbor:"3,keyasint"`
+	EndHeight   int   `cbor:"4,keyasint"`
+}
+
+// Unlocked returns the unlocked amount at the given height
+func (v Vesting) Unlocked(height int) int64 {
+	if height >= v.EndHeight {
+		return v.Amount
+	}
+	if height < v.CliffHeight || height <= v.StartHeight || v.Amount <= 0 {
+		return 0
+	}
+	// Here: StartHeight < height < EndHeight.
+	// The product is computed in 128 bits to avoid overflow,
+	// and the quotient fits in 64 bits because it is less than the amount.
+	d := uint64(v.EndHeight - v.StartHeight)
+	e := uint64(height - v.StartHeight)
+	hi, lo := bits.Mul64(uint64(v.Amount), e)
+	unlocked, _ := bits.Div64(hi, lo, d)
+	return int64(unlocked)
+}
+
+// Locked returns the locked amount at the given height
+func (v Vesting) Locked(height int) int64 {
+	return v.Amount - v.Unlocked(height)
+}
@@ -28,7 +28,7 @@ type blockData struct {
 	TxIDs           TxIDs        `cbor:"3,keyasint"`
 }
 
-func MakeBlock(version int, timestamp time.Time, txIDs TxIDs,
+func MakeBlock(version, protocolVersion int, timestamp time.Time, txIDs TxIDs,
 	prevBlockHash, stateHash hash.Hash,
 	prevCertificate *Certificate, sortitionSeed sortition.VerifiableSeed, proposer crypto.Address) *Block {
 	txIDsHash := txIDs.Hash()
@@ -36,7 +36,7 @@ func MakeBlock(version int, timestamp time.Time, txIDs TxIDs,
 	if prevCertificate != nil {
 		prevCertHash = prevCertificate.Hash()
 	}
-	header := NewHeader(version, timestamp,
+	header := NewHeader(version, protocolVersion, timestamp,
 		txIDsHash, prevBlockHash, stateHash, prevCertHash, sortitionSeed, proposer)
 
 	b := &Block{
@@ -53,6 +53,18 @@ func MakeBlock(version int, timestamp time.Time, txIDs TxIDs,
 	return b
 }
 
+// NewBlock assembles a block from its parts, like a block that is relayed in compact form.
+// Unlike MakeBlock it doesn't panic, the caller should check the block by SanityCheck.
+func NewBlock(header Header, prevCertificate *Certificate, txIDs TxIDs) *Block {
+	return &Block{
+		data: blockData{
+			Header:          header,
+			PrevCertificate: prevCertificate,
+			TxIDs:           txIDs,
+		},
+	}
+}
+
 func (b *Block) Header() *Header               { return &b.data.Header }
 func (b *Block) PrevCertificate() *Certificate { return b.data.PrevCertificate }
 func (b *Block) TxIDs() TxIDs                  { return b.data.TxIDs }
@@ -168,7 +180,7 @@ func GenerateTestBlock(proposer *crypto.Address, prevBlockHash *hash.Hash) (*Blo
 		cert = nil
 	}
 	sortitionSeed := sortition.GenerateRandomSeed()
-	block := MakeBlock(1, util.Now(), ids,
+	block := MakeBlock(1, 1, util.Now(), ids,
 		*prevBlockHash,
 		hash.GenerateTestHash(),
 		cert,
@@ -47,6 +47,18 @@ func TestBlockSanityCheck(t *testing.T) {
 	assert.Error(t, b.SanityCheck())
 }
 
+func TestNewBlock(t *testing.T) {
+	b1, _ := GenerateTestBlock(nil, nil)
+	b2 := NewBlock(*b1.Header(), b1.PrevCertificate(), b1.TxIDs())
+	assert.NoError(t, b2.SanityCheck())
+	assert.Equal(t, b1.Hash(), b2.Hash())
+
+	ids := NewTxIDs()
+	ids.Append(hash.GenerateTestHash())
+	b3 := NewBlock(*b1.Header(), b1.PrevCertificate(), ids)
+	assert.Error(t, b3.SanityCheck())
+}
+
 func TestMarshaling(t *testing.T) {
 	b1, _ := GenerateTestBlock(nil, nil)
 
@@ -62,6 +74,7 @@ func TestMarshaling(t *testing.T) {
 	assert.Equal(t, b1.Header().Time(), b2.Header().Time())
 	assert.Equal(t, b1.Header().Version(), b2.Header().Version())
 	assert.Equal(t, b2.Header().Version(), 1)
+	assert.Equal(t, b2.Header().ProtocolVersion(), 1)
 }
 
 func TestJSONMarshaling(t *testing.T) {
@@ -131,7 +144,7 @@ func TestSanityCheck(t *testing.T) {
 			}
 		}()
 
-		MakeBlock(1, util.Now(), tmp.TxIDs(),
+		MakeBlock(1, 1, util.Now(), tmp.TxIDs(),
 			tmp.Header().PrevBlockHash(),
 			tmp.Header().StateHash(),
 			nil,
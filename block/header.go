@@ -24,6 +24,7 @@ type headerData struct {
 	PrevCertificateHash hash.Hash                `cbor:"6,keyasint"`
 	SortitionSeed       sortition.VerifiableSeed `cbor:"7,keyasint"`
 	ProposerAddress     crypto.Address           `cbor:"8,keyasint"`
+	ProtocolVersion     int                      `cbor:"9,keyasint,omitempty"`
 }
 
 func (h *Header) Version() int                            { return h.data.Version }
@@ -35,7 +36,11 @@ func (h *Header) PrevCertificateHash() hash.Hash          { return h.data.PrevCe
 func (h *Header) SortitionSeed() sortition.VerifiableSeed { return h.data.SortitionSeed }
 func (h *Header) ProposerAddress() crypto.Address         { return h.data.ProposerAddress }
 
-func NewHeader(version int,
+// ProtocolVersion returns the latest protocol version that the proposer supports.
+// Validators signal their readiness for protocol upgrades by this version.
+func (h *Header) ProtocolVersion() int { return h.data.ProtocolVersion }
+
+func NewHeader(version, protocolVersion int,
 	time time.Time,
 	txIDsHash, prevBlockHash, stateHash, prevCertificateHash hash.Hash,
 	sortitionSeed sortition.VerifiableSeed, proposerAddress crypto.Address) Header {
@@ -50,6 +55,7 @@ func NewHeader(version int,
 			PrevCertificateHash: prevCertificateHash,
 			ProposerAddress:     proposerAddress,
 			SortitionSeed:       sortitionSeed,
+			ProtocolVersion:     protocolVersion,
 		},
 	}
 }
@@ -41,6 +41,18 @@ func (txs TxIDs) Hash() hash.Hash {
 	return merkle.Root()
 }
 
+// Proof returns the merkle proof of the inclusion of the transaction in the TxIDs hash.
+// It returns nil if the transaction is not in the list.
+func (txs TxIDs) Proof(id tx.ID) *simplemerkle.Proof {
+	for i, txID := range txs.data.IDs {
+		if txID.EqualsTo(id) {
+			merkle := simplemerkle.NewTreeFromHashes(txs.data.IDs)
+			return merkle.Proof(i)
+		}
+	}
+	return nil
+}
+
 func (txs TxIDs) IDs() []tx.ID {
 	return txs.data.IDs
 }
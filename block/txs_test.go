@@ -33,3 +33,15 @@ func TestAppendAndPrepend(t *testing.T) {
 
 	assert.Equal(t, ids.data.IDs, []hash.Hash{h1, h2, h3, h4})
 }
+
+func TestTxsProof(t *testing.T) {
+	b, txs := GenerateTestBlock(nil, nil)
+
+	for _, trx := range txs {
+		proof := b.TxIDs().Proof(trx.ID())
+		assert.NotNil(t, proof)
+		assert.True(t, proof.Verify(trx.ID(), b.Header().TxIDsHash()))
+	}
+
+	assert.Nil(t, b.TxIDs().Proof(hash.GenerateTestHash()))
+}
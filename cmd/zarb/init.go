@@ -98,7 +98,9 @@ func makeLocalGenesis(pub *bls.PublicKey) *genesis.Genesis {
 	val := validator.NewValidator(pub, 0)
 	vals := []*validator.Validator{val}
 
-	// create genesis
-	gen := genesis.MakeGenesis(util.RoundNow(60), accs, vals, param.DefaultParams())
+	// create genesis, the latest protocol version is activated from the first block
+	params := param.DefaultParams()
+	upgrade := param.Upgrade{Version: param.ProtocolVersion, Height: 1, BlockVersion: params.BlockVersion, TxVersion: 1}
+	gen := genesis.MakeGenesis(util.RoundNow(60), accs, vals, params, upgrade)
 	return gen
 }
@@ -0,0 +1,159 @@
+package key
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/zarbchain/zarb-go/cmd"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/tx"
+	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
+)
+
+// Multisig prints the address of a multi-signature account
+func Multisig() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		thresholdOpt := c.Int(cli.IntOpt{
+			Name: "t threshold",
+			Desc: "Minimum number of signatures to sign a transaction",
+		})
+		publicKeysArg := c.Strings(cli.StringsArg{
+			Name: "PUBLICKEY",
+			Desc: "Public keys of the owners",
+		})
+
+		c.Spec = "[-t=<threshold>] PUBLICKEY..."
+		c.Before = func() { fmt.Println(cmd.ZARB) }
+		c.Action = func() {
+			ms, err := makeMultisig(*thresholdOpt, *publicKeysArg)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+
+			cmd.PrintLine()
+			cmd.PrintInfoMsg("Threshold: %v of %v", ms.Threshold(), len(ms.PublicKeys()))
+			cmd.PrintInfoMsg("Address: %s", ms.Address())
+		}
+	}
+}
+
+// Combine aggregates the partial signatures of a multi-signature transaction
+func Combine() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		thresholdOpt := c.Int(cli.IntOpt{
+			Name: "t threshold",
+			Desc: "Minimum number of signatures to sign a transaction",
+		})
+		transactionOpt := c.String(cli.StringOpt{
+			Name: "tx",
+			Desc: "Raw transaction to sign",
+		})
+		signaturesOpt := c.Strings(cli.StringsOpt{
+			Name: "s sig",
+			Desc: "Partial signatures in <PUBLICKEY>:<SIGNATURE> format",
+		})
+		grpcOpt := c.String(cli.StringOpt{
+			Name: "e endpoint",
+			Desc: "gRPC server address to publish the transaction",
+		})
+		publicKeysArg := c.Strings(cli.StringsArg{
+			Name: "PUBLICKEY",
+			Desc: "Public keys of the owners",
+		})
+
+		c.Spec = "[-t=<threshold>] [--tx=<raw tx>] [-s=<partial signature>]... [-e=<endpoint>] PUBLICKEY..."
+		c.Before = func() { fmt.Println(cmd.ZARB) }
+		c.Action = func() {
+			ms, err := makeMultisig(*thresholdOpt, *publicKeysArg)
+			if err != nil {
+				cmd.PrintErrorMsg("%v", err)
+				return
+			}
+
+			if *transactionOpt == "" {
+				cmd.PrintWarnMsg("Please specify the raw transaction.")
+				c.PrintHelp()
+				return
+			}
+			bz, err := hex.DecodeString(*transactionOpt)
+			if err != nil {
+				cmd.PrintErrorMsg("Invalid input: %v", err)
+				return
+			}
+			trx := new(tx.Tx)
+			if err := trx.Decode(bz); err != nil {
+				cmd.PrintErrorMsg("Invalid transaction: %v", err)
+				return
+			}
+
+			pubs := make([]*bls.PublicKey, 0, len(*signaturesOpt))
+			sigs := make([]*bls.Signature, 0, len(*signaturesOpt))
+			for _, s := range *signaturesOpt {
+				parts := strings.Split(s, ":")
+				if len(parts) != 2 {
+					cmd.PrintErrorMsg("Invalid partial signature: %s", s)
+					return
+				}
+				pub, err := bls.PublicKeyFromString(parts[0])
+				if err != nil {
+					cmd.PrintErrorMsg("Invalid public key: %v", err)
+					return
+				}
+				sig, err := bls.SignatureFromString(parts[1])
+				if err != nil {
+					cmd.PrintErrorMsg("Invalid signature: %v", err)
+					return
+				}
+				if !pub.Verify(trx.SignBytes(), sig) {
+					cmd.PrintErrorMsg("Partial signature of %s is not valid", pub)
+					return
+				}
+				pubs = append(pubs, pub)
+				sigs = append(sigs, sig.(*bls.Signature))
+			}
+
+			signers, aggSig, err := ms.Aggregate(pubs, sigs)
+			if err != nil {
+				cmd.PrintErrorMsg("Unable to combine the signatures: %v", err)
+				return
+			}
+			trx.SetMultisig(ms, signers, aggSig)
+			if err := trx.SanityCheck(); err != nil {
+				cmd.PrintErrorMsg("Invalid transaction: %v", err)
+				return
+			}
+
+			signedTrx, _ := trx.Encode()
+			fmt.Println()
+			cmd.PrintInfoMsg("Signed raw transaction:\n%x", signedTrx)
+
+			if *grpcOpt != "" {
+				confirm := cmd.PromptInput("This operation is \"not reversible\". Are you sure [yes/no]? ")
+				if !strings.HasPrefix(strings.ToLower(confirm), "yes") {
+					cmd.PrintWarnMsg("Opration aborted!")
+					return
+				}
+				if id, err := grpcclient.SendTx(*grpcOpt, signedTrx); err != nil {
+					cmd.PrintErrorMsg("Couldn't publish transaction: %v", err)
+				} else {
+					cmd.PrintSuccessMsg("Transaction sent with ID: %v", id)
+				}
+			}
+		}
+	}
+}
+
+func makeMultisig(threshold int, publicKeys []string) (*bls.Multisig, error) {
+	pubs := make([]*bls.PublicKey, len(publicKeys))
+	for i, s := range publicKeys {
+		pub, err := bls.PublicKeyFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %v", s, err)
+		}
+		pubs[i] = pub
+	}
+	return bls.NewMultisig(threshold, pubs)
+}
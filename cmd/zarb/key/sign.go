@@ -26,6 +26,11 @@ func Sign() func(c *cli.Cmd) {
 			Name: "t tx",
 			Desc: "Raw transaction to sign",
 		})
+		partialOpt := c.Bool(cli.BoolOpt{
+			Name:  "p partial",
+			Desc:  "Create a partial signature for a multisig transaction",
+			Value: false,
+		})
 		keyFileOpt := c.String(cli.StringOpt{
 			Name: "k keyfile",
 			Desc: "Path to the encrypted key file",
@@ -86,7 +91,13 @@ func Sign() func(c *cli.Cmd) {
 				return
 			}
 
-			if trx != nil {
+			if trx != nil && *partialOpt {
+				signer := key.ToSigner()
+				signature := signer.SignData(trx.SignBytes())
+
+				fmt.Println()
+				cmd.PrintInfoMsg("Partial signature: %s:%s", signer.PublicKey(), signature)
+			} else if trx != nil {
 				key.ToSigner().SignMsg(trx)
 				bz, _ := trx.Encode()
 
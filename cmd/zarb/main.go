@@ -20,12 +20,18 @@ func zarb() *cli.Cli {
 		k.Command("sign", "Sign a transaction or message with a key file", key.Sign())
 		k.Command("verify", "Verify a signature", key.Verify())
 		k.Command("change-auth", "Change the passphrase of a keyfile", key.ChangeAuth())
+		k.Command("multisig", "Print the address of a multisig account", key.Multisig())
+		k.Command("combine", "Combine the partial signatures of a multisig transaction", key.Combine())
 	})
 	app.Command("tx", "Create, sign and publish a transaction", func(k *cli.Cmd) {
 		k.Command("bond", "Create, sign and publish a bond transaction", tx.BondTx())
 		k.Command("send", "Create, sign and publish a send transactio", tx.SendTx())
+		k.Command("batch-send", "Create, sign and publish a batch send transaction", tx.BatchSendTx())
 		k.Command("unbond", "Create, sign and publish an unbond transaction", tx.UnbondTx())
 		k.Command("withdraw", "Create, sign and publish a withdraw transaction", tx.WithdrawTx())
+		k.Command("unjail", "Create, sign and publish an unjail transaction", tx.UnjailTx())
+		k.Command("proposal", "Create, sign and publish a parameter proposal transaction", tx.ProposalTx())
+		k.Command("proposal-vote", "Create, sign and publish a proposal vote transaction", tx.ProposalVoteTx())
 	})
 	app.Command("version", "Print the zarb version", Version())
 	return app
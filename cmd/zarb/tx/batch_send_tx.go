@@ -0,0 +1,195 @@
+package tx
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/zarbchain/zarb-go/cmd"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
+)
+
+func BatchSendTx() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		stampOpt := c.String(cli.StringOpt{
+			Name: "stamp",
+			Desc: "Transaction stamp if not specified will query from RPC server",
+		})
+
+		seqOpt := c.Int(cli.IntOpt{
+			Name: "seq",
+			Desc: "Transaction sequence number if not specified will query from RPC server",
+		})
+
+		senderOpt := c.String(cli.StringOpt{
+			Name: "sender",
+			Desc: "Sender address",
+		})
+
+		fileOpt := c.String(cli.StringOpt{
+			Name: "f file",
+			Desc: "Path to a CSV file, each line contains a receiver address and an amount",
+		})
+
+		feeOpt := c.Int(cli.IntOpt{
+			Name: "fee",
+			Desc: "Transaction fee",
+		})
+
+		memoOpt := c.String(cli.StringOpt{
+			Name:  "memo",
+			Desc:  "Transaction memo (Optional)",
+			Value: "",
+		})
+
+		authOpt := c.String(cli.StringOpt{
+			Name: "a auth",
+			Desc: "Passphrase of the key file",
+		})
+		keyFileOpt := c.String(cli.StringOpt{
+			Name: "k keyfile",
+			Desc: "Path to the encrypted key file",
+		})
+
+		grpcOpt := c.String(cli.StringOpt{
+			Name: "e endpoint",
+			Desc: "gRPC server address if not specified will just print raw signed transaction",
+		})
+		c.Before = func() { fmt.Println(cmd.ZARB) }
+		c.Action = func() {
+
+			var err error
+			var stamp hash.Stamp
+			var sender crypto.Address
+			var receivers []payload.BatchReceiver
+			var seq int
+			var fee int64
+			var auth string
+
+			// ---
+			if *fileOpt == "" {
+				cmd.PrintWarnMsg("Receivers file is not defined.")
+				c.PrintHelp()
+				return
+			}
+			receivers, err = readReceivers(*fileOpt)
+			if err != nil {
+				cmd.PrintErrorMsg("Couldn't read receivers: %v", err)
+				return
+			}
+
+			if *feeOpt == 0 {
+				cmd.PrintWarnMsg("Fee is not defined.")
+				c.PrintHelp()
+				return
+			}
+			fee = int64(*feeOpt)
+
+			if *senderOpt == "" {
+				cmd.PrintWarnMsg("Sender address is not defined.")
+				c.PrintHelp()
+				return
+			}
+			sender, err = crypto.AddressFromString(*senderOpt)
+			if err != nil {
+				cmd.PrintErrorMsg("Sender address is not valid: %v", err)
+				return
+			}
+
+			//sign transaction
+			if *keyFileOpt == "" {
+				cmd.PrintWarnMsg("Please specify a key file to sign.")
+				c.PrintHelp()
+				return
+			}
+
+			if *authOpt == "" {
+				auth = cmd.PromptPassphrase("Passphrase: ", false)
+			} else {
+				auth = *authOpt
+			}
+
+			//RPC
+			if seqOpt != nil {
+				seq = *seqOpt
+			} else {
+				seq, err = grpcclient.GetSequence(promptRPCEndpoint(grpcOpt), sender)
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't retrieve sequence number from RPC Server: %v", err)
+					return
+				}
+			}
+
+			if stampOpt == nil || *stampOpt == "" {
+				stamp, err = grpcclient.GetStamp(promptRPCEndpoint(grpcOpt))
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't retrieve stamp from RPC Server: %v", err)
+					return
+				}
+			} else {
+				stamp, err = hash.StampFromString(*stampOpt)
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't decode stamp from input: %v", err)
+					return
+				}
+			}
+
+			//fulfill transaction payload
+			trx := tx.NewBatchSendTx(stamp, seq, sender, receivers, fee, *memoOpt)
+
+			//sign transaction
+			signAndPublish(trx, *keyFileOpt, auth, grpcOpt)
+		}
+	}
+}
+
+// readReceivers reads the receivers from a CSV file.
+// Each record contains the receiver address and the amount to be transferred.
+func readReceivers(path string) ([]payload.BatchReceiver, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	receivers := []payload.BatchReceiver{}
+	for i := 1; ; i++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		addr, err := crypto.AddressFromString(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("record %d: invalid address: %v", i, err)
+		}
+		amount, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: invalid amount: %v", i, err)
+		}
+		receivers = append(receivers, payload.BatchReceiver{
+			Address: addr,
+			Amount:  amount,
+		})
+	}
+	if len(receivers) == 0 {
+		return nil, fmt.Errorf("no receiver is defined")
+	}
+
+	return receivers, nil
+}
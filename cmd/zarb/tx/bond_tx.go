@@ -9,6 +9,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
 )
 
@@ -44,6 +45,11 @@ func BondTx() func(c *cli.Cmd) {
 			Desc: "Transaction fee",
 		})
 
+		commissionOpt := c.Int(cli.IntOpt{
+			Name: "commission",
+			Desc: "Percentage of the delegators' reward that the validator takes. It can only be set by the first bond",
+		})
+
 		memoOpt := c.String(cli.StringOpt{
 			Name:  "memo",
 			Desc:  "Transaction memo",
@@ -148,7 +154,14 @@ func BondTx() func(c *cli.Cmd) {
 				}
 			}
 
+			if *commissionOpt < 0 || *commissionOpt > 100 {
+				cmd.PrintErrorMsg("Commission should be between 0 and 100")
+				return
+			}
+
 			trx := tx.NewBondTx(stamp, seq, bonder, pub, stake, fee, *memoOpt)
+			// The commission is in basis points
+			trx.Payload().(*payload.BondPayload).Commission = *commissionOpt * 100
 
 			signAndPublish(trx, *keyFileOpt, auth, grpcOpt)
 		}
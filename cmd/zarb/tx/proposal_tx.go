@@ -0,0 +1,174 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/zarbchain/zarb-go/cmd"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
+)
+
+func ProposalTx() func(c *cli.Cmd) {
+	return func(c *cli.Cmd) {
+		stampOpt := c.String(cli.StringOpt{
+			Name: "stamp",
+			Desc: "Transaction stamp",
+		})
+
+		seqOpt := c.Int(cli.IntOpt{
+			Name: "seq",
+			Desc: "Transaction sequence number",
+		})
+
+		valOpt := c.String(cli.StringOpt{
+			Name: "val",
+			Desc: "Proposer validator's address",
+		})
+
+		fileOpt := c.String(cli.StringOpt{
+			Name: "file",
+			Desc: "Path to the JSON file of the changed parameters",
+		})
+
+		heightOpt := c.Int(cli.IntOpt{
+			Name: "height",
+			Desc: "Activation height of the changed parameters",
+		})
+
+		feeOpt := c.Int(cli.IntOpt{
+			Name: "fee",
+			Desc: "Transaction fee",
+		})
+
+		memoOpt := c.String(cli.StringOpt{
+			Name:  "memo",
+			Desc:  "Transaction memo",
+			Value: "",
+		})
+
+		authOpt := c.String(cli.StringOpt{
+			Name: "a auth",
+			Desc: "Passphrase of the key file",
+		})
+		keyFileOpt := c.String(cli.StringOpt{
+			Name: "k keyfile",
+			Desc: "Path to the encrypted key file",
+		})
+
+		grpcOpt := c.String(cli.StringOpt{
+			Name: "e endpoint",
+			Desc: "gRPC server address",
+		})
+		c.Before = func() { fmt.Println(cmd.ZARB) }
+		c.Action = func() {
+
+			var err error
+			var stamp hash.Stamp
+			var validator crypto.Address
+			var changes param.Changes
+			var seq int
+			var fee int64
+			var auth string
+
+			// ---
+			if *valOpt == "" {
+				cmd.PrintWarnMsg("Validator address is not defined.")
+				c.PrintHelp()
+				return
+			}
+			validator, err = crypto.AddressFromString(*valOpt)
+			if err != nil {
+				cmd.PrintErrorMsg("Validator address is not valid: %v", err)
+				return
+			}
+
+			if *fileOpt == "" {
+				cmd.PrintWarnMsg("Parameters file is not defined.")
+				c.PrintHelp()
+				return
+			}
+			changes, err = readChanges(*fileOpt)
+			if err != nil {
+				cmd.PrintErrorMsg("Couldn't read the parameters: %v", err)
+				return
+			}
+			if *heightOpt <= 0 {
+				cmd.PrintWarnMsg("Activation height is not defined.")
+				c.PrintHelp()
+				return
+			}
+
+			if *feeOpt == 0 {
+				cmd.PrintWarnMsg("Fee is not defined.")
+				c.PrintHelp()
+				return
+			}
+			fee = int64(*feeOpt)
+
+			//sign transaction
+			if *keyFileOpt == "" {
+				cmd.PrintWarnMsg("Please specify a key file to sign.")
+				c.PrintHelp()
+				return
+			}
+			if *authOpt == "" {
+				auth = cmd.PromptPassphrase("Passphrase: ", false)
+			} else {
+				auth = *authOpt
+			}
+
+			//RPC
+			if seqOpt != nil {
+				seq = *seqOpt
+			} else {
+				seq, err = grpcclient.GetSequence(promptRPCEndpoint(grpcOpt), validator)
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't retrieve sequence number from RPC Server: %v", err)
+					return
+				}
+			}
+			if stampOpt == nil || *stampOpt == "" {
+				stamp, err = grpcclient.GetStamp(promptRPCEndpoint(grpcOpt))
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't retrieve stamp from RPC Server: %v", err)
+					return
+				}
+			} else {
+				stamp, err = hash.StampFromString(*stampOpt)
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't decode stamp from input: %v", err)
+					return
+				}
+			}
+
+			trx := tx.NewProposalTx(stamp, seq, validator, changes, *heightOpt, fee, *memoOpt)
+
+			signAndPublish(trx, *keyFileOpt, auth, grpcOpt)
+
+		}
+	}
+}
+
+// readChanges reads the changed parameters from a JSON file.
+// The parameters that are not in the file are kept unchanged.
+func readChanges(path string) (param.Changes, error) {
+	changes := param.Changes{}
+	data, err := util.ReadFile(path)
+	if err != nil {
+		return changes, err
+	}
+	if err := json.Unmarshal(data, &changes); err != nil {
+		return changes, err
+	}
+	if err := changes.SanityCheck(); err != nil {
+		return changes, err
+	}
+
+	return changes, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
 )
 
@@ -40,7 +41,22 @@ func SendTx() func(c *cli.Cmd) {
 
 		feeOpt := c.Int(cli.IntOpt{
 			Name: "fee",
-			Desc: "Transaction fee",
+			Desc: "Transaction fee, if not specified will estimate it from RPC server",
+		})
+
+		startOpt := c.Int(cli.IntOpt{
+			Name: "vesting-start",
+			Desc: "Block height that the amount starts unlocking linearly (Optional)",
+		})
+
+		cliffOpt := c.Int(cli.IntOpt{
+			Name: "vesting-cliff",
+			Desc: "Block height that nothing is unlocked before it (Optional)",
+		})
+
+		endOpt := c.Int(cli.IntOpt{
+			Name: "vesting-end",
+			Desc: "Block height that the amount is fully unlocked. If defined, the amount will be locked in the receiver account (Optional)",
 		})
 
 		memoOpt := c.String(cli.StringOpt{
@@ -83,11 +99,15 @@ func SendTx() func(c *cli.Cmd) {
 			amount = int64(*amountOpt)
 
 			if *feeOpt == 0 {
-				cmd.PrintWarnMsg("Fee is not defined.")
-				c.PrintHelp()
-				return
+				fee, err = grpcclient.EstimateFee(promptRPCEndpoint(grpcOpt), amount, payload.PayloadTypeSend)
+				if err != nil {
+					cmd.PrintErrorMsg("Couldn't estimate fee from RPC Server: %v", err)
+					return
+				}
+				cmd.PrintInfoMsg("Recommended fee: %v", fee)
+			} else {
+				fee = int64(*feeOpt)
 			}
-			fee = int64(*feeOpt)
 
 			if *senderOpt == "" {
 				cmd.PrintWarnMsg("Sender address is not defined.")
@@ -151,6 +171,22 @@ func SendTx() func(c *cli.Cmd) {
 
 			//fulfill transaction payload
 			trx := tx.NewSendTx(stamp, seq, sender, receiver, amount, fee, *memoOpt)
+			if *endOpt != 0 {
+				start := *startOpt
+				cliff := *cliffOpt
+				if cliff == 0 {
+					cliff = start
+				}
+				if start == 0 {
+					start = cliff
+				}
+				trx = tx.NewVestingSendTx(stamp, seq, sender, receiver, amount, fee, *memoOpt,
+					payload.VestingSchedule{
+						StartHeight: start,
+						CliffHeight: cliff,
+						EndHeight:   *endOpt,
+					})
+			}
 
 			//sign transaction
 			signAndPublish(trx, *keyFileOpt, auth, grpcOpt)
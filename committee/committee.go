@@ -120,6 +120,32 @@ func (committee *Committee) Update(lastRound int, joined []*validator.Validator)
 	return nil
 }
 
+// Eject removes a validator from the committee.
+// If the validator is the next proposer, the proposer moves to the next validator.
+func (committee *Committee) Eject(addr crypto.Address) error {
+	committee.lk.Lock()
+	defer committee.lk.Unlock()
+
+	if committee.validatorList.Len() <= 1 {
+		return errors.Errorf(errors.ErrGeneric, "Committee can't be empty")
+	}
+
+	for e := committee.validatorList.Front(); e != nil; e = e.Next() {
+		if e.Value.(*validator.Validator).Address().EqualsTo(addr) {
+			if e == committee.proposerPos {
+				committee.proposerPos = committee.proposerPos.Next()
+				if committee.proposerPos == nil {
+					committee.proposerPos = committee.validatorList.Front()
+				}
+			}
+			committee.validatorList.Remove(e)
+			return nil
+		}
+	}
+
+	return errors.Errorf(errors.ErrGeneric, "Validator is not in the committee")
+}
+
 func (committee *Committee) Validators() []*validator.Validator {
 	committee.lk.Lock()
 	defer committee.lk.Unlock()
@@ -212,6 +238,15 @@ func (committee *Committee) Size() int {
 	return committee.validatorList.Len()
 }
 
+// SetCommitteeSize changes the maximum size of the committee.
+// The committee is adjusted to the new size on the next update.
+func (committee *Committee) SetCommitteeSize(size int) {
+	committee.lk.Lock()
+	defer committee.lk.Unlock()
+
+	committee.committeeSize = size
+}
+
 func (committee *Committee) committers() []int {
 	committers := make([]int, committee.validatorList.Len())
 	i := 0
@@ -313,3 +313,42 @@ func TestTotalPower(t *testing.T) {
 	assert.Equal(t, committee.TotalPower(), totalPower)
 	assert.Equal(t, committee.TotalPower(), totalStake+1)
 }
+
+func TestEject(t *testing.T) {
+	committee, signers := GenerateTestCommittee()
+
+	assert.Error(t, committee.Eject(crypto.GenerateTestAddress()))
+
+	// Ejecting the proposer
+	assert.NoError(t, committee.Eject(signers[0].Address()))
+	assert.False(t, committee.Contains(signers[0].Address()))
+	assert.Equal(t, committee.Size(), 3)
+	assert.Equal(t, committee.Proposer(0).Address(), signers[1].Address())
+
+	// Ejecting the last validator in the list
+	assert.NoError(t, committee.Eject(signers[3].Address()))
+	assert.Equal(t, committee.Proposer(0).Address(), signers[1].Address())
+	assert.Equal(t, committee.Proposer(1).Address(), signers[2].Address())
+	assert.Equal(t, committee.Proposer(2).Address(), signers[1].Address())
+
+	assert.NoError(t, committee.Eject(signers[1].Address()))
+	assert.Equal(t, committee.Proposer(0).Address(), signers[2].Address())
+
+	// Committee can't be empty
+	assert.Error(t, committee.Eject(signers[2].Address()))
+}
+
+func TestSetCommitteeSize(t *testing.T) {
+	committee, signers := GenerateTestCommittee()
+
+	committee.SetCommitteeSize(5)
+	val5, _ := validator.GenerateTestValidator(4)
+	assert.NoError(t, committee.Update(0, []*validator.Validator{val5}))
+	assert.Equal(t, committee.Size(), 5)
+	assert.True(t, committee.Contains(signers[0].Address()))
+
+	committee.SetCommitteeSize(4)
+	val6, _ := validator.GenerateTestValidator(5)
+	assert.NoError(t, committee.Update(0, []*validator.Validator{val6}))
+	assert.Equal(t, committee.Size(), 4)
+}
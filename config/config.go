@@ -10,6 +10,7 @@ import (
 	"github.com/zarbchain/zarb-go/consensus"
 	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/metrics"
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/store"
@@ -32,6 +33,7 @@ type Config struct {
 	Capnp     *capnp.Config     `toml:"" comment:"Cap’n Proto is an insanely fast data interchange format and capability-based RPC system."`
 	HTTP      *http.Config      `toml:"" comment:"Http configuration."`
 	GRPC      *grpc.Config      `toml:"" comment:"GRPC configuration."`
+	Metrics   *metrics.Config   `toml:"" comment:"Metrics configuration. Zarb exposes Prometheus metrics."`
 }
 
 func DefaultConfig() *Config {
@@ -46,6 +48,7 @@ func DefaultConfig() *Config {
 		Capnp:     capnp.DefaultConfig(),
 		HTTP:      http.DefaultConfig(),
 		GRPC:      grpc.DefaultConfig(),
+		Metrics:   metrics.DefaultConfig(),
 	}
 
 	return conf
@@ -63,6 +66,7 @@ func TestConfig() *Config {
 		Capnp:     capnp.TestConfig(),
 		HTTP:      http.TestConfig(),
 		GRPC:      grpc.TestConfig(),
+		Metrics:   metrics.TestConfig(),
 	}
 
 	return conf
@@ -152,5 +156,8 @@ func (conf *Config) SanityCheck() error {
 	if err := conf.Capnp.SanityCheck(); err != nil {
 		return err
 	}
-	return conf.HTTP.SanityCheck()
+	if err := conf.HTTP.SanityCheck(); err != nil {
+		return err
+	}
+	return conf.Metrics.SanityCheck()
 }
@@ -22,6 +22,7 @@ func (s *changeProposerState) decide() {
 	if voteset.QuorumHash() != nil {
 		s.logger.Debug("change proposer has quorum", "proposer", s.proposer(s.round).Address())
 		s.round++
+		s.publishRoundChanged()
 
 		s.enterNewState(s.proposeState)
 	}
@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/event"
 )
 
 func TestChangeProposer(t *testing.T) {
@@ -22,6 +24,9 @@ func TestGotoNewRound(t *testing.T) {
 
 	commitBlockForAllStates(t)
 
+	sub := tConsP.eventBus.Subscribe(event.TypeRoundChanged)
+	defer sub.Unsubscribe()
+
 	tConsP.config.ChangeProposerTimeout = 100 * time.Millisecond
 	testEnterNewHeight(tConsP)
 
@@ -30,4 +35,11 @@ func TestGotoNewRound(t *testing.T) {
 	testAddVote(tConsP, vote.VoteTypeChangeProposer, 2, 0, hash.UndefHash, tIndexY)
 
 	checkHeightRound(t, tConsP, 2, 1)
+
+	e := <-sub.Events()
+	assert.Equal(t, e.(*event.RoundChangedEvent).Height, 2)
+	assert.Equal(t, e.(*event.RoundChangedEvent).Round, 0)
+	e = <-sub.Events()
+	assert.Equal(t, e.(*event.RoundChangedEvent).Height, 2)
+	assert.Equal(t, e.(*event.RoundChangedEvent).Round, 1)
 }
@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/consensus/log"
 	"github.com/zarbchain/zarb-go/consensus/proposal"
 	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
@@ -35,6 +38,7 @@ type consensus struct {
 	currentState        consState
 	changeProposerState consState
 	broadcastCh         chan message.Message
+	eventBus            *event.Bus
 	logger              *logger.Logger
 }
 
@@ -42,11 +46,13 @@ func NewConsensus(
 	conf *Config,
 	state state.Facade,
 	signer crypto.Signer,
-	broadcastCh chan message.Message) (Consensus, error) {
+	broadcastCh chan message.Message,
+	eventBus *event.Bus) (Consensus, error) {
 	cs := &consensus{
 		config:      conf,
 		state:       state,
 		broadcastCh: broadcastCh,
+		eventBus:    eventBus,
 		signer:      signer,
 	}
 
@@ -139,6 +145,13 @@ func (cs *consensus) enterNewState(s consState) {
 	cs.currentState.enter()
 }
 
+func (cs *consensus) publishRoundChanged() {
+	cs.eventBus.Publish(&event.RoundChangedEvent{
+		Height: cs.height,
+		Round:  cs.round,
+	})
+}
+
 func (cs *consensus) MoveToNewHeight() {
 	cs.lk.Lock()
 	defer cs.lk.Unlock()
@@ -231,6 +244,10 @@ func (cs *consensus) doAddVote(v *vote.Vote) {
 	err := cs.log.AddVote(v)
 	if err != nil {
 		cs.logger.Error("error on adding a vote", "vote", v, "err", err)
+
+		if errors.Code(err) == errors.ErrDuplicateVote {
+			cs.reportEvidence(cs.log.Evidence(v))
+		}
 	}
 
 	cs.logger.Debug("new vote added", "vote", v)
@@ -265,13 +282,31 @@ func (cs *consensus) queryProposal() {
 }
 
 func (cs *consensus) broadcastProposal(p *proposal.Proposal) {
-	cs.broadcastCh <- message.NewProposalMessage(p)
+	// Nodes before version 2 don't understand compact proposals
+	if cs.state.ProtocolVersion() < 2 {
+		cs.broadcastCh <- message.NewProposalMessage(p)
+		return
+	}
+	cs.broadcastCh <- message.NewCompactProposalMessage(p)
 }
 
 func (cs *consensus) broadcastVote(v *vote.Vote) {
 	cs.broadcastCh <- message.NewVoteMessage(v)
 }
 
+func (cs *consensus) reportEvidence(ev *evidence.Evidence) {
+	if ev == nil {
+		return
+	}
+	cs.logger.Warn("double-signing detected", "evidence", ev)
+
+	if err := cs.state.AddEvidence(ev); err != nil {
+		cs.logger.Error("unable to add the evidence", "evidence", ev, "err", err)
+		return
+	}
+	cs.broadcastCh <- message.NewEvidenceMessage(ev)
+}
+
 func (cs *consensus) announceNewBlock(h int, b *block.Block, c *block.Certificate) {
 	cs.broadcastCh <- message.NewBlockAnnounceMessage(h, b, c)
 }
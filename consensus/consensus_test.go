@@ -15,6 +15,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/genesis"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/param"
@@ -80,23 +81,24 @@ func setup(t *testing.T) {
 
 	// To prevent trigging timers before starting the tests, otherwise some tests will have double entry for new height.
 	getTime := util.RoundNow(params.BlockTimeInSecond).Add(time.Duration(params.BlockTimeInSecond) * time.Second)
-	tGenDoc = genesis.MakeGenesis(getTime, []*account.Account{acc}, vals, params)
-	stX, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexX], store1, tTxPool)
+	upgrade := param.Upgrade{Version: param.ProtocolVersion, Height: 1, BlockVersion: params.BlockVersion, TxVersion: 1}
+	tGenDoc = genesis.MakeGenesis(getTime, []*account.Account{acc}, vals, params, upgrade)
+	stX, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexX], store1, tTxPool, event.NewBus())
 	require.NoError(t, err)
-	stY, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexY], store2, tTxPool)
+	stY, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexY], store2, tTxPool, event.NewBus())
 	require.NoError(t, err)
-	stB, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexB], store3, tTxPool)
+	stB, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexB], store3, tTxPool, event.NewBus())
 	require.NoError(t, err)
-	stP, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexP], store4, tTxPool)
+	stP, err := state.LoadOrNewState(state.TestConfig(), tGenDoc, tSigners[tIndexP], store4, tTxPool, event.NewBus())
 	require.NoError(t, err)
 
-	consX, err := NewConsensus(TestConfig(), stX, tSigners[tIndexX], make(chan message.Message, 100))
+	consX, err := NewConsensus(TestConfig(), stX, tSigners[tIndexX], make(chan message.Message, 100), event.NewBus())
 	assert.NoError(t, err)
-	consY, err := NewConsensus(TestConfig(), stY, tSigners[tIndexY], make(chan message.Message, 100))
+	consY, err := NewConsensus(TestConfig(), stY, tSigners[tIndexY], make(chan message.Message, 100), event.NewBus())
 	assert.NoError(t, err)
-	consB, err := NewConsensus(TestConfig(), stB, tSigners[tIndexB], make(chan message.Message, 100))
+	consB, err := NewConsensus(TestConfig(), stB, tSigners[tIndexB], make(chan message.Message, 100), event.NewBus())
 	assert.NoError(t, err)
-	consP, err := NewConsensus(TestConfig(), stP, tSigners[tIndexP], make(chan message.Message, 100))
+	consP, err := NewConsensus(TestConfig(), stP, tSigners[tIndexP], make(chan message.Message, 100), event.NewBus())
 	assert.NoError(t, err)
 	tConsX = consX.(*consensus)
 	tConsY = consY.(*consensus)
@@ -149,10 +151,10 @@ func shouldPublishProposal(t *testing.T, cons *consensus, height, round int) {
 		case msg := <-cons.broadcastCh:
 			logger.Info("shouldPublishProposal", "msg", msg)
 
-			if msg.Type() == message.MessageTypeProposal {
-				m := msg.(*message.ProposalMessage)
-				assert.Equal(t, m.Proposal.Height(), height)
-				assert.Equal(t, m.Proposal.Round(), round)
+			if msg.Type() == message.MessageTypeCompactProposal {
+				m := msg.(*message.CompactProposalMessage)
+				assert.Equal(t, m.Height, height)
+				assert.Equal(t, m.Round, round)
 				return
 			}
 		}
@@ -308,8 +310,8 @@ func TestNotInCommittee(t *testing.T) {
 	signer := crypto.NewSigner(prv)
 	store := store.MockingStore()
 
-	st, _ := state.LoadOrNewState(state.TestConfig(), tGenDoc, signer, store, tTxPool)
-	cons, err := NewConsensus(TestConfig(), st, signer, make(chan message.Message, 100))
+	st, _ := state.LoadOrNewState(state.TestConfig(), tGenDoc, signer, store, tTxPool, event.NewBus())
+	cons, err := NewConsensus(TestConfig(), st, signer, make(chan message.Message, 100), event.NewBus())
 	assert.NoError(t, err)
 
 	testEnterNewHeight(cons.(*consensus))
@@ -318,6 +320,24 @@ func TestNotInCommittee(t *testing.T) {
 	assert.Zero(t, len(cons.RoundVotes(0)))
 }
 
+func TestBroadcastProposalBeforeUpgrade(t *testing.T) {
+	setup(t)
+
+	// No upgrade, the chain runs the first version of the protocol
+	genDoc := genesis.MakeGenesis(tGenDoc.GenesisTime(), tGenDoc.Accounts(), tGenDoc.Validators(), tGenDoc.Params())
+	st, err := state.LoadOrNewState(state.TestConfig(), genDoc, tSigners[tIndexX], store.MockingStore(), tTxPool, event.NewBus())
+	require.NoError(t, err)
+	cons, err := NewConsensus(TestConfig(), st, tSigners[tIndexX], make(chan message.Message, 100), event.NewBus())
+	assert.NoError(t, err)
+
+	p := makeProposal(t, 1, 0)
+	cons.(*consensus).broadcastProposal(p)
+
+	msg := <-cons.(*consensus).broadcastCh
+	assert.Equal(t, msg.Type(), message.MessageTypeProposal)
+	assert.Equal(t, msg.(*message.ProposalMessage).Proposal.Hash(), p.Hash())
+}
+
 func TestRoundVotes(t *testing.T) {
 	setup(t)
 
@@ -383,6 +403,35 @@ func TestConsensusAddVote(t *testing.T) {
 	assert.True(t, tConsP.HasVote(v5.Hash())) // next round
 }
 
+func TestConsensusDuplicateVote(t *testing.T) {
+	setup(t)
+
+	testEnterNewHeight(tConsP)
+
+	testAddVote(tConsP, vote.VoteTypePrepare, 1, 0, hash.GenerateTestHash(), tIndexB)
+	testAddVote(tConsP, vote.VoteTypePrepare, 1, 0, hash.GenerateTestHash(), tIndexB)
+
+	timeout := time.NewTimer(1 * time.Second)
+	for {
+		select {
+		case <-timeout.C:
+			require.NoError(t, fmt.Errorf("Timeout"))
+			return
+		case msg := <-tConsP.broadcastCh:
+			if msg.Type() == message.MessageTypeEvidence {
+				m := msg.(*message.EvidenceMessage)
+				assert.Equal(t, m.Evidence.Offender(), tSigners[tIndexB].Address())
+
+				// Slash transaction is broadcasted by the state
+				trx := tTxPool.Txs[len(tTxPool.Txs)-1]
+				assert.True(t, trx.IsSlashTx())
+				assert.Equal(t, trx.Payload().Signer(), tSigners[tIndexP].Address())
+				return
+			}
+		}
+	}
+}
+
 func TestConsensusLateProposal1(t *testing.T) {
 	setup(t)
 
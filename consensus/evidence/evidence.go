@@ -0,0 +1,125 @@
+package evidence
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/consensus/vote"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// Evidence is a proof of double-signing.
+// It contains two conflicting votes of a validator for the same height, round and type.
+type Evidence struct {
+	data evidenceData
+}
+
+type evidenceData struct {
+	VoteA *vote.Vote `cbor:"1,keyasint"`
+	VoteB *vote.Vote `cbor:"2,keyasint"`
+}
+
+// NewEvidence creates a new evidence from two conflicting votes.
+// The votes are ordered by their hashes, so the same votes always make the same evidence.
+func NewEvidence(voteA, voteB *vote.Vote) *Evidence {
+	hashA := voteA.Hash()
+	hashB := voteB.Hash()
+	if bytes.Compare(hashA.RawBytes(), hashB.RawBytes()) > 0 {
+		voteA, voteB = voteB, voteA
+	}
+	return &Evidence{
+		data: evidenceData{
+			VoteA: voteA,
+			VoteB: voteB,
+		},
+	}
+}
+
+func (e *Evidence) VoteA() *vote.Vote        { return e.data.VoteA }
+func (e *Evidence) VoteB() *vote.Vote        { return e.data.VoteB }
+func (e *Evidence) Offender() crypto.Address { return e.data.VoteA.Signer() }
+func (e *Evidence) Height() int              { return e.data.VoteA.Height() }
+func (e *Evidence) Round() int               { return e.data.VoteA.Round() }
+
+func (e *Evidence) SanityCheck() error {
+	if e.data.VoteA == nil || e.data.VoteB == nil {
+		return errors.Errorf(errors.ErrInvalidEvidence, "missing vote")
+	}
+	if err := e.data.VoteA.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidEvidence, err.Error())
+	}
+	if err := e.data.VoteB.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidEvidence, err.Error())
+	}
+	if e.data.VoteA.Height() != e.data.VoteB.Height() ||
+		e.data.VoteA.Round() != e.data.VoteB.Round() ||
+		e.data.VoteA.Type() != e.data.VoteB.Type() {
+		return errors.Errorf(errors.ErrInvalidEvidence, "votes are not for the same height, round and type")
+	}
+	if !e.data.VoteA.Signer().EqualsTo(e.data.VoteB.Signer()) {
+		return errors.Errorf(errors.ErrInvalidEvidence, "votes are signed by different validators")
+	}
+	if e.data.VoteA.BlockHash().EqualsTo(e.data.VoteB.BlockHash()) {
+		return errors.Errorf(errors.ErrInvalidEvidence, "votes are not conflicting")
+	}
+	return nil
+}
+
+// Verify checks the signature of both votes against the offender's public key.
+func (e *Evidence) Verify(pubKey *bls.PublicKey) error {
+	if err := e.data.VoteA.Verify(pubKey); err != nil {
+		return errors.Errorf(errors.ErrInvalidEvidence, err.Error())
+	}
+	if err := e.data.VoteB.Verify(pubKey); err != nil {
+		return errors.Errorf(errors.ErrInvalidEvidence, err.Error())
+	}
+	return nil
+}
+
+func (e *Evidence) Hash() hash.Hash {
+	bz, _ := e.MarshalCBOR()
+	return hash.CalcHash(bz)
+}
+
+func (e *Evidence) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(e.data)
+}
+
+func (e *Evidence) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &e.data)
+}
+
+func (e *Evidence) Encode() ([]byte, error) {
+	return e.MarshalCBOR()
+}
+
+func (e *Evidence) Decode(bs []byte) error {
+	return e.UnmarshalCBOR(bs)
+}
+
+func (e *Evidence) Fingerprint() string {
+	return fmt.Sprintf("{%v/%d/%s 👤 %s ⌘ %v ⌘ %v}",
+		e.Height(),
+		e.Round(),
+		e.data.VoteA.Type(),
+		e.Offender().Fingerprint(),
+		e.data.VoteA.BlockHash().Fingerprint(),
+		e.data.VoteB.BlockHash().Fingerprint(),
+	)
+}
+
+// ---------
+// For tests
+func GenerateTestEvidence(height, round int) (*Evidence, crypto.Signer) {
+	s := bls.GenerateTestSigner()
+	v1 := vote.NewVote(vote.VoteTypePrecommit, height, round, hash.GenerateTestHash(), s.Address())
+	v2 := vote.NewVote(vote.VoteTypePrecommit, height, round, hash.GenerateTestHash(), s.Address())
+	s.SignMsg(v1)
+	s.SignMsg(v2)
+
+	return NewEvidence(v1, v2), s
+}
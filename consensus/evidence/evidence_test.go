@@ -0,0 +1,79 @@
+package evidence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/consensus/vote"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+)
+
+func TestEvidenceMarshaling(t *testing.T) {
+	e1, _ := GenerateTestEvidence(10, 1)
+
+	bz, err := e1.Encode()
+	require.NoError(t, err)
+	e2 := new(Evidence)
+	require.NoError(t, e2.Decode(bz))
+	assert.Equal(t, e1.Hash(), e2.Hash())
+	assert.Equal(t, e2.Height(), 10)
+	assert.Equal(t, e2.Round(), 1)
+	assert.NoError(t, e2.SanityCheck())
+}
+
+func TestEvidenceOrdering(t *testing.T) {
+	e1, _ := GenerateTestEvidence(10, 1)
+	e2 := NewEvidence(e1.VoteB(), e1.VoteA())
+
+	assert.Equal(t, e1.Hash(), e2.Hash())
+}
+
+func TestEvidenceSanityCheck(t *testing.T) {
+	s := bls.GenerateTestSigner()
+	h1 := hash.GenerateTestHash()
+	h2 := hash.GenerateTestHash()
+
+	makeVote := func(voteType vote.Type, height, round int, blockHash hash.Hash) *vote.Vote {
+		v := vote.NewVote(voteType, height, round, blockHash, s.Address())
+		s.SignMsg(v)
+		return v
+	}
+
+	t.Run("Not conflicting votes", func(t *testing.T) {
+		e := NewEvidence(makeVote(vote.VoteTypePrepare, 10, 1, h1), makeVote(vote.VoteTypePrepare, 10, 1, h1))
+		assert.Error(t, e.SanityCheck())
+	})
+
+	t.Run("Different heights", func(t *testing.T) {
+		e := NewEvidence(makeVote(vote.VoteTypePrepare, 10, 1, h1), makeVote(vote.VoteTypePrepare, 11, 1, h2))
+		assert.Error(t, e.SanityCheck())
+	})
+
+	t.Run("Different rounds", func(t *testing.T) {
+		e := NewEvidence(makeVote(vote.VoteTypePrepare, 10, 1, h1), makeVote(vote.VoteTypePrepare, 10, 2, h2))
+		assert.Error(t, e.SanityCheck())
+	})
+
+	t.Run("Different types", func(t *testing.T) {
+		e := NewEvidence(makeVote(vote.VoteTypePrepare, 10, 1, h1), makeVote(vote.VoteTypePrecommit, 10, 1, h2))
+		assert.Error(t, e.SanityCheck())
+	})
+
+	t.Run("Different signers", func(t *testing.T) {
+		v, _ := vote.GenerateTestPrepareVote(10, 1)
+		e := NewEvidence(makeVote(vote.VoteTypePrepare, 10, 1, h1), v)
+		assert.Error(t, e.SanityCheck())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		e := NewEvidence(makeVote(vote.VoteTypePrepare, 10, 1, h1), makeVote(vote.VoteTypePrepare, 10, 1, h2))
+		assert.NoError(t, e.SanityCheck())
+		assert.Equal(t, e.Offender(), s.Address())
+		assert.NoError(t, e.Verify(s.PublicKey().(*bls.PublicKey)))
+
+		pub, _ := bls.GenerateTestKeyPair()
+		assert.Error(t, e.Verify(pub))
+	})
+}
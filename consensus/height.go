@@ -44,6 +44,7 @@ func (s *newHeightState) decide() {
 	s.height = sateHeight + 1
 	s.round = 0
 	s.logger.Info("entering new height", "height", s.height)
+	s.publishRoundChanged()
 
 	s.enterNewState(s.proposeState)
 }
@@ -1,6 +1,7 @@
 package log
 
 import (
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/consensus/proposal"
 	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/consensus/voteset"
@@ -57,6 +58,12 @@ func (log *Log) AddVote(v *vote.Vote) error {
 	return m.addVote(v)
 }
 
+// Evidence returns the evidence of double-signing for the signer of the given vote, if any.
+func (log *Log) Evidence(v *vote.Vote) *evidence.Evidence {
+	m := log.MustGetRoundMessages(v.Round())
+	return m.voteSet(v.Type()).Evidence(v.Signer())
+}
+
 func (log *Log) PrepareVoteSet(round int) *voteset.VoteSet {
 	m := log.MustGetRoundMessages(round)
 	return m.voteSet(vote.VoteTypePrepare)
@@ -63,15 +63,21 @@ func (p *Proposal) SetSignature(sig crypto.Signature) {
 func (p *Proposal) SetPublicKey(crypto.PublicKey) {}
 
 func (p *Proposal) SignBytes() []byte {
+	return SignBytes(p.data.Height, p.data.Round, p.data.Block.Hash())
+}
+
+// SignBytes returns the bytes that the proposer signs for a block at this height and round.
+// It lets a proposal be verified without having the transactions of its block.
+func SignBytes(height, round int, blockHash hash.Hash) []byte {
 	type signProposal struct {
 		Height    int       `cbor:"1,keyasint"`
 		Round     int       `cbor:"2,keyasint"`
 		BlockHash hash.Hash `cbor:"3,keyasint"`
 	}
 	bz, _ := cbor.Marshal(signProposal{
-		Height:    p.data.Height,
-		Round:     p.data.Round,
-		BlockHash: p.data.Block.Hash(),
+		Height:    height,
+		Round:     round,
+		BlockHash: blockHash,
 	})
 	return bz
 }
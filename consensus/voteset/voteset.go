@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
@@ -19,6 +20,7 @@ type VoteSet struct {
 	validators []*validator.Validator
 	blockVotes map[hash.Hash]*blockVotes
 	allVotes   map[hash.Hash]*vote.Vote
+	evidences  map[crypto.Address]*evidence.Evidence
 	totalPower int64
 	quorumHash *hash.Hash
 }
@@ -37,6 +39,7 @@ func NewVoteSet(height int, round int, voteType vote.Type, validators []*validat
 		totalPower: totalPower,
 		blockVotes: make(map[hash.Hash]*blockVotes),
 		allVotes:   make(map[hash.Hash]*vote.Vote),
+		evidences:  make(map[crypto.Address]*evidence.Evidence),
 	}
 }
 
@@ -104,14 +107,17 @@ func (vs *VoteSet) AddVote(v *vote.Vote) error {
 	// Now check for duplicity
 	for h, bv := range vs.blockVotes {
 		if !h.EqualsTo(v.BlockHash()) {
-			_, ok := bv.votes[signer]
+			existing, ok := bv.votes[signer]
 			if ok {
 				// Duplicated vote:
 				// 1- Same signer
 				// 2- Both votes are different
 				//
-				// We report an error
+				// We keep the evidence and report an error
 				//
+				if _, ok := vs.evidences[signer]; !ok {
+					vs.evidences[signer] = evidence.NewEvidence(existing, v)
+				}
 				return errors.Error(errors.ErrDuplicateVote)
 			}
 		}
@@ -127,6 +133,12 @@ func (vs *VoteSet) AddVote(v *vote.Vote) error {
 
 	return nil
 }
+
+// Evidence returns the evidence of double-signing for the given validator, if any.
+func (vs *VoteSet) Evidence(addr crypto.Address) *evidence.Evidence {
+	return vs.evidences[addr]
+}
+
 func (vs *VoteSet) hasTwoThirdOfTotalPower(power int64) bool {
 	return power > (vs.totalPower * 2 / 3)
 }
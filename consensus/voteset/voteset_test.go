@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
@@ -85,9 +86,15 @@ func TestDuplicateVote(t *testing.T) {
 	assert.NoError(t, vs.AddVote(correctVote)) // ok
 	assert.Equal(t, vs.Len(), 1)               // correctVote
 
+	assert.Nil(t, vs.Evidence(signers[0].Address()))
+
 	assert.Error(t, vs.AddVote(duplicatedVote1)) // rejected
 	assert.Equal(t, vs.Len(), 2)                 // correctVote + duplicatedVote1
 
+	ev := vs.Evidence(signers[0].Address())
+	assert.NoError(t, ev.SanityCheck())
+	assert.Equal(t, ev.Hash(), evidence.NewEvidence(correctVote, duplicatedVote1).Hash())
+
 	assert.Error(t, vs.AddVote(duplicatedVote2)) // rejected
 	assert.Equal(t, vs.Len(), 3)                 // correctVote + duplicatedVote1 + duplicatedVote2
 
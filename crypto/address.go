@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/btcsuite/btcutil/bech32"
 	cbor "github.com/fxamacker/cbor/v2"
@@ -13,10 +14,12 @@ import (
 
 // Address format:
 // `zc` + type + data + checksum
-// type is 1 for BLS signatures
+// type is 1 for BLS signatures and 2 for multi-signature accounts.
+// The type of BLS addresses is not encoded, to keep them short.
 
 const (
-	AddressTypeBLS byte = 1
+	AddressTypeBLS      byte = 1
+	AddressTypeMultisig byte = 2
 )
 
 const (
@@ -51,10 +54,18 @@ func AddressFromString(text string) (Address, error) {
 	if hrp != hrpAddress {
 		return Address{}, fmt.Errorf("invalid hrp: %v", hrp)
 	}
-	// TODO: fix me, Get type from decode function DecodeToBase256
-	data = append([]byte{AddressTypeBLS}, data...)
-	return AddressFromRawBytes(data)
-
+	if len(data) != addressSize {
+		data = append([]byte{AddressTypeBLS}, data...)
+	}
+	addr, err := AddressFromRawBytes(data)
+	if err != nil {
+		return Address{}, err
+	}
+	// Each address has only one valid string, e.g. BLS addresses can't have the type
+	if addr.String() != strings.ToLower(text) {
+		return Address{}, fmt.Errorf("invalid address: %v", text)
+	}
+	return addr, nil
 }
 
 func AddressFromRawBytes(bs []byte) (Address, error) {
@@ -68,6 +79,10 @@ func AddressFromRawBytes(bs []byte) (Address, error) {
 	return addr, nil
 }
 
+func (addr Address) Type() byte {
+	return addr.data.Address[0]
+}
+
 func (addr Address) RawBytes() []byte {
 	return addr.data.Address[:]
 }
@@ -80,7 +95,11 @@ func (addr Address) String() string {
 	if addr.EqualsTo(TreasuryAddress) {
 		return treasuryAddressString
 	}
-	str, err := bech32.EncodeFromBase256(hrpAddress, addr.data.Address[1:])
+	data := addr.data.Address[1:]
+	if addr.Type() != AddressTypeBLS {
+		data = addr.data.Address[:]
+	}
+	str, err := bech32.EncodeFromBase256(hrpAddress, data)
 	if err != nil {
 		panic(fmt.Sprintf("Invalid address. %v", err))
 	}
@@ -131,7 +150,7 @@ func (addr *Address) SanityCheck() error {
 	if addr.EqualsTo(TreasuryAddress) {
 		return errors.Errorf(errors.ErrInvalidAddress, "Treasury address")
 	}
-	if addr.data.Address[0] != 1 {
+	if addr.Type() != AddressTypeBLS && addr.Type() != AddressTypeMultisig {
 		return errors.Errorf(errors.ErrInvalidAddress, "Invalid type")
 	}
 	return nil
@@ -3,8 +3,10 @@ package crypto
 import (
 	"encoding/hex"
 	"encoding/json"
+	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcutil/bech32"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -53,6 +55,45 @@ func TestAddressFromString(t *testing.T) {
 
 	_, err = AddressFromString("inv")
 	assert.Error(t, err)
+
+	addr3, err := AddressFromString(strings.ToUpper(addr1.String()))
+	assert.NoError(t, err)
+	require.True(t, addr1.EqualsTo(addr3))
+
+	// BLS addresses can't be encoded with the type
+	long, err := bech32.EncodeFromBase256(hrpAddress, addr1.RawBytes())
+	assert.NoError(t, err)
+	_, err = AddressFromString(long)
+	assert.Error(t, err)
+
+	long, err = bech32.EncodeFromBase256(hrpAddress, TreasuryAddress.RawBytes())
+	assert.NoError(t, err)
+	_, err = AddressFromString(long)
+	assert.Error(t, err)
+
+	short, err := bech32.EncodeFromBase256(hrpAddress, addr1.RawBytes()[2:])
+	assert.NoError(t, err)
+	_, err = AddressFromString(short)
+	assert.Error(t, err)
+}
+
+func TestMultisigAddressFromString(t *testing.T) {
+	data := GenerateTestAddress().RawBytes()
+	data[0] = AddressTypeMultisig
+	addr1, err := AddressFromRawBytes(data)
+	assert.NoError(t, err)
+	assert.Equal(t, addr1.Type(), AddressTypeMultisig)
+	assert.NoError(t, addr1.SanityCheck())
+
+	addr2, err := AddressFromString(addr1.String())
+	assert.NoError(t, err)
+	assert.Equal(t, addr2.Type(), AddressTypeMultisig)
+	require.True(t, addr1.EqualsTo(addr2))
+	assert.Greater(t, len(addr1.String()), len(GenerateTestAddress().String()))
+
+	data[0] = 3
+	addr3, _ := AddressFromRawBytes(data)
+	assert.Error(t, addr3.SanityCheck())
 }
 
 func TestMarshalingEmptyAddress(t *testing.T) {
@@ -0,0 +1,205 @@
+package bls
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+)
+
+// MaxMultisigKeys is the maximum number of public keys in a multi-signature account
+const MaxMultisigKeys = 32
+
+// Multisig defines an M-of-N multi-signature account.
+// Any M of the N owners can sign a transaction together. Their signatures are aggregated
+// into one BLS signature and a bitmap that shows which owners have signed.
+// Like MuSig, each signature and public key is weighted by a coefficient that is derived from
+// all the public keys of the account. It prevents rogue-key attacks, where an owner chooses its
+// public key based on the public keys of the other owners to sign on their behalf.
+type Multisig struct {
+	data multisigData
+}
+
+type multisigData struct {
+	Threshold  int          `cbor:"1,keyasint"`
+	PublicKeys []*PublicKey `cbor:"2,keyasint"`
+}
+
+// NewMultisig creates a multi-signature account.
+// The public keys are sorted, so the address doesn't depend on the order of the keys.
+func NewMultisig(threshold int, pubs []*PublicKey) (*Multisig, error) {
+	sorted := make([]*PublicKey, len(pubs))
+	copy(sorted, pubs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].RawBytes(), sorted[j].RawBytes()) < 0
+	})
+
+	ms := &Multisig{
+		data: multisigData{
+			Threshold:  threshold,
+			PublicKeys: sorted,
+		},
+	}
+	if err := ms.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+func (ms *Multisig) Threshold() int           { return ms.data.Threshold }
+func (ms *Multisig) PublicKeys() []*PublicKey { return ms.data.PublicKeys }
+
+func (ms *Multisig) SanityCheck() error {
+	n := len(ms.data.PublicKeys)
+	if n == 0 || n > MaxMultisigKeys {
+		return fmt.Errorf("invalid number of public keys: %v", n)
+	}
+	if ms.data.Threshold <= 0 || ms.data.Threshold > n {
+		return fmt.Errorf("invalid threshold: %v", ms.data.Threshold)
+	}
+	for i, pub := range ms.data.PublicKeys {
+		if pub == nil {
+			return fmt.Errorf("invalid public key")
+		}
+		if err := pub.SanityCheck(); err != nil {
+			return err
+		}
+		if i > 0 && bytes.Compare(ms.data.PublicKeys[i-1].RawBytes(), pub.RawBytes()) >= 0 {
+			return fmt.Errorf("public keys are not sorted or duplicated")
+		}
+	}
+	return nil
+}
+
+// Address returns the address of the multi-signature account.
+// It is derived from the threshold and the public keys.
+func (ms *Multisig) Address() crypto.Address {
+	bs, err := ms.MarshalCBOR()
+	if err != nil {
+		return crypto.Address{}
+	}
+	data := hash.Hash160(hash.Hash256(bs))
+	data = append([]byte{crypto.AddressTypeMultisig}, data...)
+	addr, _ := crypto.AddressFromRawBytes(data)
+	return addr
+}
+
+// Aggregate aggregates the partial signatures of the owners.
+// It returns the bitmap of the signers and the aggregated signature.
+func (ms *Multisig) Aggregate(pubs []*PublicKey, sigs []*Signature) ([]byte, *Signature, error) {
+	if len(pubs) != len(sigs) {
+		return nil, nil, fmt.Errorf("number of public keys and signatures are not same")
+	}
+	signers := make([]byte, (len(ms.data.PublicKeys)+7)/8)
+	for _, pub := range pubs {
+		i := ms.index(pub)
+		if i == -1 {
+			return nil, nil, fmt.Errorf("public key is not an owner: %v", pub)
+		}
+		if signers[i/8]&(1<<(i%8)) != 0 {
+			return nil, nil, fmt.Errorf("duplicated public key: %v", pub)
+		}
+		signers[i/8] |= 1 << (i % 8)
+	}
+	if len(pubs) < ms.data.Threshold {
+		return nil, nil, fmt.Errorf("not enough signatures. expected %v, got %v", ms.data.Threshold, len(pubs))
+	}
+
+	weighted := make([]*Signature, len(sigs))
+	for i, sig := range sigs {
+		g1 := new(bls.G1)
+		bls.G1Mul(g1, bls.CastFromSign(sig.data.Signature), ms.coefficient(ms.index(pubs[i])))
+		weighted[i] = &Signature{data: signatureData{Signature: bls.CastToSign(g1)}}
+	}
+
+	return signers, Aggregate(weighted), nil
+}
+
+// Verify checks the aggregated signature of the signers.
+// Signers is a bitmap that shows which owners have signed the message.
+func (ms *Multisig) Verify(msg []byte, signers []byte, sig crypto.Signature) bool {
+	s, ok := sig.(*Signature)
+	if !ok {
+		return false
+	}
+	if len(signers) != (len(ms.data.PublicKeys)+7)/8 {
+		return false
+	}
+	count := 0
+	aggPub := new(bls.G2)
+	for i := 0; i < len(signers)*8; i++ {
+		if signers[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if i >= len(ms.data.PublicKeys) {
+			return false
+		}
+		g2 := new(bls.G2)
+		bls.G2Mul(g2, bls.CastFromPublicKey(ms.data.PublicKeys[i].data.PublicKey), ms.coefficient(i))
+		bls.G2Add(aggPub, aggPub, g2)
+		count++
+	}
+	if count < ms.data.Threshold {
+		return false
+	}
+	return s.data.Signature.VerifyByte(bls.CastToPublicKey(aggPub), msg)
+}
+
+// coefficient returns the coefficient of the i-th public key,
+// which is the hash of the public key and all the public keys of the account.
+func (ms *Multisig) coefficient(i int) *bls.Fr {
+	bs := make([]byte, 0, (len(ms.data.PublicKeys)+1)*PublicKeySize)
+	bs = append(bs, ms.data.PublicKeys[i].RawBytes()...)
+	for _, pub := range ms.data.PublicKeys {
+		bs = append(bs, pub.RawBytes()...)
+	}
+	c := new(bls.Fr)
+	c.SetHashOf(bs)
+	return c
+}
+
+func (ms *Multisig) index(pub *PublicKey) int {
+	for i, p := range ms.data.PublicKeys {
+		if p.EqualsTo(pub) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (ms *Multisig) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(ms.data)
+}
+
+func (ms *Multisig) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &ms.data)
+}
+
+func (ms *Multisig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ms.data)
+}
+
+func (ms *Multisig) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &ms.data)
+}
+
+// GenerateTestMultisig generates an M-of-N multi-signature account for testing purpose
+func GenerateTestMultisig(threshold, n int) (*Multisig, []crypto.Signer) {
+	pubs := make([]*PublicKey, n)
+	signers := make([]crypto.Signer, n)
+	for i := 0; i < n; i++ {
+		pub, prv := RandomKeyPair()
+		pubs[i] = pub
+		signers[i] = crypto.NewSigner(prv)
+	}
+	ms, err := NewMultisig(threshold, pubs)
+	if err != nil {
+		panic(err)
+	}
+	return ms, signers
+}
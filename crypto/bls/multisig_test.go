@@ -0,0 +1,127 @@
+package bls
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestMultisigMarshaling(t *testing.T) {
+	ms1, _ := GenerateTestMultisig(2, 3)
+	ms2 := new(Multisig)
+	ms3 := new(Multisig)
+
+	bs, err := ms1.MarshalCBOR()
+	require.NoError(t, err)
+	require.NoError(t, ms2.UnmarshalCBOR(bs))
+	assert.Equal(t, ms1.Address(), ms2.Address())
+
+	js, err := json.Marshal(ms1)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(js, ms3))
+	assert.Equal(t, ms1.Address(), ms3.Address())
+}
+
+func TestMultisigAddress(t *testing.T) {
+	pub1, _ := RandomKeyPair()
+	pub2, _ := RandomKeyPair()
+	pub3, _ := RandomKeyPair()
+
+	ms1, err := NewMultisig(2, []*PublicKey{pub1, pub2, pub3})
+	require.NoError(t, err)
+	ms2, err := NewMultisig(2, []*PublicKey{pub3, pub1, pub2})
+	require.NoError(t, err)
+	ms3, err := NewMultisig(3, []*PublicKey{pub1, pub2, pub3})
+	require.NoError(t, err)
+
+	assert.Equal(t, ms1.Address(), ms2.Address())
+	assert.NotEqual(t, ms1.Address(), ms3.Address())
+	addr := ms1.Address()
+	assert.Equal(t, addr.Type(), crypto.AddressTypeMultisig)
+	assert.NoError(t, addr.SanityCheck())
+}
+
+func TestInvalidMultisig(t *testing.T) {
+	pub1, _ := RandomKeyPair()
+	pub2, _ := RandomKeyPair()
+
+	_, err := NewMultisig(1, []*PublicKey{})
+	assert.Error(t, err)
+	_, err = NewMultisig(0, []*PublicKey{pub1, pub2})
+	assert.Error(t, err)
+	_, err = NewMultisig(3, []*PublicKey{pub1, pub2})
+	assert.Error(t, err)
+	_, err = NewMultisig(1, []*PublicKey{pub1, pub1})
+	assert.Error(t, err)
+}
+
+func TestMultisigSignature(t *testing.T) {
+	ms, signers := GenerateTestMultisig(2, 3)
+	msg := []byte("zarb")
+
+	pubs := make([]*PublicKey, 3)
+	sigs := make([]*Signature, 3)
+	for i, s := range signers {
+		pubs[i] = s.PublicKey().(*PublicKey)
+		sigs[i] = s.SignData(msg).(*Signature)
+	}
+
+	t.Run("Not enough signatures", func(t *testing.T) {
+		_, _, err := ms.Aggregate(pubs[:1], sigs[:1])
+		assert.Error(t, err)
+	})
+
+	t.Run("Duplicated signatures", func(t *testing.T) {
+		_, _, err := ms.Aggregate([]*PublicKey{pubs[0], pubs[0]}, []*Signature{sigs[0], sigs[0]})
+		assert.Error(t, err)
+	})
+
+	t.Run("Not an owner", func(t *testing.T) {
+		pub, prv := RandomKeyPair()
+		_, _, err := ms.Aggregate([]*PublicKey{pubs[0], pub}, []*Signature{sigs[0], prv.Sign(msg).(*Signature)})
+		assert.Error(t, err)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		signersBitmap, aggSig, err := ms.Aggregate(pubs[1:], sigs[1:])
+		require.NoError(t, err)
+		assert.True(t, ms.Verify(msg, signersBitmap, aggSig))
+		assert.False(t, ms.Verify([]byte("invalid"), signersBitmap, aggSig))
+
+		// Manipulating the signers bitmap
+		assert.False(t, ms.Verify(msg, []byte{0x7}, aggSig))
+		assert.False(t, ms.Verify(msg, []byte{0x9}, aggSig))
+		assert.False(t, ms.Verify(msg, []byte{0x1}, sigs[0]))
+		assert.False(t, ms.Verify(msg, []byte{}, aggSig))
+	})
+
+	t.Run("All signatures", func(t *testing.T) {
+		signersBitmap, aggSig, err := ms.Aggregate(pubs, sigs)
+		require.NoError(t, err)
+		assert.Equal(t, signersBitmap, []byte{0x7})
+		assert.True(t, ms.Verify(msg, signersBitmap, aggSig))
+	})
+}
+
+func TestMultisigRogueKey(t *testing.T) {
+	pub1, _ := RandomKeyPair()
+	pubX, prvX := RandomKeyPair()
+
+	// The attacker chooses its public key as pubX - pub1,
+	// so the sum of the public keys is pubX
+	rogue := new(bls.G2)
+	bls.G2Sub(rogue, bls.CastFromPublicKey(pubX.data.PublicKey), bls.CastFromPublicKey(pub1.data.PublicKey))
+	pubRogue := &PublicKey{data: publicKeyData{PublicKey: bls.CastToPublicKey(rogue)}}
+
+	ms, err := NewMultisig(2, []*PublicKey{pub1, pubRogue})
+	require.NoError(t, err)
+
+	msg := []byte("zarb")
+	sig := prvX.Sign(msg)
+	assert.True(t, VerifyAggregated(sig.(*Signature), []*PublicKey{pub1, pubRogue}, msg))
+	assert.False(t, ms.Verify(msg, []byte{0x3}, sig))
+}
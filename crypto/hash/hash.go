@@ -2,6 +2,7 @@ package hash
 
 import (
 	"crypto/rand"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -76,6 +77,14 @@ func (h Hash) Stamp() Stamp {
 	return stamp
 }
 
+// ShortID returns a short form of the hash, which is its SipHash keyed by another hash.
+// The short IDs are different for each key, so nobody can make colliding short IDs in advance.
+func (h Hash) ShortID(key Hash) uint64 {
+	k0 := binary.LittleEndian.Uint64(key.data.Hash[0:8])
+	k1 := binary.LittleEndian.Uint64(key.data.Hash[8:16])
+	return SipHash24(k0, k1, h.data.Hash[:])
+}
+
 func (h Hash) Fingerprint() string {
 	return fmt.Sprintf("%X", h.data.Hash[:6])
 }
@@ -87,3 +87,23 @@ func TestHash160(t *testing.T) {
 	expected, _ := hex.DecodeString("e93efc0c83176034cb828e39435eeecc07a29298")
 	assert.Equal(t, h, expected)
 }
+
+func TestSipHash24(t *testing.T) {
+	// Test vector from the SipHash paper
+	data := make([]byte, 15)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	k0 := uint64(0x0706050403020100)
+	k1 := uint64(0x0f0e0d0c0b0a0908)
+	assert.Equal(t, SipHash24(k0, k1, data), uint64(0xa129ca6149be45e5))
+}
+
+func TestShortID(t *testing.T) {
+	h := GenerateTestHash()
+	key1 := GenerateTestHash()
+	key2 := GenerateTestHash()
+
+	assert.Equal(t, h.ShortID(key1), h.ShortID(key1))
+	assert.NotEqual(t, h.ShortID(key1), h.ShortID(key2))
+}
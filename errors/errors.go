@@ -22,6 +22,12 @@ const (
 	ErrInvalidConfig
 	ErrDuplicateVote
 	ErrInsufficientFunds
+	ErrInvalidSnapshot
+	ErrTxPoolFull
+	ErrInvalidEvidence
+	ErrInvalidParams
+	ErrUnknownCommittee
+	ErrInvalidProof
 
 	ErrCount
 )
@@ -44,6 +50,12 @@ var messages = map[int]string{
 	ErrInvalidConfig:     "Invalid config",
 	ErrDuplicateVote:     "Duplicate vote",
 	ErrInsufficientFunds: "Insufficient funds",
+	ErrInvalidSnapshot:   "Invalid snapshot",
+	ErrTxPoolFull:        "Transaction pool is full",
+	ErrInvalidEvidence:   "Invalid evidence",
+	ErrInvalidParams:     "Invalid parameters",
+	ErrUnknownCommittee:  "Unknown committee",
+	ErrInvalidProof:      "Invalid proof",
 }
 
 type withCode struct {
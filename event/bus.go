@@ -0,0 +1,94 @@
+package event
+
+import (
+	"sync"
+)
+
+// DefaultBufferSize is the size of the subscription channel
+const DefaultBufferSize = 100
+
+// Bus delivers the published events to all subscribers.
+// Publishing never blocks. If a subscriber can't keep up, the events are dropped for it.
+type Bus struct {
+	lk sync.RWMutex
+
+	subs map[*Subscription]struct{}
+}
+
+type Subscription struct {
+	bus   *Bus
+	ch    chan Event
+	types map[Type]bool
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe returns a new subscription for the given event types.
+// If no type is given, the subscription receives all the events.
+func (b *Bus) Subscribe(types ...Type) *Subscription {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	sub := &Subscription{
+		bus:   b,
+		ch:    make(chan Event, DefaultBufferSize),
+		types: make(map[Type]bool),
+	}
+	for _, t := range types {
+		sub.types[t] = true
+	}
+	b.subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (b *Bus) Publish(e Event) {
+	b.lk.RLock()
+	defer b.lk.RUnlock()
+
+	for sub := range b.subs {
+		if !sub.isInterested(e.Type()) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			// Slow subscriber
+		}
+	}
+}
+
+func (b *Bus) unsubscribe(sub *Subscription) {
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+func (b *Bus) Subscribers() int {
+	b.lk.RLock()
+	defer b.lk.RUnlock()
+
+	return len(b.subs)
+}
+
+// Events returns the channel of the subscription.
+// The channel will be closed after unsubscribing.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+func (s *Subscription) isInterested(t Type) bool {
+	return len(s.types) == 0 || s.types[t]
+}
+
+func (s *Subscription) Unsubscribe() {
+	s.bus.unsubscribe(s)
+}
@@ -0,0 +1,76 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestPublishAndSubscribe(t *testing.T) {
+	bus := NewBus()
+	sub1 := bus.Subscribe()
+	sub2 := bus.Subscribe()
+	assert.Equal(t, bus.Subscribers(), 2)
+
+	b, _ := block.GenerateTestBlock(nil, nil)
+	trx, _ := tx.GenerateTestSendTx()
+	bus.Publish(&BlockCommittedEvent{Height: 1, Block: b})
+	bus.Publish(&TransactionAddedEvent{Transaction: trx})
+
+	for _, sub := range []*Subscription{sub1, sub2} {
+		e := <-sub.Events()
+		assert.Equal(t, e.Type(), TypeBlockCommitted)
+		assert.Equal(t, e.(*BlockCommittedEvent).Block.Hash(), b.Hash())
+
+		e = <-sub.Events()
+		assert.Equal(t, e.Type(), TypeTransactionAdded)
+		assert.Equal(t, e.(*TransactionAddedEvent).Transaction.ID(), trx.ID())
+	}
+
+	sub1.Unsubscribe()
+	sub1.Unsubscribe()
+	assert.Equal(t, bus.Subscribers(), 1)
+	_, ok := <-sub1.Events()
+	assert.False(t, ok)
+}
+
+func TestSubscribeByType(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe(TypeRoundChanged, TypePeerConnected)
+
+	bus.Publish(&BlockCommittedEvent{Height: 1})
+	bus.Publish(&RoundChangedEvent{Height: 2, Round: 1})
+	bus.Publish(&TransactionRemovedEvent{})
+	bus.Publish(&PeerConnectedEvent{Moniker: "alice"})
+
+	assert.Equal(t, len(sub.Events()), 2)
+	e := <-sub.Events()
+	assert.Equal(t, e.(*RoundChangedEvent).Round, 1)
+	e = <-sub.Events()
+	assert.Equal(t, e.(*PeerConnectedEvent).Moniker, "alice")
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe()
+
+	for i := 0; i < DefaultBufferSize+10; i++ {
+		bus.Publish(&BlockCommittedEvent{Height: i + 1})
+	}
+
+	assert.Equal(t, len(sub.Events()), DefaultBufferSize)
+	e := <-sub.Events()
+	assert.Equal(t, e.(*BlockCommittedEvent).Height, 1)
+}
+
+func TestTypeString(t *testing.T) {
+	assert.Equal(t, TypeBlockCommitted.String(), "block-committed")
+	assert.Equal(t, TypeTransactionAdded.String(), "transaction-added")
+	assert.Equal(t, TypeTransactionRemoved.String(), "transaction-removed")
+	assert.Equal(t, TypeCommitteeChanged.String(), "committee-changed")
+	assert.Equal(t, TypeRoundChanged.String(), "round-changed")
+	assert.Equal(t, TypePeerConnected.String(), "peer-connected")
+	assert.Equal(t, Type(-1).String(), "invalid")
+}
@@ -0,0 +1,105 @@
+package event
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+type Type int
+
+const (
+	TypeBlockCommitted     = Type(1)
+	TypeTransactionAdded   = Type(2)
+	TypeTransactionRemoved = Type(3)
+	TypeCommitteeChanged   = Type(4)
+	TypeRoundChanged       = Type(5)
+	TypePeerConnected      = Type(6)
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeBlockCommitted:
+		return "block-committed"
+	case TypeTransactionAdded:
+		return "transaction-added"
+	case TypeTransactionRemoved:
+		return "transaction-removed"
+	case TypeCommitteeChanged:
+		return "committee-changed"
+	case TypeRoundChanged:
+		return "round-changed"
+	case TypePeerConnected:
+		return "peer-connected"
+	}
+	return "invalid"
+}
+
+type Event interface {
+	Type() Type
+}
+
+// BlockCommittedEvent is published right after a block is committed into the state.
+type BlockCommittedEvent struct {
+	Height      int
+	Block       *block.Block
+	Certificate *block.Certificate
+}
+
+func (e *BlockCommittedEvent) Type() Type {
+	return TypeBlockCommitted
+}
+
+// TransactionAddedEvent is published when a new transaction is appended into the transaction pool.
+type TransactionAddedEvent struct {
+	Transaction *tx.Tx
+}
+
+func (e *TransactionAddedEvent) Type() Type {
+	return TypeTransactionAdded
+}
+
+// TransactionRemovedEvent is published when a transaction is removed from the transaction pool,
+// either because it is committed or it is not valid anymore.
+type TransactionRemovedEvent struct {
+	ID tx.ID
+}
+
+func (e *TransactionRemovedEvent) Type() Type {
+	return TypeTransactionRemoved
+}
+
+// CommitteeChangedEvent is published when new validators join the committee
+// or slashed validators are ejected from it.
+type CommitteeChangedEvent struct {
+	Height     int
+	Joined     []crypto.Address
+	Ejected    []crypto.Address
+	Committers []int
+}
+
+func (e *CommitteeChangedEvent) Type() Type {
+	return TypeCommitteeChanged
+}
+
+// RoundChangedEvent is published when the consensus engine moves to a new height or round.
+type RoundChangedEvent struct {
+	Height int
+	Round  int
+}
+
+func (e *RoundChangedEvent) Type() Type {
+	return TypeRoundChanged
+}
+
+// PeerConnectedEvent is published when the handshake with a new peer is done.
+type PeerConnectedEvent struct {
+	PeerID  peer.ID
+	Moniker string
+	Height  int
+}
+
+func (e *PeerConnectedEvent) Type() Type {
+	return TypePeerConnected
+}
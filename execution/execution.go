@@ -14,11 +14,13 @@ type Executor interface {
 	Fee() int64
 }
 type Execution struct {
-	executors      map[payload.Type]Executor
+	// executors keeps the executor set of each protocol version
+	executors      map[int]map[payload.Type]Executor
 	accumulatedFee int64
 }
 
-func newExecution(strict bool) *Execution {
+// executorsV1 returns the executor set of the first protocol version.
+func executorsV1(strict bool) map[payload.Type]Executor {
 	execs := make(map[payload.Type]Executor)
 	execs[payload.PayloadTypeSend] = executor.NewSendExecutor(strict)
 	execs[payload.PayloadTypeBond] = executor.NewBondExecutor(strict)
@@ -26,8 +28,31 @@ func newExecution(strict bool) *Execution {
 	execs[payload.PayloadTypeUnbond] = executor.NewUnbondExecutor(strict)
 	execs[payload.PayloadTypeWithdraw] = executor.NewWithdrawExecutor(strict)
 
+	return execs
+}
+
+// executorsV2 returns the executor set of the second protocol version.
+// It adds batch send, delegation, slashing, jailing and governance transactions.
+func executorsV2(strict bool) map[payload.Type]Executor {
+	execs := executorsV1(strict)
+	execs[payload.PayloadTypeBatchSend] = executor.NewBatchSendExecutor(strict)
+	execs[payload.PayloadTypeDelegate] = executor.NewDelegateExecutor(strict)
+	execs[payload.PayloadTypeUndelegate] = executor.NewUndelegateExecutor(strict)
+	execs[payload.PayloadTypeWithdrawDelegation] = executor.NewWithdrawDelegationExecutor(strict)
+	execs[payload.PayloadTypeSlash] = executor.NewSlashExecutor(strict)
+	execs[payload.PayloadTypeUnjail] = executor.NewUnjailExecutor(strict)
+	execs[payload.PayloadTypeProposal] = executor.NewProposalExecutor(strict)
+	execs[payload.PayloadTypeProposalVote] = executor.NewProposalVoteExecutor(strict)
+
+	return execs
+}
+
+func newExecution(strict bool) *Execution {
 	return &Execution{
-		executors: execs,
+		executors: map[int]map[payload.Type]Executor{
+			1: executorsV1(strict),
+			2: executorsV2(strict),
+		},
 	}
 }
 func NewExecution() *Execution {
@@ -42,6 +67,9 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if err := trx.SanityCheck(); err != nil {
 		return err
 	}
+	if err := exe.checkVersion(trx, sb); err != nil {
+		return err
+	}
 	if err := exe.checkStamp(trx, sb); err != nil {
 		return err
 	}
@@ -51,8 +79,15 @@ func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if err := exe.checkFee(trx, sb); err != nil {
 		return err
 	}
+	if err := exe.checkFeatures(trx, sb); err != nil {
+		return err
+	}
 
-	e, ok := exe.executors[trx.PayloadType()]
+	execs, ok := exe.executors[sb.ProtocolVersion()]
+	if !ok {
+		return errors.Errorf(errors.ErrInvalidTx, "unsupported protocol version: %v", sb.ProtocolVersion())
+	}
+	e, ok := execs[trx.PayloadType()]
 	if !ok {
 		return errors.Errorf(errors.ErrInvalidTx, "unknown transaction type: %v", trx.PayloadType())
 	}
@@ -70,6 +105,35 @@ func (exe *Execution) AccumulatedFee() int64 {
 	return exe.accumulatedFee
 }
 
+func (exe *Execution) checkVersion(trx *tx.Tx, sb sandbox.Sandbox) error {
+	if trx.Version() != sb.TxVersion() {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid transaction version. expected: %v, got: %v", sb.TxVersion(), trx.Version())
+	}
+	return nil
+}
+
+// checkFeatures rejects the features that are added in protocol version 2,
+// before the protocol version 2 is activated.
+func (exe *Execution) checkFeatures(trx *tx.Tx, sb sandbox.Sandbox) error {
+	if sb.ProtocolVersion() >= 2 {
+		return nil
+	}
+	if trx.Multisig() != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "multi-signature transactions are not supported")
+	}
+	switch pld := trx.Payload().(type) {
+	case *payload.SendPayload:
+		if pld.Vesting != nil {
+			return errors.Errorf(errors.ErrInvalidTx, "vesting is not supported")
+		}
+	case *payload.BondPayload:
+		if pld.Commission != 0 {
+			return errors.Errorf(errors.ErrInvalidTx, "commission is not supported")
+		}
+	}
+	return nil
+}
+
 func (exe *Execution) checkMemo(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if len(trx.Memo()) > sb.MaxMemoLength() {
 		return errors.Errorf(errors.ErrInvalidTx, "memo length exceeded")
@@ -101,11 +165,21 @@ func (exe *Execution) checkFee(trx *tx.Tx, sb sandbox.Sandbox) error {
 			return errors.Errorf(errors.ErrInvalidTx, "fee is wrong. expected: 0, got: %v", trx.Fee())
 		}
 	} else {
-		fee := int64(float64(trx.Payload().Value()) * sb.FeeFraction())
-		fee = util.Max64(fee, sb.MinFee())
-		if trx.Fee() != fee {
+		fee := MinimumFee(trx.Payload().Value(), sb.FeeFraction(), sb.MinFee())
+		// Before protocol version 2, transactions should pay the exact fee
+		if sb.ProtocolVersion() < 2 && trx.Fee() != fee {
 			return errors.Errorf(errors.ErrInvalidTx, "fee is wrong. expected: %v, got: %v", fee, trx.Fee())
 		}
+		if trx.Fee() < fee {
+			return errors.Errorf(errors.ErrInvalidTx, "fee is too low. expected at least: %v, got: %v", fee, trx.Fee())
+		}
 	}
 	return nil
 }
+
+// MinimumFee returns the minimum fee that a transaction should pay to transfer the given amount.
+// Transactions can pay more to get a higher priority in the transaction pool.
+func MinimumFee(amount int64, feeFraction float64, minFee int64) int64 {
+	fee := int64(float64(amount) * feeFraction)
+	return util.Max64(fee, minFee)
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 )
 
 func TestExecution(t *testing.T) {
@@ -95,10 +97,10 @@ func TestExecution(t *testing.T) {
 		assert.Error(t, tExec.Execute(trx, tSandbox))
 	})
 
-	t.Run("Invalid fee, Should returns error", func(t *testing.T) {
-		trx := tx.NewSendTx(hash2.Stamp(), 2, addr1, rcvAddr, 1000, 1001, "invalid fee")
+	t.Run("Higher fee, Should pass the fee check", func(t *testing.T) {
+		trx := tx.NewSendTx(hash2.Stamp(), 2, addr1, rcvAddr, 1000, 1001, "higher fee")
 		signer1.SignMsg(trx)
-		assert.Error(t, tExec.Execute(trx, tSandbox))
+		assert.NoError(t, tExec.checkFee(trx, tSandbox))
 	})
 
 	t.Run("Invalid fee, Should returns error", func(t *testing.T) {
@@ -140,3 +142,69 @@ func TestChecker(t *testing.T) {
 		assert.NoError(t, tChecker.Execute(trx, tSandbox))
 	})
 }
+
+func TestProtocolRules(t *testing.T) {
+	tExec := NewExecution()
+	tSandbox := sandbox.MockingSandbox()
+
+	acc, signer := account.GenerateTestAccount(1)
+	tSandbox.Accounts[acc.Address()] = acc
+	hash1 := hash.GenerateTestHash()
+	tSandbox.AppendNewBlock(1, hash1)
+	rcvAddr := crypto.GenerateTestAddress()
+
+	t.Run("Invalid transaction version, Should returns error", func(t *testing.T) {
+		tSandbox.Rules.TxVersion = 2
+		trx := tx.NewSendTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), rcvAddr, 1000, 1000, "")
+		signer.SignMsg(trx)
+		assert.Error(t, tExec.Execute(trx, tSandbox))
+		tSandbox.Rules.TxVersion = 1
+	})
+
+	t.Run("Unsupported protocol version, Should returns error", func(t *testing.T) {
+		tSandbox.Rules.ProtocolVersion = param.ProtocolVersion + 1
+		trx := tx.NewSendTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), rcvAddr, 1000, 1000, "")
+		signer.SignMsg(trx)
+		assert.Error(t, tExec.Execute(trx, tSandbox))
+		tSandbox.Rules.ProtocolVersion = 1
+	})
+
+	t.Run("New transactions before protocol version 2, Should returns error", func(t *testing.T) {
+		trx := tx.NewDelegateTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), rcvAddr, 1000, 1000, "")
+		signer.SignMsg(trx)
+		assert.Error(t, tExec.Execute(trx, tSandbox))
+	})
+
+	t.Run("Vesting before protocol version 2, Should returns error", func(t *testing.T) {
+		vesting := payload.VestingSchedule{StartHeight: 1, CliffHeight: 10, EndHeight: 100}
+		trx := tx.NewVestingSendTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), rcvAddr, 1000, 1000, "", vesting)
+		signer.SignMsg(trx)
+		assert.Error(t, tExec.Execute(trx, tSandbox))
+	})
+
+	t.Run("Commission before protocol version 2, Should returns error", func(t *testing.T) {
+		pub, _ := bls.GenerateTestKeyPair()
+		trx := tx.NewBondTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), pub, 1000, 1000, "")
+		trx.Payload().(*payload.BondPayload).Commission = 1000
+		signer.SignMsg(trx)
+		assert.Error(t, tExec.Execute(trx, tSandbox))
+	})
+
+	t.Run("Higher fee before protocol version 2, Should returns error", func(t *testing.T) {
+		trx := tx.NewSendTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), rcvAddr, 1000, 2000, "")
+		signer.SignMsg(trx)
+		assert.Error(t, tExec.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewSendTx(hash1.Stamp(), acc.Sequence()+1, acc.Address(), rcvAddr, 1000, 1000, "")
+		signer.SignMsg(trx)
+		assert.NoError(t, tExec.Execute(trx, tSandbox))
+	})
+}
+
+func TestMinimumFee(t *testing.T) {
+	assert.Equal(t, MinimumFee(1e8, 0.001, 1000), int64(1e5))
+	assert.Equal(t, MinimumFee(1, 0.001, 1000), int64(1000))
+	assert.Equal(t, MinimumFee(0, 0.001, 0), int64(0))
+}
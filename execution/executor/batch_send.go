@@ -0,0 +1,64 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type BatchSendExecutor struct {
+	fee    int64
+	strict bool
+}
+
+func NewBatchSendExecutor(strict bool) *BatchSendExecutor {
+	return &BatchSendExecutor{strict: strict}
+}
+
+func (e *BatchSendExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.BatchSendPayload)
+
+	senderAcc := sb.Account(pld.Sender)
+	if senderAcc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve sender account")
+	}
+	total := pld.Value()
+	if total > senderAcc.SpendableBalance(sb.CurrentHeight())-trx.Fee() {
+		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
+	}
+	if senderAcc.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence, Expected: %v, got: %v", senderAcc.Sequence()+1, trx.Sequence())
+	}
+
+	senderAcc.IncSequence()
+	senderAcc.SubtractFromBalance(total + trx.Fee())
+
+	// The sender and receivers might be repeated, so we keep one copy of each account
+	accs := map[crypto.Address]*account.Account{pld.Sender: senderAcc}
+	for _, r := range pld.Receivers {
+		receiverAcc, ok := accs[r.Address]
+		if !ok {
+			receiverAcc = sb.Account(r.Address)
+			if receiverAcc == nil {
+				receiverAcc = sb.MakeNewAccount(r.Address)
+			}
+			accs[r.Address] = receiverAcc
+		}
+		receiverAcc.AddToBalance(r.Amount)
+	}
+
+	for _, acc := range accs {
+		sb.UpdateAccount(acc)
+	}
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+func (e *BatchSendExecutor) Fee() int64 {
+	return e.fee
+}
@@ -0,0 +1,76 @@
+package executor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+func TestExecuteBatchSendTx(t *testing.T) {
+	setup(t)
+	exe := NewBatchSendExecutor(true)
+
+	sender := bls.GenerateTestSigner()
+	receiver1 := crypto.GenerateTestAddress()
+	receiver2 := crypto.GenerateTestAddress()
+
+	t.Run("Should fail, Sender has no account", func(t *testing.T) {
+		receivers := []payload.BatchReceiver{{Address: receiver1, Amount: 1000}}
+		trx := tx.NewBatchSendTx(tStamp500000, 1, sender.Address(), receivers, 1000, "non-existing account")
+
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		receivers := []payload.BatchReceiver{
+			{Address: receiver1, Amount: tAcc1Balance / 2},
+			{Address: receiver2, Amount: tAcc1Balance / 2},
+		}
+		trx := tx.NewBatchSendTx(tStamp500000, tSandbox.AccSeq(tAcc1.Address())+1, tAcc1.Address(), receivers, 1000, "insufficient balance")
+
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Total plus fee overflows", func(t *testing.T) {
+		receivers := []payload.BatchReceiver{{Address: receiver1, Amount: math.MaxInt64 - 1e14}}
+		trx := tx.NewBatchSendTx(tStamp500000, tSandbox.AccSeq(tAcc1.Address())+1, tAcc1.Address(), receivers, 1e15, "overflow")
+
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		receivers := []payload.BatchReceiver{{Address: receiver1, Amount: 1000}}
+		trx := tx.NewBatchSendTx(tStamp500000, tSandbox.AccSeq(tAcc1.Address())+2, tAcc1.Address(), receivers, 1000, "invalid sequence")
+
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		seq := tSandbox.AccSeq(tAcc1.Address())
+		receivers := []payload.BatchReceiver{
+			{Address: receiver1, Amount: 1000},
+			{Address: receiver2, Amount: 2000},
+			{Address: receiver1, Amount: 3000},
+			{Address: tAcc1.Address(), Amount: 4000},
+		}
+		trx := tx.NewBatchSendTx(tStamp500000, seq+1, tAcc1.Address(), receivers, 1000, "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		// Replay transaction
+		assert.Error(t, exe.Execute(trx, tSandbox))
+
+		assert.Equal(t, tSandbox.AccSeq(tAcc1.Address()), seq+1)
+		assert.Equal(t, exe.Fee(), int64(1000))
+	})
+
+	assert.Equal(t, tSandbox.Account(tAcc1.Address()).Balance(), tAcc1Balance-7000) // 6000 sent + 1000 fee
+	assert.Equal(t, tSandbox.Account(receiver1).Balance(), int64(4000))
+	assert.Equal(t, tSandbox.Account(receiver2).Balance(), int64(2000))
+
+	checkTotalCoin(t, 1000)
+}
@@ -32,11 +32,14 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	val := sb.Validator(pld.PublicKey.Address())
 	if val == nil {
 		val = sb.MakeNewValidator(pld.PublicKey)
+		val.UpdateCommission(pld.Commission)
+	} else if pld.Commission != 0 && pld.Commission != val.Commission() {
+		return errors.Errorf(errors.ErrInvalidTx, "Commission can only be set by the first bond")
 	}
 	if val.UnbondingHeight() > 0 {
 		return errors.Errorf(errors.ErrInvalidTx, "You cannot Rebond please generate new set of keys")
 	}
-	if bonderAcc.Balance() < pld.Stake+trx.Fee() {
+	if bonderAcc.SpendableBalance(sb.CurrentHeight()) < pld.Stake+trx.Fee() {
 		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
 	}
 
@@ -0,0 +1,74 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type DelegateExecutor struct {
+	fee    int64
+	strict bool
+}
+
+func NewDelegateExecutor(strict bool) *DelegateExecutor {
+	return &DelegateExecutor{strict: strict}
+}
+
+func (e *DelegateExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.DelegatePayload)
+
+	delegatorAcc := sb.Account(pld.Delegator)
+	if delegatorAcc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve delegator account")
+	}
+	if delegatorAcc.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", delegatorAcc.Sequence()+1, trx.Sequence())
+	}
+	if pld.Stake > delegatorAcc.SpendableBalance(sb.CurrentHeight())-trx.Fee() {
+		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
+	}
+	val := sb.Validator(pld.Validator)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has unbonded at height %v", val.UnbondingHeight())
+	}
+	if e.strict && sb.IsInCommittee(pld.Validator) {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator is in committee right now")
+	}
+	d := sb.Delegation(pld.Delegator, pld.Validator)
+	if d == nil {
+		d = sb.MakeNewDelegation(pld.Delegator, pld.Validator)
+	}
+	if d.UnbondingHeight() > 0 {
+		if d.Stake() > 0 {
+			return errors.Errorf(errors.ErrInvalidTx, "Delegation is unbonding, withdraw the stake first")
+		}
+		// The unbonded stake is withdrawn, delegation can start again
+		d.UpdateUnbondingHeight(0)
+	}
+
+	delegatorAcc.IncSequence()
+	delegatorAcc.SubtractFromBalance(pld.Stake + trx.Fee())
+	d.AddToStake(pld.Stake)
+	val.AddToStake(pld.Stake)
+	val.AddToDelegatedStake(pld.Stake)
+	// Only the validator's own bond keeps it out of the sortition.
+	// The delegated stake is locked on the delegation instead.
+	d.UpdateLastBondingHeight(sb.CurrentHeight())
+
+	sb.UpdateAccount(delegatorAcc)
+	sb.UpdateDelegation(d)
+	sb.UpdateValidator(val)
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+func (e *DelegateExecutor) Fee() int64 {
+	return e.fee
+}
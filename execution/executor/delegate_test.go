@@ -0,0 +1,113 @@
+package executor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestExecuteDelegateTx(t *testing.T) {
+	setup(t)
+	exe := NewDelegateExecutor(true)
+
+	delegator := tAcc1.Address()
+	val := tVal1.Address()
+	lastBondingHeight := tVal1.LastBondingHeight()
+
+	t.Run("Should fail, Invalid delegator", func(t *testing.T) {
+		trx := tx.NewDelegateTx(tStamp500000, 1, crypto.GenerateTestAddress(), val, 100000, 1000, "invalid delegator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+2, delegator, val, 100000, 1000, "invalid sequence")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Insufficient balance", func(t *testing.T) {
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, tAcc1Balance, 1000, "insufficient balance")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Stake plus fee overflows", func(t *testing.T) {
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, math.MaxInt64-1e14, 1e15, "overflow")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, crypto.GenerateTestAddress(), 100000, 1000, "invalid validator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Inside committee", func(t *testing.T) {
+		tSandbox.InCommittee = true
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, "inside committee")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		tSandbox.InCommittee = false
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		// Replay
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Unbonded validator", func(t *testing.T) {
+		tVal1.UpdateUnbondingHeight(tSandbox.CurHeight)
+		trx := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, "unbonded validator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+		tVal1.UpdateUnbondingHeight(0)
+	})
+
+	d := tSandbox.Delegation(delegator, val)
+	assert.Equal(t, d.Stake(), int64(100000))
+	assert.Zero(t, d.Reward())
+	assert.Equal(t, tSandbox.Account(delegator).Balance(), tAcc1Balance-(100000+1000))
+	assert.Equal(t, tSandbox.Validator(val).Stake(), tVal1Stake+100000)
+	assert.Equal(t, tSandbox.Validator(val).DelegatedStake(), int64(100000))
+	assert.Equal(t, tSandbox.Validator(val).SelfStake(), tVal1Stake)
+	assert.Equal(t, d.LastBondingHeight(), tSandbox.CurHeight)
+	assert.Equal(t, tSandbox.Validator(val).LastBondingHeight(), lastBondingHeight)
+	assert.Equal(t, exe.Fee(), int64(1000))
+
+	checkTotalCoin(t, 1000)
+}
+
+func TestDelegateAgainAfterWithdraw(t *testing.T) {
+	setup(t)
+	exe := NewDelegateExecutor(true)
+
+	delegator := tAcc1.Address()
+	val := tVal1.Address()
+
+	d := tSandbox.MakeNewDelegation(delegator, val)
+	d.AddToStake(1000)
+	d.UpdateUnbondingHeight(tSandbox.CurHeight - 1)
+	tSandbox.UpdateDelegation(d)
+
+	trx1 := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, "stake is not withdrawn")
+	assert.Error(t, exe.Execute(trx1, tSandbox))
+
+	d.AddToStake(-1000)
+	trx2 := tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, "ok")
+	assert.NoError(t, exe.Execute(trx2, tSandbox))
+	assert.Zero(t, tSandbox.Delegation(delegator, val).UnbondingHeight())
+	assert.Equal(t, tSandbox.Delegation(delegator, val).Stake(), int64(100000))
+}
+
+func TestDelegateNonStrictMode(t *testing.T) {
+	setup(t)
+	exe1 := NewDelegateExecutor(true)
+	exe2 := NewDelegateExecutor(false)
+
+	tSandbox.InCommittee = true
+	trx := tx.NewDelegateTx(tStamp500001, tSandbox.AccSeq(tAcc1.Address())+1, tAcc1.Address(), tVal1.Address(), 1000, 1000, "")
+
+	assert.Error(t, exe1.Execute(trx, tSandbox))
+	assert.NoError(t, exe2.Execute(trx, tSandbox))
+}
@@ -0,0 +1,86 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type ProposalExecutor struct {
+	fee    int64
+	strict bool
+}
+
+func NewProposalExecutor(strict bool) *ProposalExecutor {
+	return &ProposalExecutor{strict: strict}
+}
+
+// Execute submits a new proposal for changing the consensus parameters.
+// The proposer votes for its own proposal.
+// The voting period, until the activation height, can't be longer than the unbonding interval.
+// Each validator can have only one proposal that is not activated yet, and the fee is paid from its self-stake.
+func (e *ProposalExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.ProposalPayload)
+
+	val := sb.Validator(pld.Proposer)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if val.Power() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has no Power to propose")
+	}
+	if val.SelfStake() < trx.Fee() {
+		return errors.Errorf(errors.ErrInsufficientFunds, "Insufficient balance")
+	}
+	if val.ProposalHeight() > sb.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has a proposal that is not activated yet, activation height: %v", val.ProposalHeight())
+	}
+	if pld.ActivationHeight <= sb.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Activation height should be in future")
+	}
+	// Voters can't unbond and withdraw their stake before the activation height
+	if pld.ActivationHeight > sb.CurrentHeight()+sb.UnbondInterval() {
+		return errors.Errorf(errors.ErrInvalidTx, "Voting period is longer than the unbonding interval")
+	}
+
+	val.IncSequence()
+	val.AddToStake(-1 * trx.Fee())
+	val.UpdateProposalHeight(pld.ActivationHeight)
+	sb.UpdateValidator(val)
+
+	p := sb.MakeNewProposal(trx.ID(), pld.Proposer, pld.Changes, pld.ActivationHeight)
+	p.AddVote(val.Address())
+	tally(p, sb)
+	sb.UpdateProposal(p)
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+//Fee will return proposal execution fee
+func (e *ProposalExecutor) Fee() int64 {
+	return e.fee
+}
+
+// tally recomputes the voted power from the current power of the voters,
+// so the voters that have unbonded or slashed since their votes count with their current power.
+// The proposal is approved once more than 2/3 of the total power has voted for it.
+func tally(p *param.Proposal, sb sandbox.Sandbox) {
+	power := int64(0)
+	for _, addr := range p.Voters() {
+		if val := sb.Validator(addr); val != nil {
+			power += val.Power()
+		}
+	}
+	p.UpdateVotedPower(power)
+
+	if power*3 > sb.TotalPower()*2 {
+		p.Approve(sb.CurrentHeight())
+	}
+}
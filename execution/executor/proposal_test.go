@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestExecuteProposalTx(t *testing.T) {
+	setup(t)
+	exe := NewProposalExecutor(true)
+
+	minFee := int64(2000)
+	changes := param.Changes{MinimumFee: &minFee}
+	addr := crypto.GenerateTestAddress()
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, 1, addr, changes, tSandbox.CurHeight+100, 1000, "invalid validator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+2, tVal1.Address(), changes, tSandbox.CurHeight+100, 1000, "invalid sequence")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Activation height is passed", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight, 1000, "passed")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Voting period is too long", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight+tSandbox.UnbondInterval()+1, 1000, "too long")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Insufficient stake", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight+100, tVal1Stake+1, "insufficient stake")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight+100, 1000, "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		// Replay
+		assert.Error(t, exe.Execute(trx, tSandbox))
+
+		p := tSandbox.Proposal(trx.ID())
+		assert.NotNil(t, p)
+		assert.Equal(t, p.Proposer(), tVal1.Address())
+		assert.Equal(t, p.Changes(), changes)
+		assert.Equal(t, p.ActivationHeight(), tSandbox.CurHeight+100)
+		assert.True(t, p.HasVoted(tVal1.Address()))
+		assert.Equal(t, p.VotedPower(), tVal1Stake-1000)
+		// The only validator has proposed it
+		assert.True(t, p.IsApproved())
+		assert.Equal(t, tSandbox.Validator(tVal1.Address()).ProposalHeight(), tSandbox.CurHeight+100)
+	})
+
+	t.Run("Should fail, Validator has an open proposal", func(t *testing.T) {
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight+50, 1000, "open proposal")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Unbonded validator", func(t *testing.T) {
+		tSandbox.Validator(tVal1.Address()).UpdateUnbondingHeight(tSandbox.CurHeight)
+		trx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight+100, 1000, "unbonded")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	assert.Equal(t, exe.Fee(), int64(1000))
+	checkTotalCoin(t, 1000)
+}
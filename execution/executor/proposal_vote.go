@@ -0,0 +1,60 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type ProposalVoteExecutor struct {
+	strict bool
+}
+
+func NewProposalVoteExecutor(strict bool) *ProposalVoteExecutor {
+	return &ProposalVoteExecutor{strict: strict}
+}
+
+// Execute adds the vote of a validator to a proposal and recomputes the tally,
+// weighted by the current power of the voters.
+func (e *ProposalVoteExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.ProposalVotePayload)
+
+	val := sb.Validator(pld.Voter)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if val.Power() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has no Power to vote")
+	}
+	p := sb.Proposal(pld.ProposalID)
+	if p == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve proposal")
+	}
+	if p.IsApproved() {
+		return errors.Errorf(errors.ErrInvalidTx, "Proposal is approved at height %v", p.ApprovedHeight())
+	}
+	if sb.CurrentHeight() >= p.ActivationHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Proposal is expired")
+	}
+	if p.HasVoted(val.Address()) {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator has voted before")
+	}
+
+	p.AddVote(val.Address())
+	tally(p, sb)
+	val.IncSequence()
+
+	sb.UpdateProposal(p)
+	sb.UpdateValidator(val)
+
+	return nil
+}
+
+//Fee will return proposal vote execution fee
+func (e *ProposalVoteExecutor) Fee() int64 {
+	return 0
+}
@@ -0,0 +1,104 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+func TestExecuteProposalVoteTx(t *testing.T) {
+	setup(t)
+	exe := NewProposalVoteExecutor(true)
+
+	vals := []*validator.Validator{tVal1}
+	for i := 0; i < 2; i++ {
+		pub, _ := bls.GenerateTestKeyPair()
+		val := validator.NewValidator(pub, i+1)
+		val.AddToStake(tVal1Stake)
+		tSandbox.UpdateValidator(val)
+		vals = append(vals, val)
+		tTotalCoin += tVal1Stake
+	}
+
+	reward := int64(200000000)
+	changes := param.Changes{BlockReward: &reward}
+	proposalTx := tx.NewProposalTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), changes, tSandbox.CurHeight+100, 1000, "")
+	require.NoError(t, NewProposalExecutor(true).Execute(proposalTx, tSandbox))
+	require.False(t, tSandbox.Proposal(proposalTx.ID()).IsApproved())
+
+	t.Run("Should fail, Invalid proposal", func(t *testing.T) {
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(vals[1].Address())+1, vals[1].Address(), hash.GenerateTestHash(), "invalid proposal")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(vals[1].Address())+2, vals[1].Address(), proposalTx.ID(), "invalid sequence")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Proposer has voted before", func(t *testing.T) {
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), proposalTx.ID(), "voted before")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Two third of power is not enough", func(t *testing.T) {
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(vals[1].Address())+1, vals[1].Address(), proposalTx.ID(), "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		p := tSandbox.Proposal(proposalTx.ID())
+		// The proposer has paid the fee from its stake
+		assert.Equal(t, p.VotedPower(), 2*tVal1Stake-1000)
+		assert.False(t, p.IsApproved())
+	})
+
+	t.Run("Should fail, Proposal is expired", func(t *testing.T) {
+		curHeight := tSandbox.CurHeight
+		tSandbox.CurHeight += 100
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(vals[2].Address())+1, vals[2].Address(), proposalTx.ID(), "expired")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+		tSandbox.CurHeight = curHeight
+	})
+
+	t.Run("Approved", func(t *testing.T) {
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(vals[2].Address())+1, vals[2].Address(), proposalTx.ID(), "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		p := tSandbox.Proposal(proposalTx.ID())
+		assert.True(t, p.IsApproved())
+		assert.Equal(t, p.ApprovedHeight(), tSandbox.CurHeight)
+	})
+
+	t.Run("Should fail, Proposal is approved", func(t *testing.T) {
+		pub, _ := bls.GenerateTestKeyPair()
+		val := validator.NewValidator(pub, 3)
+		val.AddToStake(1)
+		tSandbox.UpdateValidator(val)
+		tTotalCoin++
+
+		trx := tx.NewProposalVoteTx(tStamp500000, tSandbox.ValSeq(val.Address())+1, val.Address(), proposalTx.ID(), "approved")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	assert.Zero(t, exe.Fee())
+	checkTotalCoin(t, 1000)
+}
+
+func TestTallyVotes(t *testing.T) {
+	setup(t)
+
+	p := tSandbox.MakeNewProposal(hash.GenerateTestHash(), tVal1.Address(), param.Changes{}, tSandbox.CurHeight+100)
+	p.AddVote(tVal1.Address())
+	tally(p, tSandbox)
+	assert.Equal(t, p.VotedPower(), tVal1Stake)
+
+	// The voter has unbonded after the vote
+	tSandbox.Validator(tVal1.Address()).UpdateUnbondingHeight(tSandbox.CurHeight)
+	tally(p, tSandbox)
+	assert.Zero(t, p.VotedPower())
+}
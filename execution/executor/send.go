@@ -46,13 +46,25 @@ func (e *SendExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			receiverAcc = sb.MakeNewAccount(pld.Receiver)
 		}
 	}
-	if senderAcc.Balance() < pld.Amount+trx.Fee() {
+	if senderAcc.SpendableBalance(sb.CurrentHeight()) < pld.Amount+trx.Fee() {
 		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
 	}
 	if senderAcc.Sequence()+1 != trx.Sequence() {
 		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence, Expected: %v, got: %v", senderAcc.Sequence()+1, trx.Sequence())
 	}
 
+	if pld.Vesting != nil {
+		err := receiverAcc.AddVesting(account.Vesting{
+			Amount:      pld.Amount,
+			StartHeight: pld.Vesting.StartHeight,
+			CliffHeight: pld.Vesting.CliffHeight,
+			EndHeight:   pld.Vesting.EndHeight,
+		}, sb.CurrentHeight())
+		if err != nil {
+			return errors.Errorf(errors.ErrInvalidTx, err.Error())
+		}
+	}
+
 	senderAcc.IncSequence()
 	senderAcc.SubtractFromBalance(pld.Amount + trx.Fee())
 	receiverAcc.AddToBalance(pld.Amount)
@@ -11,6 +11,7 @@ import (
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -70,6 +71,13 @@ func checkTotalCoin(t *testing.T, fee int64) {
 	for _, val := range tSandbox.Validators {
 		total += val.Stake()
 	}
+	for _, d := range tSandbox.Delegations {
+		// Unbonded stakes are not counted in validators' stake anymore
+		if d.UnbondingHeight() > 0 {
+			total += d.Stake()
+		}
+		total += d.Reward()
+	}
 	assert.Equal(t, total+fee, tTotalCoin)
 }
 
@@ -133,6 +141,60 @@ func TestExecuteSendTx(t *testing.T) {
 	checkTotalCoin(t, 4000)
 }
 
+func TestExecuteVestingSendTx(t *testing.T) {
+	setup(t)
+	exe := NewSendExecutor(true)
+
+	receiver := bls.GenerateTestSigner()
+	other := crypto.GenerateTestAddress()
+	curHeight := tSandbox.CurHeight
+	vesting := payload.VestingSchedule{
+		StartHeight: curHeight,
+		CliffHeight: curHeight + 50,
+		EndHeight:   curHeight + 100,
+	}
+
+	t.Run("Should fail, amount is too low", func(t *testing.T) {
+		trx := tx.NewVestingSendTx(tStamp500000, tSandbox.AccSeq(tAcc1.Address())+1, tAcc1.Address(), receiver.Address(), account.MinVestingAmount-1, 1000, "too low", vesting)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	amount := account.MinVestingAmount
+	trx := tx.NewVestingSendTx(tStamp500000, tSandbox.AccSeq(tAcc1.Address())+1, tAcc1.Address(), receiver.Address(), amount, 1000, "vesting", vesting)
+	assert.NoError(t, exe.Execute(trx, tSandbox))
+
+	acc := tSandbox.Account(receiver.Address())
+	assert.Equal(t, acc.Balance(), amount)
+	assert.Equal(t, acc.LockedBalance(curHeight), amount)
+	assert.Equal(t, len(acc.Vestings()), 1)
+
+	t.Run("Should fail, balance is locked before the cliff", func(t *testing.T) {
+		trx := tx.NewSendTx(tStamp500000, 1, receiver.Address(), other, 1, 1000, "locked")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, spending more than unlocked amount", func(t *testing.T) {
+		tSandbox.CurHeight = curHeight + 75
+		trx := tx.NewSendTx(tStamp500000, 1, receiver.Address(), other, amount*3/4, 1000, "locked")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok, spending unlocked amount", func(t *testing.T) {
+		tSandbox.CurHeight = curHeight + 75
+		trx := tx.NewSendTx(tStamp500000, 1, receiver.Address(), other, amount*3/4-1000, 1000, "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok, fully unlocked", func(t *testing.T) {
+		tSandbox.CurHeight = curHeight + 100
+		trx := tx.NewSendTx(tStamp500000, 2, receiver.Address(), other, amount/4-1000, 1000, "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+	})
+
+	assert.Equal(t, tSandbox.Account(receiver.Address()).Balance(), int64(0))
+	checkTotalCoin(t, 3000)
+}
+
 func TestSendNonStrictMode(t *testing.T) {
 	setup(t)
 	exe1 := NewSendExecutor(true)
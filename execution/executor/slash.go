@@ -0,0 +1,92 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type SlashExecutor struct {
+	strict bool
+}
+
+func NewSlashExecutor(strict bool) *SlashExecutor {
+	return &SlashExecutor{strict: strict}
+}
+
+// Execute punishes a double-signing validator.
+// A fraction of the offender's stake, including the delegated stake, is burned,
+// the offender is ejected from the committee and its stake starts unbonding.
+// The offender can be slashed until its stake is withdrawn, but only once for each offence height.
+func (e *SlashExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.SlashPayload)
+
+	if pld.Reporter.EqualsTo(pld.Evidence.Offender()) {
+		return errors.Errorf(errors.ErrInvalidTx, "Reporter can't be the offender")
+	}
+	reporter := sb.Validator(pld.Reporter)
+	if reporter == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve reporter")
+	}
+	offender := sb.Validator(pld.Evidence.Offender())
+	if offender == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve offender")
+	}
+	if err := pld.Evidence.Verify(offender.PublicKey()); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+	if pld.Evidence.Height() <= offender.SlashedHeight() {
+		return errors.Errorf(errors.ErrInvalidTx, "Offender has slashed for the offence at height %v", offender.SlashedHeight())
+	}
+	if offender.UnbondingHeight() > 0 && offender.Stake() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Offender has withdrawn the stake")
+	}
+	if e.strict {
+		// The reporter might send a sortition transaction with the same sequence number.
+		// In non-strict mode we don't check the sequence number
+		if reporter.Sequence()+1 != trx.Sequence() {
+			return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", reporter.Sequence()+1, trx.Sequence())
+		}
+
+		if sb.IsInCommittee(offender.Address()) {
+			if err := sb.EjectFromCommittee(offender.Address()); err != nil {
+				return errors.Errorf(errors.ErrInvalidTx, err.Error())
+			}
+		}
+	}
+
+	// The delegations that are undelegated after the offence were at stake too
+	for _, d := range sb.ValidatorDelegations(offender.Address()) {
+		if d.UnbondingHeight() > 0 && d.UnbondingHeight() <= pld.Evidence.Height() {
+			continue
+		}
+		burned := int64(float64(d.Stake()) * sb.SlashFraction())
+		if burned == 0 {
+			continue
+		}
+		d.AddToStake(-1 * burned)
+		if d.UnbondingHeight() == 0 {
+			offender.AddToStake(-1 * burned)
+			offender.AddToDelegatedStake(-1 * burned)
+		}
+		sb.UpdateDelegation(d)
+	}
+
+	burned := int64(float64(offender.SelfStake()) * sb.SlashFraction())
+	offender.AddToStake(-1 * burned)
+	offender.UpdateSlashedHeight(pld.Evidence.Height())
+	if offender.UnbondingHeight() == 0 {
+		offender.UpdateUnbondingHeight(sb.CurrentHeight())
+	}
+	reporter.IncSequence()
+
+	sb.UpdateValidator(offender)
+	sb.UpdateValidator(reporter)
+
+	return nil
+}
+
+func (e *SlashExecutor) Fee() int64 {
+	return 0
+}
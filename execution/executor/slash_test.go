@@ -0,0 +1,148 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
+	"github.com/zarbchain/zarb-go/consensus/vote"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+func TestExecuteSlashTx(t *testing.T) {
+	setup(t)
+	exe := NewSlashExecutor(true)
+
+	offenderSigner := bls.GenerateTestSigner()
+	offender := validator.NewValidator(offenderSigner.PublicKey().(*bls.PublicKey), 1)
+	offender.AddToStake(10000)
+	offender.AddToStake(5000)
+	offender.AddToDelegatedStake(5000)
+	tSandbox.UpdateValidator(offender)
+
+	// Active delegation
+	d1 := tSandbox.MakeNewDelegation(crypto.GenerateTestAddress(), offender.Address())
+	d1.AddToStake(5000)
+	tSandbox.UpdateDelegation(d1)
+	// Undelegated after the offence
+	d2 := tSandbox.MakeNewDelegation(crypto.GenerateTestAddress(), offender.Address())
+	d2.AddToStake(2000)
+	d2.UpdateUnbondingHeight(150)
+	tSandbox.UpdateDelegation(d2)
+	// Undelegated before the offence
+	d3 := tSandbox.MakeNewDelegation(crypto.GenerateTestAddress(), offender.Address())
+	d3.AddToStake(1000)
+	d3.UpdateUnbondingHeight(50)
+	tSandbox.UpdateDelegation(d3)
+
+	v1 := vote.NewVote(vote.VoteTypePrecommit, 100, 0, hash.GenerateTestHash(), offender.Address())
+	v2 := vote.NewVote(vote.VoteTypePrecommit, 100, 0, hash.GenerateTestHash(), offender.Address())
+	offenderSigner.SignMsg(v1)
+	offenderSigner.SignMsg(v2)
+	ev := evidence.NewEvidence(v1, v2)
+	reporter := tVal1.Address()
+
+	t.Run("Should fail, Invalid reporter", func(t *testing.T) {
+		pub, _ := bls.GenerateTestKeyPair()
+		trx := tx.NewSlashTx(tStamp500000, 1, pub.Address(), ev)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Reporter is the offender", func(t *testing.T) {
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(offender.Address())+1, offender.Address(), ev)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Unknown offender", func(t *testing.T) {
+		ev2, _ := evidence.GenerateTestEvidence(100, 0)
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(reporter)+1, reporter, ev2)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(reporter)+2, reporter, ev)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		tSandbox.InCommittee = true
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(reporter)+1, reporter, ev)
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+		assert.Equal(t, tSandbox.Ejected[0], offender.Address())
+	})
+
+	t.Run("Should fail, Slashed before", func(t *testing.T) {
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(reporter)+1, reporter, ev)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	slashed := tSandbox.Validator(offender.Address())
+	burned := func(stake int64) int64 { return int64(float64(stake) * tSandbox.SlashFraction()) }
+	assert.Equal(t, slashed.SelfStake(), 10000-burned(10000))
+	assert.Equal(t, slashed.DelegatedStake(), 5000-burned(5000))
+	assert.Equal(t, slashed.Stake(), 15000-burned(10000)-burned(5000))
+	assert.Equal(t, slashed.UnbondingHeight(), tSandbox.CurHeight)
+	assert.Equal(t, slashed.SlashedHeight(), 100)
+	assert.Equal(t, tSandbox.Delegation(d1.Delegator(), offender.Address()).Stake(), 5000-burned(5000))
+	assert.Equal(t, tSandbox.Delegation(d2.Delegator(), offender.Address()).Stake(), 2000-burned(2000))
+	assert.Equal(t, tSandbox.Delegation(d3.Delegator(), offender.Address()).Stake(), int64(1000))
+	assert.Equal(t, tSandbox.ValSeq(reporter), 1)
+	assert.Zero(t, exe.Fee())
+
+	t.Run("Ok, Slashing an unbonding validator for a new offence", func(t *testing.T) {
+		v3 := vote.NewVote(vote.VoteTypePrecommit, 101, 0, hash.GenerateTestHash(), offender.Address())
+		v4 := vote.NewVote(vote.VoteTypePrecommit, 101, 0, hash.GenerateTestHash(), offender.Address())
+		offenderSigner.SignMsg(v3)
+		offenderSigner.SignMsg(v4)
+		ev2 := evidence.NewEvidence(v3, v4)
+
+		tSandbox.CurHeight++
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(reporter)+1, reporter, ev2)
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		slashed := tSandbox.Validator(offender.Address())
+		assert.Equal(t, slashed.SlashedHeight(), 101)
+		assert.Equal(t, slashed.UnbondingHeight(), tSandbox.CurHeight-1)
+	})
+
+	t.Run("Should fail, Stake is withdrawn", func(t *testing.T) {
+		v5 := vote.NewVote(vote.VoteTypePrecommit, 102, 0, hash.GenerateTestHash(), offender.Address())
+		v6 := vote.NewVote(vote.VoteTypePrecommit, 102, 0, hash.GenerateTestHash(), offender.Address())
+		offenderSigner.SignMsg(v5)
+		offenderSigner.SignMsg(v6)
+		ev3 := evidence.NewEvidence(v5, v6)
+
+		withdrawn := tSandbox.Validator(offender.Address())
+		withdrawn.AddToStake(-1 * withdrawn.Stake())
+		withdrawn.AddToDelegatedStake(-1 * withdrawn.DelegatedStake())
+		tSandbox.UpdateValidator(withdrawn)
+
+		trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(reporter)+1, reporter, ev3)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+}
+
+func TestSlashNonStrictMode(t *testing.T) {
+	setup(t)
+	exe1 := NewSlashExecutor(true)
+	exe2 := NewSlashExecutor(false)
+
+	offenderSigner := bls.GenerateTestSigner()
+	offender := validator.NewValidator(offenderSigner.PublicKey().(*bls.PublicKey), 1)
+	tSandbox.UpdateValidator(offender)
+
+	v1 := vote.NewVote(vote.VoteTypePrepare, 100, 0, hash.GenerateTestHash(), offender.Address())
+	v2 := vote.NewVote(vote.VoteTypePrepare, 100, 0, hash.GenerateTestHash(), offender.Address())
+	offenderSigner.SignMsg(v1)
+	offenderSigner.SignMsg(v2)
+	ev := evidence.NewEvidence(v1, v2)
+
+	trx := tx.NewSlashTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+2, tVal1.Address(), ev)
+	assert.Error(t, exe1.Execute(trx, tSandbox))
+	assert.NoError(t, exe2.Execute(trx, tSandbox))
+	assert.Empty(t, tSandbox.Ejected)
+}
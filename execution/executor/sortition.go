@@ -26,6 +26,9 @@ func (e *SortitionExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if val.Power() == 0 {
 		return errors.Errorf(errors.ErrInvalidTx, "Validator has no Power to be in committee")
 	}
+	if val.IsJailed() {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator is jailed at height %v", val.JailedHeight())
+	}
 	if sb.CurrentHeight()-val.LastBondingHeight() < sb.BondInterval() {
 		return errors.Errorf(errors.ErrInvalidTx, "In bonding period")
 	}
@@ -64,10 +64,15 @@ func TestExecuteSortitionTx(t *testing.T) {
 		tSandbox.Validator(tVal1.Address()).UpdateUnbondingHeight(3)
 		assert.Error(t, exe.Execute(trx, tSandbox))
 
+		// Check if validator is jailed
+		tSandbox.Validator(tVal1.Address()).UpdateUnbondingHeight(0)
+		tSandbox.Validator(tVal1.Address()).Jail(3)
+		assert.Error(t, exe.Execute(trx, tSandbox))
+
 		// Sounds good
 		tSandbox.AcceptSortition = true
 		tSandbox.WelcomeToCommittee = true
-		tSandbox.Validator(tVal1.Address()).UpdateUnbondingHeight(0)
+		tSandbox.Validator(tVal1.Address()).Unjail()
 		assert.NoError(t, exe.Execute(trx, tSandbox))
 
 		// replay
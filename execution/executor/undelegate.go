@@ -0,0 +1,72 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type UndelegateExecutor struct {
+	fee    int64
+	strict bool
+}
+
+func NewUndelegateExecutor(strict bool) *UndelegateExecutor {
+	return &UndelegateExecutor{strict: strict}
+}
+
+// Execute starts unbonding the delegated stake.
+// The stake stops earning rewards and can be withdrawn after the unbonding interval.
+func (e *UndelegateExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.UndelegatePayload)
+
+	delegatorAcc := sb.Account(pld.Delegator)
+	if delegatorAcc == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve delegator account")
+	}
+	if delegatorAcc.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", delegatorAcc.Sequence()+1, trx.Sequence())
+	}
+	if delegatorAcc.SpendableBalance(sb.CurrentHeight()) < trx.Fee() {
+		return errors.Errorf(errors.ErrInvalidTx, "Insufficient balance")
+	}
+	d := sb.Delegation(pld.Delegator, pld.Validator)
+	if d == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve delegation")
+	}
+	if d.UnbondingHeight() > 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Delegation has unbonded at height %v", d.UnbondingHeight())
+	}
+	if d.Stake() == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Nothing to undelegate")
+	}
+	if sb.CurrentHeight()-d.LastBondingHeight() < sb.BondInterval() {
+		return errors.Errorf(errors.ErrInvalidTx, "Delegated stake is locked until height %v", d.LastBondingHeight()+sb.BondInterval())
+	}
+	if e.strict && sb.IsInCommittee(pld.Validator) {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator is in committee right now")
+	}
+	val := sb.Validator(pld.Validator)
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+
+	delegatorAcc.IncSequence()
+	delegatorAcc.SubtractFromBalance(trx.Fee())
+	val.AddToStake(-1 * d.Stake())
+	val.AddToDelegatedStake(-1 * d.Stake())
+	d.UpdateUnbondingHeight(sb.CurrentHeight())
+
+	sb.UpdateAccount(delegatorAcc)
+	sb.UpdateDelegation(d)
+	sb.UpdateValidator(val)
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+func (e *UndelegateExecutor) Fee() int64 {
+	return e.fee
+}
@@ -0,0 +1,67 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestExecuteUndelegateTx(t *testing.T) {
+	setup(t)
+	exe := NewUndelegateExecutor(true)
+
+	delegator := tAcc1.Address()
+	val := tVal1.Address()
+
+	t.Run("Should fail, No delegation", func(t *testing.T) {
+		trx := tx.NewUndelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 1000, "no delegation")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	assert.NoError(t, NewDelegateExecutor(true).Execute(
+		tx.NewDelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, ""), tSandbox))
+
+	t.Run("Should fail, Invalid delegator", func(t *testing.T) {
+		trx := tx.NewUndelegateTx(tStamp500000, 1, crypto.GenerateTestAddress(), val, 1000, "invalid delegator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewUndelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+2, delegator, val, 1000, "invalid sequence")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Inside committee", func(t *testing.T) {
+		tSandbox.InCommittee = true
+		trx := tx.NewUndelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 1000, "inside committee")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Stake is locked", func(t *testing.T) {
+		tSandbox.InCommittee = false
+		trx := tx.NewUndelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 1000, "stake is locked")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		tSandbox.CurHeight += tSandbox.BondInterval()
+		trx := tx.NewUndelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 1000, "ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Already unbonded", func(t *testing.T) {
+		trx := tx.NewUndelegateTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 1000, "already unbonded")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	d := tSandbox.Delegation(delegator, val)
+	assert.Equal(t, d.Stake(), int64(100000))
+	assert.Equal(t, d.UnbondingHeight(), tSandbox.CurHeight)
+	assert.Equal(t, tSandbox.Validator(val).Stake(), tVal1Stake)
+	assert.Zero(t, tSandbox.Validator(val).DelegatedStake())
+	assert.Equal(t, exe.Fee(), int64(1000))
+
+	checkTotalCoin(t, 2000)
+}
@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+type UnjailExecutor struct {
+	strict bool
+}
+
+func NewUnjailExecutor(strict bool) *UnjailExecutor {
+	return &UnjailExecutor{strict: strict}
+}
+
+// Execute releases a jailed validator.
+// The validator should stay in the jail at least for one downtime window.
+func (e *UnjailExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.UnjailPayload)
+
+	val := sb.Validator(pld.Signer())
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid sequence. Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if !val.IsJailed() {
+		return errors.Errorf(errors.ErrInvalidTx, "Validator is not jailed")
+	}
+	if sb.CurrentHeight()-val.JailedHeight() < sb.DowntimeWindow() {
+		return errors.Errorf(errors.ErrInvalidTx, "In jail period")
+	}
+
+	val.IncSequence()
+	val.Unjail()
+	sb.UpdateValidator(val)
+
+	return nil
+}
+
+//Fee will return unjail execution fee
+func (e *UnjailExecutor) Fee() int64 {
+	return 0
+}
@@ -0,0 +1,54 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestExecuteUnjailTx(t *testing.T) {
+	setup(t)
+	exe := NewUnjailExecutor(true)
+
+	addr := crypto.GenerateTestAddress()
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx := tx.NewUnjailTx(tStamp500000, 1, addr, "invalid validator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewUnjailTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+2, tVal1.Address(), "invalid sequence")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Not jailed", func(t *testing.T) {
+		trx := tx.NewUnjailTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), "not jailed")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, In jail period", func(t *testing.T) {
+		tSandbox.Validator(tVal1.Address()).Jail(tSandbox.CurHeight - tSandbox.DowntimeWindow() + 1)
+		trx := tx.NewUnjailTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), "in jail period")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		tSandbox.Validator(tVal1.Address()).Jail(tSandbox.CurHeight - tSandbox.DowntimeWindow())
+		trx := tx.NewUnjailTx(tStamp500000, tSandbox.ValSeq(tVal1.Address())+1, tVal1.Address(), "Ok")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		// Replay
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	val := tSandbox.Validator(tVal1.Address())
+	assert.False(t, val.IsJailed())
+	assert.Zero(t, val.JailedHeight())
+	assert.Zero(t, val.MissedBlocks())
+	assert.Zero(t, exe.Fee())
+
+	checkTotalCoin(t, 0)
+}
@@ -27,7 +27,8 @@ func (e *WithdrawExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if val.Sequence()+1 != trx.Sequence() {
 		return errors.Errorf(errors.ErrInvalidSequence, "Invalid sequence, Expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
 	}
-	if val.Stake() < pld.Amount+trx.Fee() {
+	// The delegated stake belongs to the delegators
+	if val.SelfStake() < pld.Amount+trx.Fee() {
 		return errors.Errorf(errors.ErrInsufficientFunds, "Insufficient balance")
 	}
 	if val.UnbondingHeight() == 0 {
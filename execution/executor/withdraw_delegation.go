@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type WithdrawDelegationExecutor struct {
+	fee    int64
+	strict bool
+}
+
+func NewWithdrawDelegationExecutor(strict bool) *WithdrawDelegationExecutor {
+	return &WithdrawDelegationExecutor{strict: strict}
+}
+
+// Execute withdraws the earned rewards and, after the unbonding interval, the unbonded stake.
+// The fee is paid from the withdrawn amount and the rest goes to the delegator account.
+func (e *WithdrawDelegationExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.WithdrawDelegationPayload)
+
+	delegatorAcc := sb.Account(pld.Delegator)
+	if delegatorAcc == nil {
+		return errors.Errorf(errors.ErrInvalidAddress, "Unable to retrieve delegator account")
+	}
+	if delegatorAcc.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidSequence, "Invalid sequence, Expected: %v, got: %v", delegatorAcc.Sequence()+1, trx.Sequence())
+	}
+	d := sb.Delegation(pld.Delegator, pld.Validator)
+	if d == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Unable to retrieve delegation")
+	}
+
+	withdrawable := d.Reward()
+	if d.UnbondingHeight() > 0 && sb.CurrentHeight() >= d.UnbondingHeight()+sb.UnbondInterval() {
+		withdrawable += d.Stake()
+	}
+	// Subtracting the fee can't overflow, unlike adding it to the amount
+	if pld.Amount > withdrawable-trx.Fee() {
+		return errors.Errorf(errors.ErrInsufficientFunds, "Insufficient balance")
+	}
+
+	// Rewards are withdrawn first
+	total := pld.Amount + trx.Fee()
+	fromReward := util.Min64(total, d.Reward())
+	d.AddToReward(-1 * fromReward)
+	d.AddToStake(-1 * (total - fromReward))
+
+	delegatorAcc.IncSequence()
+	delegatorAcc.AddToBalance(pld.Amount)
+
+	sb.UpdateDelegation(d)
+	sb.UpdateAccount(delegatorAcc)
+
+	e.fee = trx.Fee()
+
+	return nil
+}
+
+func (e *WithdrawDelegationExecutor) Fee() int64 {
+	return e.fee
+}
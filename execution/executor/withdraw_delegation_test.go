@@ -0,0 +1,84 @@
+package executor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestExecuteWithdrawDelegationTx(t *testing.T) {
+	setup(t)
+	exe := NewWithdrawDelegationExecutor(true)
+
+	delegator := tAcc1.Address()
+	val := tVal1.Address()
+
+	d := tSandbox.MakeNewDelegation(delegator, val)
+	d.AddToStake(100000)
+	d.AddToReward(5000)
+	tSandbox.UpdateDelegation(d)
+	tVal1.AddToStake(100000)
+	tVal1.AddToDelegatedStake(100000)
+	tTotalCoin += 105000
+
+	t.Run("Should fail, Invalid delegator", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, 1, crypto.GenerateTestAddress(), val, 1000, 1000, "invalid delegator")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+2, delegator, val, 1000, 1000, "invalid sequence")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, No delegation", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, crypto.GenerateTestAddress(), 1000, 1000, "no delegation")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Stake is not unbonded", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 5000, 1000, "more than reward")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should fail, Amount plus fee overflows", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, math.MaxInt64-1e14, 1e15, "overflow")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	t.Run("Should pass, Withdraw reward", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 3000, 1000, "withdraw reward")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		assert.Equal(t, tSandbox.Delegation(delegator, val).Reward(), int64(1000))
+		assert.Equal(t, tSandbox.Delegation(delegator, val).Stake(), int64(100000))
+	})
+
+	// Undelegating
+	tVal1.AddToStake(-100000)
+	tVal1.AddToDelegatedStake(-100000)
+	d.UpdateUnbondingHeight(tSandbox.CurHeight - tSandbox.UnbondInterval() + 1)
+
+	t.Run("Should fail, hasn't passed unbonding interval", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 1000, 1000, "not passed unbonding interval")
+		assert.Error(t, exe.Execute(trx, tSandbox))
+	})
+
+	d.UpdateUnbondingHeight(tSandbox.CurHeight - tSandbox.UnbondInterval())
+
+	t.Run("Should pass, Withdraw reward and stake", func(t *testing.T) {
+		trx := tx.NewWithdrawDelegationTx(tStamp500000, tSandbox.AccSeq(delegator)+1, delegator, val, 100000, 1000, "withdraw all")
+		assert.NoError(t, exe.Execute(trx, tSandbox))
+
+		assert.Zero(t, tSandbox.Delegation(delegator, val).Reward())
+		assert.Zero(t, tSandbox.Delegation(delegator, val).Stake())
+	})
+
+	assert.Equal(t, tSandbox.Account(delegator).Balance(), tAcc1Balance+103000)
+	assert.Equal(t, exe.Fee(), int64(1000))
+
+	checkTotalCoin(t, 2000)
+}
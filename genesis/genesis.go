@@ -31,10 +31,11 @@ type Genesis struct {
 }
 
 type genesisData struct {
-	GenesisTime time.Time      `cbor:"1,keyasint"`
-	Params      param.Params   `cbor:"2,keyasint"`
-	Accounts    []genAccount   `cbor:"3,keyasint"`
-	Validators  []genValidator `cbor:"4,keyasint"`
+	GenesisTime time.Time       `cbor:"1,keyasint"`
+	Params      param.Params    `cbor:"2,keyasint"`
+	Accounts    []genAccount    `cbor:"3,keyasint"`
+	Validators  []genValidator  `cbor:"4,keyasint"`
+	Upgrades    []param.Upgrade `cbor:"5,keyasint,omitempty" json:",omitempty"`
 }
 
 func (gen *Genesis) Hash() hash.Hash {
@@ -53,6 +54,11 @@ func (gen *Genesis) Params() param.Params {
 	return gen.data.Params
 }
 
+// Upgrades returns the scheduled protocol upgrades.
+func (gen *Genesis) Upgrades() []param.Upgrade {
+	return gen.data.Upgrades
+}
+
 func (gen *Genesis) Accounts() []*account.Account {
 	accs := make([]*account.Account, 0)
 	for i, genAcc := range gen.data.Accounts {
@@ -97,7 +103,7 @@ func makeGenesisValidator(val *validator.Validator) genValidator {
 
 func MakeGenesis(genesisTime time.Time,
 	accounts []*account.Account,
-	validators []*validator.Validator, params param.Params) *Genesis {
+	validators []*validator.Validator, params param.Params, upgrades ...param.Upgrade) *Genesis {
 
 	genAccs := make([]genAccount, 0, len(accounts))
 	for _, acc := range accounts {
@@ -117,6 +123,7 @@ func MakeGenesis(genesisTime time.Time,
 			Accounts:    genAccs,
 			Validators:  genVals,
 			Params:      params,
+			Upgrades:    upgrades,
 		},
 	}
 }
@@ -131,6 +138,9 @@ func LoadFromFile(file string) (*Genesis, error) {
 	if err := json.Unmarshal(dat, &gen); err != nil {
 		return nil, err
 	}
+	if err := param.CheckUpgrades(gen.Upgrades()); err != nil {
+		return nil, err
+	}
 	return &gen, nil
 }
 
@@ -39,6 +39,33 @@ func TestMarshaling(t *testing.T) {
 	require.Equal(t, gen1.Hash(), gen3.Hash())
 }
 
+func TestUpgradeSchedule(t *testing.T) {
+	acc, _ := account.GenerateTestAccount(0)
+	val, _ := validator.GenerateTestValidator(0)
+	upgrade := param.Upgrade{Version: 2, Height: 1000, BlockVersion: 2, TxVersion: 1}
+	gen1 := MakeGenesis(util.Now(), []*account.Account{acc}, []*validator.Validator{val}, param.DefaultParams())
+	gen2 := MakeGenesis(gen1.GenesisTime(), []*account.Account{acc}, []*validator.Validator{val}, param.DefaultParams(), upgrade)
+
+	assert.Empty(t, gen1.Upgrades())
+	assert.Equal(t, gen2.Upgrades(), []param.Upgrade{upgrade})
+	assert.NotEqual(t, gen1.Hash(), gen2.Hash())
+
+	f := util.TempFilePath()
+	assert.NoError(t, gen2.SaveToFile(f))
+	gen3, err := LoadFromFile(f)
+	assert.NoError(t, err)
+	assert.Equal(t, gen2.Hash(), gen3.Hash())
+
+	t.Run("Invalid upgrade schedule", func(t *testing.T) {
+		upgrade.Version = 1
+		gen := MakeGenesis(util.Now(), []*account.Account{acc}, []*validator.Validator{val}, param.DefaultParams(), upgrade)
+		f := util.TempFilePath()
+		assert.NoError(t, gen.SaveToFile(f))
+		_, err := LoadFromFile(f)
+		assert.Error(t, err)
+	})
+}
+
 func TestGenesisTestNet(t *testing.T) {
 	g := Testnet()
 	assert.Equal(t, len(g.Validators()), 4)
@@ -23,6 +23,7 @@ require (
 	github.com/pelletier/go-toml v1.9.0
 	github.com/peterh/liner v1.2.1
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.11.0
 	github.com/rakyll/statik v0.1.7
 	github.com/sirupsen/logrus v1.8.0
 	github.com/stretchr/testify v1.7.0
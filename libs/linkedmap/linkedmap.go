@@ -114,6 +114,10 @@ func (lm *LinkedMap) Size() int {
 	return lm.list.Len()
 }
 
+func (lm *LinkedMap) Capacity() int {
+	return lm.capacity
+}
+
 func (lm *LinkedMap) Full() bool {
 	return lm.list.Len() == lm.capacity
 }
@@ -52,6 +52,12 @@ func NewTreeFromSlices(slices [][]byte) *Tree {
 	return NewTreeFromHashes(hashes)
 }
 
+// NewTree returns an empty tree. Leaves can be added or updated later by
+// calling SetHash.
+func NewTree() *Tree {
+	return &Tree{}
+}
+
 func NewTreeFromHashes(hashes []hash.Hash) *Tree {
 	if len(hashes) == 0 {
 		return nil
@@ -66,35 +72,88 @@ func NewTreeFromHashes(hashes []hash.Hash) *Tree {
 		merkles[i] = &hashes[i]
 	}
 
+	tree := &Tree{merkles: merkles}
+	tree.build()
+
+	return tree
+}
+
+// build calculates all the parent nodes from the leaves.
+func (tree *Tree) build() {
+	arraySize := len(tree.merkles)
+	merkles := tree.merkles
+
 	// Start the array offset after the last transaction and adjusted to the
 	// next power of two.
-	offset := nextPoT
+	offset := tree.capacity()
 	for i := 0; i < arraySize-1; i += 2 {
-		switch {
-		// When there is no left child node, the parent is nil too.
-		case merkles[i] == nil:
-			merkles[offset] = nil
-
-		// When there is no right child, the parent is generated by
-		// hashing the concatenation of the left child with itself.
-		case merkles[i+1] == nil:
-			newHash := HashMerkleBranches(merkles[i], merkles[i])
-			merkles[offset] = newHash
-
-		// The normal case sets the parent node to the double sha256
-		// of the concatenation of the left and right children.
-		default:
-			newHash := HashMerkleBranches(merkles[i], merkles[i+1])
-			merkles[offset] = newHash
-		}
+		merkles[offset] = parentHash(merkles[i], merkles[i+1])
 		offset++
 	}
+}
+
+// parentHash returns the parent node of the given left and right nodes.
+func parentHash(left, right *hash.Hash) *hash.Hash {
+	switch {
+	// When there is no left child node, the parent is nil too.
+	case left == nil:
+		return nil
+
+	// When there is no right child, the parent is generated by
+	// hashing the concatenation of the left child with itself.
+	case right == nil:
+		return HashMerkleBranches(left, left)
+
+	// The normal case sets the parent node to the double sha256
+	// of the concatenation of the left and right children.
+	default:
+		return HashMerkleBranches(left, right)
+	}
+}
+
+// capacity returns the number of leaves that tree can hold without growing.
+func (tree *Tree) capacity() int {
+	return (len(tree.merkles) + 1) / 2
+}
+
+// SetHash sets the hash of the leaf at the given index and updates only the
+// nodes on the path from that leaf to the root. If the index is out of the
+// tree capacity, the tree grows to the next power of two and it is rebuilt.
+func (tree *Tree) SetHash(index int, h hash.Hash) {
+	if index >= tree.capacity() {
+		tree.grow(index + 1)
+	}
+
+	tree.merkles[index] = &h
+
+	levelStart := 0
+	levelSize := tree.capacity()
+	pos := index
+	for levelSize > 1 {
+		leftPos := pos &^ 1
+		left := tree.merkles[levelStart+leftPos]
+		right := tree.merkles[levelStart+leftPos+1]
+
+		levelStart += levelSize
+		levelSize /= 2
+		pos /= 2
+
+		tree.merkles[levelStart+pos] = parentHash(left, right)
+	}
+}
+
+// grow extends the tree to hold at least the given number of leaves.
+func (tree *Tree) grow(leaves int) {
+	nextPoT := nextPowerOfTwo(leaves)
+	merkles := make([]*hash.Hash, nextPoT*2-1)
+	copy(merkles, tree.merkles[:tree.capacity()])
 
-	return &Tree{merkles: merkles}
+	tree.merkles = merkles
+	tree.build()
 }
 
 func (tree *Tree) Root() hash.Hash {
-	if tree == nil {
+	if tree == nil || len(tree.merkles) == 0 {
 		return hash.UndefHash
 	}
 	h := tree.merkles[len(tree.merkles)-1]
@@ -105,7 +164,7 @@ func (tree *Tree) Root() hash.Hash {
 }
 
 func (tree *Tree) Depth() int {
-	if tree == nil {
+	if tree == nil || len(tree.merkles) == 0 {
 		return 0
 	}
 	return int(math.Log2(float64(len(tree.merkles))))
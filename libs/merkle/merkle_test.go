@@ -131,3 +131,36 @@ func TestMerkleTree_Bitcoin_Block153342(t *testing.T) {
 	assert.True(t, root.EqualsTo(*root2))
 
 }
+
+func TestIncrementalTree(t *testing.T) {
+	hasher = hash.CalcHash
+
+	hashes := []hash.Hash{}
+	tree := NewTree()
+	assert.Equal(t, tree.Root(), hash.UndefHash)
+	assert.Equal(t, tree.Depth(), 0)
+
+	for i := 0; i < 21; i++ {
+		h := strToHash(fmt.Sprintf("%d", i))
+		hashes = append(hashes, h)
+		tree.SetHash(i, h)
+
+		assert.Equal(t, tree.Root(), NewTreeFromHashes(hashes).Root(), "invalid root for %v leaves", i+1)
+	}
+
+	t.Run("Update a leaf", func(t *testing.T) {
+		h := strToHash("updated")
+		hashes[7] = h
+		tree.SetHash(7, h)
+
+		assert.Equal(t, tree.Root(), NewTreeFromHashes(hashes).Root())
+	})
+
+	t.Run("Update the last leaf", func(t *testing.T) {
+		h := strToHash("last")
+		hashes[20] = h
+		tree.SetHash(20, h)
+
+		assert.Equal(t, tree.Root(), NewTreeFromHashes(hashes).Root())
+	})
+}
@@ -0,0 +1,75 @@
+package simplemerkle
+
+import (
+	"github.com/zarbchain/zarb-go/crypto/hash"
+)
+
+// Proof is a merkle branch that proves a leaf is included in a tree.
+// Bit i of Index indicates the position of the node at level i:
+// zero means it is the left child and one means it is the right child.
+type Proof struct {
+	Index    int
+	Siblings []hash.Hash
+}
+
+// Proof returns the merkle branch for the leaf at the given index.
+// It returns nil if there is no leaf at this index.
+func (tree *Tree) Proof(index int) *Proof {
+	if tree == nil || index < 0 || index >= tree.capacity() {
+		return nil
+	}
+	proof := &Proof{Index: index}
+	levelStart := 0
+	levelSize := tree.capacity()
+	pos := index
+	for levelSize > 1 {
+		node := tree.merkles[levelStart+pos]
+		if node == nil {
+			return nil
+		}
+		sibling := tree.merkles[levelStart+(pos^1)]
+		if sibling == nil {
+			// The parent is the hash of the node with itself
+			sibling = node
+		}
+		proof.Siblings = append(proof.Siblings, *sibling)
+
+		levelStart += levelSize
+		levelSize /= 2
+		pos /= 2
+	}
+
+	if tree.merkles[levelStart+pos] == nil {
+		return nil
+	}
+
+	return proof
+}
+
+// AppendSibling extends the proof one level above the current root.
+// If isLeft is true, the sibling is placed at the left side.
+func (proof *Proof) AppendSibling(sibling hash.Hash, isLeft bool) {
+	if isLeft {
+		proof.Index |= 1 << len(proof.Siblings)
+	}
+	proof.Siblings = append(proof.Siblings, sibling)
+}
+
+// Root calculates the merkle root for the given leaf.
+func (proof *Proof) Root(leaf hash.Hash) hash.Hash {
+	node := &leaf
+	for i, sibling := range proof.Siblings {
+		s := sibling
+		if proof.Index>>i&1 == 1 {
+			node = HashMerkleBranches(&s, node)
+		} else {
+			node = HashMerkleBranches(node, &s)
+		}
+	}
+	return *node
+}
+
+// Verify checks if the leaf is included in a tree with the given root.
+func (proof *Proof) Verify(leaf, root hash.Hash) bool {
+	return proof.Root(leaf).EqualsTo(root)
+}
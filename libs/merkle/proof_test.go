@@ -0,0 +1,59 @@
+package simplemerkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+)
+
+func TestProof(t *testing.T) {
+	hasher = hash.CalcHash
+
+	hashes := []hash.Hash{}
+	for i := 0; i < 13; i++ {
+		hashes = append(hashes, strToHash(fmt.Sprintf("%d", i)))
+	}
+	tree := NewTreeFromHashes(hashes)
+	root := tree.Root()
+
+	t.Run("Valid proofs", func(t *testing.T) {
+		for i, h := range hashes {
+			proof := tree.Proof(i)
+			assert.NotNil(t, proof)
+			assert.Equal(t, len(proof.Siblings), tree.Depth())
+			assert.True(t, proof.Verify(h, root), "invalid proof for %v", i)
+		}
+	})
+
+	t.Run("Invalid leaf", func(t *testing.T) {
+		proof := tree.Proof(3)
+		assert.False(t, proof.Verify(hashes[4], root))
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		assert.Nil(t, tree.Proof(-1))
+		assert.Nil(t, tree.Proof(13))
+		assert.Nil(t, tree.Proof(16))
+	})
+
+	t.Run("Single leaf", func(t *testing.T) {
+		tree := NewTreeFromHashes(hashes[:1])
+		proof := tree.Proof(0)
+		assert.Empty(t, proof.Siblings)
+		assert.True(t, proof.Verify(hashes[0], tree.Root()))
+	})
+
+	t.Run("Append sibling", func(t *testing.T) {
+		other := strToHash("other")
+
+		proof1 := tree.Proof(5)
+		proof1.AppendSibling(other, false)
+		assert.True(t, proof1.Verify(hashes[5], *HashMerkleBranches(&root, &other)))
+
+		proof2 := tree.Proof(5)
+		proof2.AppendSibling(other, true)
+		assert.True(t, proof2.Verify(hashes[5], *HashMerkleBranches(&other, &root)))
+	})
+}
@@ -0,0 +1,25 @@
+package metrics
+
+type Config struct {
+	Enable  bool   `toml:"" comment:"Enable the Prometheus metrics server."`
+	Address string `toml:"" comment:"Address of the metrics server. Metrics are served at /metrics."`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:  false,
+		Address: "[::]:9091",
+	}
+}
+
+func TestConfig() *Config {
+	return &Config{
+		Enable:  true,
+		Address: "[::]:0",
+	}
+}
+
+// SanityCheck is a basic checks for config
+func (conf *Config) SanityCheck() error {
+	return nil
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+const namespace = "zarb"
+
+var (
+	registry = prometheus.NewRegistry()
+
+	blockHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "block_height",
+		Help:      "Height of the last committed block.",
+	})
+	blockInterval = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "block_interval_seconds",
+		Help:      "Time between two committed blocks.",
+		Buckets:   []float64{5, 10, 15, 20, 30, 60, 120, 300},
+	})
+	blockTransactions = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "block_transactions",
+		Help:      "Number of transactions per committed block.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	})
+	consensusHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "consensus_height",
+		Help:      "Height that the consensus engine is working on.",
+	})
+	consensusRound = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "consensus_round",
+		Help:      "Round of the consensus engine in the current height.",
+	})
+	txPoolSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "txpool_size",
+		Help:      "Number of the pending transactions in the pool per payload type.",
+	}, []string{"type"})
+	peers = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "peers",
+		Help:      "Number of the peers per status.",
+	}, []string{"status"})
+	bundlesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bundles_received_total",
+		Help:      "Number of the received bundles per message type.",
+	}, []string{"type"})
+	bundlesInvalid = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bundles_invalid_total",
+		Help:      "Number of the invalid bundles per message type.",
+	}, []string{"type"})
+	storeWriteDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "store_write_duration_seconds",
+		Help:      "Latency of writing a batch into the store.",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+	})
+	sortitionWins = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sortition_wins_total",
+		Help:      "Number of the times this validator is chosen by the sortition.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		blockHeight,
+		blockInterval,
+		blockTransactions,
+		consensusHeight,
+		consensusRound,
+		txPoolSize,
+		peers,
+		bundlesReceived,
+		bundlesInvalid,
+		storeWriteDuration,
+		sortitionWins,
+	)
+}
+
+func SetTxPoolSize(t payload.Type, size int) {
+	txPoolSize.WithLabelValues(t.String()).Set(float64(size))
+}
+
+func SetPeers(status string, count int) {
+	peers.WithLabelValues(status).Set(float64(count))
+}
+
+func BundleReceived(msgType string) {
+	bundlesReceived.WithLabelValues(msgType).Inc()
+}
+
+func BundleInvalid(msgType string) {
+	bundlesInvalid.WithLabelValues(msgType).Inc()
+}
+
+func ObserveStoreWrite(d time.Duration) {
+	storeWriteDuration.Observe(d.Seconds())
+}
+
+func SortitionWon() {
+	sortitionWins.Inc()
+}
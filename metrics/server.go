@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/zarbchain/zarb-go/event"
+	"github.com/zarbchain/zarb-go/logger"
+)
+
+type Server struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	config        *Config
+	eventBus      *event.Bus
+	listener      net.Listener
+	lastBlockTime time.Time
+	logger        *logger.Logger
+}
+
+func NewServer(conf *Config, eventBus *event.Bus) (*Server, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Server{
+		ctx:      ctx,
+		cancel:   cancel,
+		config:   conf,
+		eventBus: eventBus,
+		logger:   logger.NewLogger("_metrics", nil),
+	}, nil
+}
+
+func (s *Server) StartServer() error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	l, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	s.logger.Info("metrics server started listening", "address", l.Addr().String())
+	s.listener = l
+	go func() {
+		if err := http.Serve(l, mux); err != nil {
+			s.logger.Debug("metrics server stopped", "err", err)
+		}
+	}()
+
+	sub := s.eventBus.Subscribe(event.TypeBlockCommitted, event.TypeRoundChanged)
+	go s.eventLoop(sub)
+
+	return nil
+}
+
+func (s *Server) StopServer() {
+	s.cancel()
+
+	if s.listener != nil {
+		s.listener.Close()
+	}
+}
+
+func (s *Server) eventLoop(sub *event.Subscription) {
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+
+		case e := <-sub.Events():
+			s.processEvent(e)
+		}
+	}
+}
+
+func (s *Server) processEvent(e event.Event) {
+	switch e.Type() {
+	case event.TypeBlockCommitted:
+		be := e.(*event.BlockCommittedEvent)
+		blockTime := be.Block.Header().Time()
+		if !s.lastBlockTime.IsZero() {
+			blockInterval.Observe(blockTime.Sub(s.lastBlockTime).Seconds())
+		}
+		s.lastBlockTime = blockTime
+		blockHeight.Set(float64(be.Height))
+		blockTransactions.Observe(float64(be.Block.TxIDs().Len()))
+
+	case event.TypeRoundChanged:
+		re := e.(*event.RoundChangedEvent)
+		consensusHeight.Set(float64(re.Height))
+		consensusRound.Set(float64(re.Round))
+	}
+}
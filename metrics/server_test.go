@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/event"
+	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+func init() {
+	logger.InitLogger(logger.TestConfig())
+}
+
+func scrape(t *testing.T, s *Server) string {
+	res, err := http.Get(fmt.Sprintf("http://%s/metrics", s.listener.Addr().String()))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestDisabledServer(t *testing.T) {
+	conf := TestConfig()
+	conf.Enable = false
+	s, err := NewServer(conf, event.NewBus())
+	require.NoError(t, err)
+	assert.NoError(t, s.StartServer())
+	assert.Nil(t, s.listener)
+	s.StopServer()
+}
+
+func TestMetricsServer(t *testing.T) {
+	bus := event.NewBus()
+	s, err := NewServer(TestConfig(), bus)
+	require.NoError(t, err)
+	require.NoError(t, s.StartServer())
+	defer s.StopServer()
+
+	b1, _ := block.GenerateTestBlock(nil, nil)
+	bus.Publish(&event.BlockCommittedEvent{Height: 41, Block: b1})
+	b2, _ := block.GenerateTestBlock(nil, nil)
+	bus.Publish(&event.BlockCommittedEvent{Height: 42, Block: b2})
+	bus.Publish(&event.RoundChangedEvent{Height: 43, Round: 2})
+
+	SetTxPoolSize(payload.PayloadTypeSend, 7)
+	SetPeers("known", 3)
+	BundleReceived("vote")
+	BundleInvalid("vote")
+	ObserveStoreWrite(time.Millisecond)
+	SortitionWon()
+
+	assert.Eventually(t, func() bool {
+		return strings.Contains(scrape(t, s), "zarb_consensus_round 2")
+	}, time.Second, 10*time.Millisecond)
+
+	out := scrape(t, s)
+	assert.Contains(t, out, "zarb_block_height 42")
+	assert.Contains(t, out, "zarb_block_interval_seconds_count 1")
+	assert.Contains(t, out, "zarb_block_transactions_count 2")
+	assert.Contains(t, out, "zarb_consensus_height 43")
+	assert.Contains(t, out, `zarb_txpool_size{type="send"} 7`)
+	assert.Contains(t, out, `zarb_peers{status="known"} 3`)
+	assert.Contains(t, out, `zarb_bundles_received_total{type="vote"} 1`)
+	assert.Contains(t, out, `zarb_bundles_invalid_total{type="vote"} 1`)
+	assert.Contains(t, out, "zarb_store_write_duration_seconds_count 1")
+	assert.Contains(t, out, "zarb_sortition_wins_total 1")
+}
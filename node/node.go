@@ -7,8 +7,10 @@ import (
 	"github.com/zarbchain/zarb-go/config"
 	"github.com/zarbchain/zarb-go/consensus"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/genesis"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/metrics"
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/store"
@@ -33,19 +35,32 @@ type Node struct {
 	capnp      *capnp.Server
 	http       *http.Server
 	grpc       *grpc.Server
+	metrics    *metrics.Server
+	eventBus   *event.Bus
 }
 
 func NewNode(genDoc *genesis.Genesis, conf *config.Config, signer crypto.Signer) (*Node, error) {
 	// Init logger
 	logger.InitLogger(conf.Logger)
 
+	if conf.Store.RetainBlocks > 0 {
+		// Stamps of the transactions should be resolved.
+		// If the interval is increased by the proposals, the store keeps more blocks.
+		if conf.Store.RetainBlocks < genDoc.Params().TransactionToLiveInterval {
+			return nil, errors.Errorf("retain blocks should be at least %v", genDoc.Params().TransactionToLiveInterval)
+		}
+		// A pruned node is not able to serve the complete blockchain
+		conf.Sync.NodeNetwork = false
+	}
+
 	network, err := network.NewNetwork(conf.Network)
 	if err != nil {
 		return nil, err
 	}
 	broadcastCh := make(chan message.Message, 100)
+	eventBus := event.NewBus()
 
-	txPool, err := txpool.NewTxPool(conf.TxPool, broadcastCh)
+	txPool, err := txpool.NewTxPool(conf.TxPool, broadcastCh, eventBus)
 	if err != nil {
 		return nil, err
 	}
@@ -55,17 +70,17 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config, signer crypto.Signer)
 		return nil, err
 	}
 
-	state, err := state.LoadOrNewState(conf.State, genDoc, signer, store, txPool)
+	state, err := state.LoadOrNewState(conf.State, genDoc, signer, store, txPool, eventBus)
 	if err != nil {
 		return nil, err
 	}
 
-	consensus, err := consensus.NewConsensus(conf.Consensus, state, signer, broadcastCh)
+	consensus, err := consensus.NewConsensus(conf.Consensus, state, signer, broadcastCh, eventBus)
 	if err != nil {
 		return nil, err
 	}
 
-	sync, err := sync.NewSynchronizer(conf.Sync, signer, state, consensus, network, broadcastCh)
+	sync, err := sync.NewSynchronizer(conf.Sync, signer, state, consensus, network, broadcastCh, eventBus)
 	if err != nil {
 		return nil, err
 	}
@@ -80,11 +95,16 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config, signer crypto.Signer)
 		return nil, errors.Wrap(err, "could not create http server")
 	}
 
-	grpc, err := grpc.NewServer(conf.GRPC, state, sync)
+	grpc, err := grpc.NewServer(conf.GRPC, state, sync, eventBus)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create grpc server")
 	}
 
+	metrics, err := metrics.NewServer(conf.Metrics, eventBus)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create metrics server")
+	}
+
 	node := &Node{
 		config:     conf,
 		genesisDoc: genDoc,
@@ -97,6 +117,8 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config, signer crypto.Signer)
 		capnp:      capnp,
 		http:       http,
 		grpc:       grpc,
+		metrics:    metrics,
+		eventBus:   eventBus,
 	}
 
 	return node, nil
@@ -139,6 +161,11 @@ func (n *Node) Start() error {
 		return errors.Wrap(err, "could not start grpc server")
 	}
 
+	err = n.metrics.StartServer()
+	if err != nil {
+		return errors.Wrap(err, "could not start metrics server")
+	}
+
 	return nil
 }
 
@@ -153,6 +180,7 @@ func (n *Node) Stop() {
 	n.http.StopServer()
 	n.capnp.StopServer()
 	n.grpc.StopServer()
+	n.metrics.StopServer()
 }
 
 func (n *Node) Consensus() consensus.Reader {
@@ -164,3 +192,6 @@ func (n *Node) Sync() sync.Synchronizer {
 func (n *Node) State() state.Facade {
 	return n.state
 }
+func (n *Node) EventBus() *event.Bus {
+	return n.eventBus
+}
@@ -36,3 +36,17 @@ func TestRunningNode(t *testing.T) {
 	require.NoError(t, err)
 	n.Stop()
 }
+
+func TestInvalidRetainBlocks(t *testing.T) {
+	pub, pv := bls.RandomKeyPair()
+	acc := account.NewAccount(crypto.TreasuryAddress, 0)
+	acc.AddToBalance(21 * 1e14)
+	val := validator.NewValidator(pub, 0)
+	gen := genesis.MakeGenesis(util.Now(), []*account.Account{acc}, []*validator.Validator{val}, param.DefaultParams())
+	conf := config.DefaultConfig()
+	conf.Store.Path = util.TempDirPath()
+	conf.Store.RetainBlocks = gen.Params().TransactionToLiveInterval - 1
+
+	_, err := NewNode(gen, conf, crypto.NewSigner(pv))
+	assert.Error(t, err)
+}
@@ -0,0 +1,40 @@
+package param
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// Changes are the tunable parameters that a proposal changes.
+// The parameters that are not set are kept unchanged,
+// so the proposals that change different parameters don't override each other.
+// The keys are same as the keys of the parameters.
+type Changes struct {
+	CommitteeSize              *int     `cbor:"3,keyasint,omitempty" json:",omitempty"`
+	BlockReward                *int64   `cbor:"4,keyasint,omitempty" json:",omitempty"`
+	TransactionToLiveInterval  *int     `cbor:"5,keyasint,omitempty" json:",omitempty"`
+	BondInterval               *int     `cbor:"6,keyasint,omitempty" json:",omitempty"`
+	UnbondInterval             *int     `cbor:"7,keyasint,omitempty" json:",omitempty"`
+	MaximumTransactionPerBlock *int     `cbor:"8,keyasint,omitempty" json:",omitempty"`
+	MaximumMemoLength          *int     `cbor:"9,keyasint,omitempty" json:",omitempty"`
+	FeeFraction                *float64 `cbor:"10,keyasint,omitempty" json:",omitempty"`
+	MinimumFee                 *int64   `cbor:"11,keyasint,omitempty" json:",omitempty"`
+	SlashFraction              *float64 `cbor:"12,keyasint,omitempty" json:",omitempty"`
+	DowntimeWindow             *int     `cbor:"13,keyasint,omitempty" json:",omitempty"`
+	MaxMissedBlocks            *int     `cbor:"14,keyasint,omitempty" json:",omitempty"`
+}
+
+// IsEmpty returns true if no parameter is changed.
+func (c Changes) IsEmpty() bool {
+	return c == Changes{}
+}
+
+// SanityCheck checks the changed parameters.
+// Each parameter is checked on its own, by applying it to the default parameters.
+// The tuned parameters are checked again on activation.
+func (c Changes) SanityCheck() error {
+	if c.IsEmpty() {
+		return errors.Errorf(errors.ErrInvalidParams, "no parameter is changed")
+	}
+	p := DefaultParams().Tune(c)
+	return p.SanityCheck()
+}
@@ -1,6 +1,11 @@
 package param
 
-import "time"
+import (
+	"math"
+	"time"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
 
 type Params struct {
 	BlockVersion               int     `cbor:"1,keyasint"`
@@ -14,6 +19,9 @@ type Params struct {
 	MaximumMemoLength          int     `cbor:"9,keyasint"`
 	FeeFraction                float64 `cbor:"10,keyasint"`
 	MinimumFee                 int64   `cbor:"11,keyasint"`
+	SlashFraction              float64 `cbor:"12,keyasint,omitempty"`
+	DowntimeWindow             int     `cbor:"13,keyasint,omitempty"`
+	MaxMissedBlocks            int     `cbor:"14,keyasint,omitempty"`
 }
 
 func DefaultParams() Params {
@@ -29,9 +37,97 @@ func DefaultParams() Params {
 		MaximumMemoLength:          1024,
 		FeeFraction:                0.001,
 		MinimumFee:                 1000,
+		SlashFraction:              0.1,
+		DowntimeWindow:             720, // two hours
+		MaxMissedBlocks:            360,
 	}
 }
 
 func (p *Params) BlockTime() time.Duration {
 	return time.Duration(p.BlockTimeInSecond) * time.Second
 }
+
+// Tune returns a copy of the parameters with the changed parameters applied.
+// The block version is controlled by the protocol upgrades and the block time can't change
+// in a running chain, so proposals can't change them.
+func (p Params) Tune(c Changes) Params {
+	if c.CommitteeSize != nil {
+		p.CommitteeSize = *c.CommitteeSize
+	}
+	if c.BlockReward != nil {
+		p.BlockReward = *c.BlockReward
+	}
+	if c.TransactionToLiveInterval != nil {
+		p.TransactionToLiveInterval = *c.TransactionToLiveInterval
+	}
+	if c.BondInterval != nil {
+		p.BondInterval = *c.BondInterval
+	}
+	if c.UnbondInterval != nil {
+		p.UnbondInterval = *c.UnbondInterval
+	}
+	if c.MaximumTransactionPerBlock != nil {
+		p.MaximumTransactionPerBlock = *c.MaximumTransactionPerBlock
+	}
+	if c.MaximumMemoLength != nil {
+		p.MaximumMemoLength = *c.MaximumMemoLength
+	}
+	if c.FeeFraction != nil {
+		p.FeeFraction = *c.FeeFraction
+	}
+	if c.MinimumFee != nil {
+		p.MinimumFee = *c.MinimumFee
+	}
+	if c.SlashFraction != nil {
+		p.SlashFraction = *c.SlashFraction
+	}
+	if c.DowntimeWindow != nil {
+		p.DowntimeWindow = *c.DowntimeWindow
+	}
+	if c.MaxMissedBlocks != nil {
+		p.MaxMissedBlocks = *c.MaxMissedBlocks
+	}
+	return p
+}
+
+// SanityCheck checks the parameters are valid to run the blockchain
+func (p *Params) SanityCheck() error {
+	if p.BlockVersion <= 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid block version")
+	}
+	if p.BlockTimeInSecond <= 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid block time")
+	}
+	if p.CommitteeSize < 4 {
+		return errors.Errorf(errors.ErrInvalidParams, "committee size should be at least 4")
+	}
+	if p.BlockReward < 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid block reward")
+	}
+	if p.TransactionToLiveInterval <= 0 ||
+		p.BondInterval < 0 ||
+		p.UnbondInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid interval")
+	}
+	if p.MaximumTransactionPerBlock <= 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid maximum transaction per block")
+	}
+	if p.MaximumMemoLength < 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid maximum memo length")
+	}
+	// Comparisons with NaN are always false, so the non-finite fractions are checked first
+	if !isFinite(p.FeeFraction) || p.FeeFraction < 0 || p.FeeFraction >= 1 || p.MinimumFee < 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid fee")
+	}
+	if !isFinite(p.SlashFraction) || p.SlashFraction < 0 || p.SlashFraction > 1 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid slash fraction")
+	}
+	if p.DowntimeWindow < 0 || p.MaxMissedBlocks < 0 {
+		return errors.Errorf(errors.ErrInvalidParams, "invalid downtime window")
+	}
+	return nil
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
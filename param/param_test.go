@@ -0,0 +1,105 @@
+package param
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsSanityCheck(t *testing.T) {
+	p := DefaultParams()
+	assert.NoError(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.CommitteeSize = 3
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.BlockTimeInSecond = 0
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.FeeFraction = 1
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.MinimumFee = -1
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.SlashFraction = 1.1
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.MaximumTransactionPerBlock = 0
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.FeeFraction = math.NaN()
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.FeeFraction = math.Inf(-1)
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.SlashFraction = math.NaN()
+	assert.Error(t, p.SanityCheck())
+
+	p = DefaultParams()
+	p.SlashFraction = math.Inf(1)
+	assert.Error(t, p.SanityCheck())
+}
+
+func TestParamsTune(t *testing.T) {
+	p := DefaultParams()
+	committeeSize := 51
+	reward := int64(1)
+	maxMissedBlocks := 10
+	changes := Changes{
+		CommitteeSize:   &committeeSize,
+		BlockReward:     &reward,
+		MaxMissedBlocks: &maxMissedBlocks,
+	}
+
+	tuned := p.Tune(changes)
+	assert.Equal(t, tuned.BlockVersion, p.BlockVersion)
+	assert.Equal(t, tuned.BlockTimeInSecond, p.BlockTimeInSecond)
+	assert.Equal(t, tuned.CommitteeSize, 51)
+	assert.Equal(t, tuned.BlockReward, int64(1))
+	assert.Equal(t, tuned.MaxMissedBlocks, 10)
+	assert.NoError(t, tuned.SanityCheck())
+
+	// The parameters that are not changed are kept
+	tuned.CommitteeSize = p.CommitteeSize
+	tuned.BlockReward = p.BlockReward
+	tuned.MaxMissedBlocks = p.MaxMissedBlocks
+	assert.Equal(t, tuned, p)
+	assert.Equal(t, p.Tune(Changes{}), p)
+}
+
+func TestOverlappingChanges(t *testing.T) {
+	p := DefaultParams()
+	committeeSize := 51
+	minFee := int64(2000)
+
+	// The second changes don't reset the committee size that is changed by the first ones
+	tuned := p.Tune(Changes{CommitteeSize: &committeeSize}).Tune(Changes{MinimumFee: &minFee})
+	assert.Equal(t, tuned.CommitteeSize, 51)
+	assert.Equal(t, tuned.MinimumFee, int64(2000))
+}
+
+func TestChangesSanityCheck(t *testing.T) {
+	assert.Error(t, Changes{}.SanityCheck())
+
+	committeeSize := 3
+	assert.Error(t, Changes{CommitteeSize: &committeeSize}.SanityCheck())
+
+	committeeSize = 4
+	assert.NoError(t, Changes{CommitteeSize: &committeeSize}.SanityCheck())
+
+	nan := math.NaN()
+	assert.Error(t, Changes{FeeFraction: &nan}.SanityCheck())
+	assert.Error(t, Changes{SlashFraction: &nan}.SanityCheck())
+}
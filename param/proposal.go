@@ -0,0 +1,129 @@
+package param
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+)
+
+// Proposal is a request for changing the consensus parameters.
+// Validators vote for a proposal, weighted by their power.
+// A proposal is approved once more than 2/3 of the total power has voted for it,
+// and the new parameters become effective at the activation height.
+// Only the tunable parameters that are set in the proposal are changed, see Params.Tune.
+type Proposal struct {
+	data proposalData
+}
+
+type proposalData struct {
+	Number           int              `cbor:"1,keyasint"`
+	ID               hash.Hash        `cbor:"2,keyasint"`
+	Proposer         crypto.Address   `cbor:"3,keyasint"`
+	Changes          Changes          `cbor:"4,keyasint"`
+	ActivationHeight int              `cbor:"5,keyasint"`
+	Voters           []crypto.Address `cbor:"6,keyasint"`
+	VotedPower       int64            `cbor:"7,keyasint"`
+	ApprovedHeight   int              `cbor:"8,keyasint"`
+}
+
+func NewProposal(id hash.Hash, proposer crypto.Address, changes Changes, activationHeight int, number int) *Proposal {
+	return &Proposal{
+		data: proposalData{
+			Number:           number,
+			ID:               id,
+			Proposer:         proposer,
+			Changes:          changes,
+			ActivationHeight: activationHeight,
+			Voters:           make([]crypto.Address, 0),
+		},
+	}
+}
+
+func (p *Proposal) Number() int              { return p.data.Number }
+func (p *Proposal) ID() hash.Hash            { return p.data.ID }
+func (p *Proposal) Proposer() crypto.Address { return p.data.Proposer }
+func (p *Proposal) Changes() Changes         { return p.data.Changes }
+func (p *Proposal) ActivationHeight() int    { return p.data.ActivationHeight }
+func (p *Proposal) Voters() []crypto.Address { return p.data.Voters }
+func (p *Proposal) VotedPower() int64        { return p.data.VotedPower }
+func (p *Proposal) ApprovedHeight() int      { return p.data.ApprovedHeight }
+func (p *Proposal) IsApproved() bool         { return p.data.ApprovedHeight > 0 }
+
+// HasVoted returns true if the validator has voted for this proposal before
+func (p *Proposal) HasVoted(addr crypto.Address) bool {
+	for _, v := range p.data.Voters {
+		if v.EqualsTo(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddVote adds the vote of a validator
+func (p *Proposal) AddVote(addr crypto.Address) {
+	p.data.Voters = append(p.data.Voters, addr)
+}
+
+// UpdateVotedPower updates the total power of the voters
+func (p *Proposal) UpdateVotedPower(power int64) {
+	p.data.VotedPower = power
+}
+
+// Approve marks the proposal as approved at the given height
+func (p *Proposal) Approve(height int) {
+	p.data.ApprovedHeight = height
+}
+
+// Hash return the hash of this proposal
+func (p *Proposal) Hash() hash.Hash {
+	bs, err := p.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return hash.CalcHash(bs)
+}
+
+func (p Proposal) Encode() ([]byte, error) {
+	return cbor.Marshal(p.data)
+}
+
+func (p *Proposal) Decode(bs []byte) error {
+	return cbor.Unmarshal(bs, &p.data)
+}
+
+func (p *Proposal) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(p.data)
+}
+
+func (p *Proposal) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &p.data)
+}
+
+func (p Proposal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.data)
+}
+
+func (p *Proposal) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &p.data)
+}
+
+func (p Proposal) Fingerprint() string {
+	return fmt.Sprintf("{%s 👤 %s ⏰ %v 🗳 %v}",
+		p.data.ID.Fingerprint(),
+		p.data.Proposer.Fingerprint(),
+		p.data.ActivationHeight,
+		len(p.data.Voters))
+}
+
+// GenerateTestProposal generates a proposal for testing purpose
+func GenerateTestProposal(number int) *Proposal {
+	reward := int64(200000000)
+	changes := Changes{BlockReward: &reward}
+	p := NewProposal(hash.GenerateTestHash(), crypto.GenerateTestAddress(), changes, 100000, number)
+	p.AddVote(crypto.GenerateTestAddress())
+	p.UpdateVotedPower(1000)
+	return p
+}
@@ -0,0 +1,66 @@
+package param
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestProposalMarshaling(t *testing.T) {
+	p1 := GenerateTestProposal(5)
+	p1.Approve(100)
+
+	bs, err := p1.Encode()
+	require.NoError(t, err)
+	p2 := new(Proposal)
+	require.NoError(t, p2.Decode(bs))
+	assert.Equal(t, p1.Hash(), p2.Hash())
+	assert.Equal(t, p1, p2)
+
+	js, err := json.Marshal(p1)
+	require.NoError(t, err)
+	p3 := new(Proposal)
+	require.NoError(t, json.Unmarshal(js, p3))
+	assert.Equal(t, p1, p3)
+
+	p4 := new(Proposal)
+	assert.Error(t, p4.Decode([]byte("asdfghjkl")))
+}
+
+func TestProposalVotes(t *testing.T) {
+	p := GenerateTestProposal(1)
+	addr := crypto.GenerateTestAddress()
+
+	assert.False(t, p.HasVoted(addr))
+	assert.False(t, p.IsApproved())
+
+	p.AddVote(addr)
+	p.UpdateVotedPower(1500)
+	assert.True(t, p.HasVoted(addr))
+	assert.Equal(t, p.VotedPower(), int64(1500))
+	assert.Len(t, p.Voters(), 2)
+
+	p.Approve(10)
+	assert.True(t, p.IsApproved())
+	assert.Equal(t, p.ApprovedHeight(), 10)
+}
+
+func TestDecodingProposalWithAllParams(t *testing.T) {
+	// A proposal that is encoded with all the parameters changes all the tunable parameters
+	type proposalWithParams struct {
+		Number int    `cbor:"1,keyasint"`
+		Params Params `cbor:"4,keyasint"`
+	}
+	params := DefaultParams()
+	params.MinimumFee = 2000
+	bs, err := cbor.Marshal(proposalWithParams{Number: 1, Params: params})
+	require.NoError(t, err)
+
+	p := new(Proposal)
+	require.NoError(t, p.Decode(bs))
+	assert.Equal(t, DefaultParams().Tune(p.Changes()), params)
+}
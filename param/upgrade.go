@@ -0,0 +1,68 @@
+package param
+
+import (
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// ProtocolVersion is the latest protocol version that this node supports.
+// The first version of the protocol is activated at genesis.
+// Version 2 adds the delegations and proposals trees to the state hash,
+// batch send, delegation, slashing, jailing, governance and multi-signature transactions,
+// vesting, validators' commission, the downtime jailing and the protocol version signalling.
+const ProtocolVersion = 2
+
+// Upgrade schedules a protocol upgrade.
+// From the upgrade height, blocks and transactions should follow the rules of the new protocol version.
+type Upgrade struct {
+	Version      int `cbor:"1,keyasint"`
+	Height       int `cbor:"2,keyasint"`
+	BlockVersion int `cbor:"3,keyasint"`
+	TxVersion    int `cbor:"4,keyasint"`
+}
+
+// Rules defines the protocol rules at a specific height.
+type Rules struct {
+	ProtocolVersion int
+	BlockVersion    int
+	TxVersion       int
+}
+
+// CheckUpgrades checks the upgrade schedule is valid.
+// Upgrades should be sorted by their heights and protocol versions.
+func CheckUpgrades(upgrades []Upgrade) error {
+	lastVersion := 1
+	lastHeight := 0
+	for _, u := range upgrades {
+		if u.Version <= lastVersion {
+			return errors.Errorf(errors.ErrInvalidParams, "invalid upgrade version: %v", u.Version)
+		}
+		if u.Height <= lastHeight {
+			return errors.Errorf(errors.ErrInvalidParams, "invalid upgrade height: %v", u.Height)
+		}
+		if u.BlockVersion <= 0 || u.TxVersion <= 0 {
+			return errors.Errorf(errors.ErrInvalidParams, "invalid upgrade block or transaction version")
+		}
+		lastVersion = u.Version
+		lastHeight = u.Height
+	}
+	return nil
+}
+
+// RulesAt returns the protocol rules at the given height.
+// Before the first upgrade, the block version is defined by the parameters.
+func RulesAt(params Params, upgrades []Upgrade, height int) Rules {
+	rules := Rules{
+		ProtocolVersion: 1,
+		BlockVersion:    params.BlockVersion,
+		TxVersion:       1,
+	}
+	for _, u := range upgrades {
+		if u.Height > height {
+			break
+		}
+		rules.ProtocolVersion = u.Version
+		rules.BlockVersion = u.BlockVersion
+		rules.TxVersion = u.TxVersion
+	}
+	return rules
+}
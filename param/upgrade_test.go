@@ -0,0 +1,58 @@
+package param
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUpgrades(t *testing.T) {
+	assert.NoError(t, CheckUpgrades(nil))
+	assert.NoError(t, CheckUpgrades([]Upgrade{
+		{Version: 2, Height: 100, BlockVersion: 2, TxVersion: 1},
+		{Version: 3, Height: 200, BlockVersion: 2, TxVersion: 2},
+	}))
+
+	t.Run("Invalid version", func(t *testing.T) {
+		assert.Error(t, CheckUpgrades([]Upgrade{
+			{Version: 1, Height: 100, BlockVersion: 2, TxVersion: 1},
+		}))
+		assert.Error(t, CheckUpgrades([]Upgrade{
+			{Version: 3, Height: 100, BlockVersion: 2, TxVersion: 1},
+			{Version: 2, Height: 200, BlockVersion: 2, TxVersion: 1},
+		}))
+	})
+
+	t.Run("Invalid height", func(t *testing.T) {
+		assert.Error(t, CheckUpgrades([]Upgrade{
+			{Version: 2, Height: 0, BlockVersion: 2, TxVersion: 1},
+		}))
+		assert.Error(t, CheckUpgrades([]Upgrade{
+			{Version: 2, Height: 100, BlockVersion: 2, TxVersion: 1},
+			{Version: 3, Height: 100, BlockVersion: 2, TxVersion: 1},
+		}))
+	})
+
+	t.Run("Invalid block or transaction version", func(t *testing.T) {
+		assert.Error(t, CheckUpgrades([]Upgrade{
+			{Version: 2, Height: 100, BlockVersion: 0, TxVersion: 1},
+		}))
+		assert.Error(t, CheckUpgrades([]Upgrade{
+			{Version: 2, Height: 100, BlockVersion: 1, TxVersion: 0},
+		}))
+	})
+}
+
+func TestRulesAt(t *testing.T) {
+	params := DefaultParams()
+	upgrades := []Upgrade{
+		{Version: 2, Height: 100, BlockVersion: 2, TxVersion: 1},
+		{Version: 3, Height: 200, BlockVersion: 3, TxVersion: 2},
+	}
+
+	assert.Equal(t, RulesAt(params, nil, 1000), Rules{ProtocolVersion: 1, BlockVersion: params.BlockVersion, TxVersion: 1})
+	assert.Equal(t, RulesAt(params, upgrades, 99), Rules{ProtocolVersion: 1, BlockVersion: params.BlockVersion, TxVersion: 1})
+	assert.Equal(t, RulesAt(params, upgrades, 100), Rules{ProtocolVersion: 2, BlockVersion: 2, TxVersion: 1})
+	assert.Equal(t, RulesAt(params, upgrades, 199), Rules{ProtocolVersion: 2, BlockVersion: 2, TxVersion: 1})
+	assert.Equal(t, RulesAt(params, upgrades, 200), Rules{ProtocolVersion: 3, BlockVersion: 3, TxVersion: 2})
+}
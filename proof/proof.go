@@ -0,0 +1,67 @@
+package proof
+
+import (
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// VerifyTransaction verifies that the encoded transaction is included in the block.
+// The block hash should be taken from a trusted source, like a certified block.
+func VerifyTransaction(data, headerData []byte, proof *simplemerkle.Proof, blockHash hash.Hash) (*tx.Tx, error) {
+	trx := new(tx.Tx)
+	if err := trx.Decode(data); err != nil {
+		return nil, err
+	}
+	header := new(block.Header)
+	if err := header.UnmarshalCBOR(headerData); err != nil {
+		return nil, err
+	}
+	if !header.Hash().EqualsTo(blockHash) {
+		return nil, errors.Errorf(errors.ErrInvalidProof, "block header doesn't match the block hash")
+	}
+	if err := verify(proof, trx.ID(), header.TxIDsHash()); err != nil {
+		return nil, err
+	}
+	return trx, nil
+}
+
+// VerifyAccount verifies that the encoded account is included in the state.
+// The state hash should be taken from a trusted block header.
+func VerifyAccount(data []byte, proof *simplemerkle.Proof, stateHash hash.Hash) (*account.Account, error) {
+	acc := new(account.Account)
+	if err := acc.Decode(data); err != nil {
+		return nil, err
+	}
+	if err := verify(proof, acc.Hash(), stateHash); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// VerifyValidator verifies that the encoded validator is included in the state.
+// The state hash should be taken from a trusted block header.
+func VerifyValidator(data []byte, proof *simplemerkle.Proof, stateHash hash.Hash) (*validator.Validator, error) {
+	val := new(validator.Validator)
+	if err := val.Decode(data); err != nil {
+		return nil, err
+	}
+	if err := verify(proof, val.Hash(), stateHash); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+func verify(proof *simplemerkle.Proof, leaf, root hash.Hash) error {
+	if proof == nil {
+		return errors.Errorf(errors.ErrInvalidProof, "no proof")
+	}
+	if !proof.Verify(leaf, root) {
+		return errors.Errorf(errors.ErrInvalidProof, "leaf is not included in the merkle root")
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+func testTree(leaf hash.Hash, index int) *simplemerkle.Tree {
+	hashes := make([]hash.Hash, 4)
+	for i := range hashes {
+		hashes[i] = hash.GenerateTestHash()
+	}
+	hashes[index] = leaf
+	return simplemerkle.NewTreeFromHashes(hashes)
+}
+
+func TestVerifyAccount(t *testing.T) {
+	acc, _ := account.GenerateTestAccount(1)
+	data, err := acc.Encode()
+	require.NoError(t, err)
+	tree := testTree(acc.Hash(), 2)
+	root := tree.Root()
+
+	t.Run("Valid proof", func(t *testing.T) {
+		acc2, err := VerifyAccount(data, tree.Proof(2), root)
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Hash(), acc2.Hash())
+	})
+
+	t.Run("Tampered sibling", func(t *testing.T) {
+		p := tree.Proof(2)
+		p.Siblings[1] = hash.GenerateTestHash()
+		_, err := VerifyAccount(data, p, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong index", func(t *testing.T) {
+		p := tree.Proof(2)
+		p.Index = 3
+		_, err := VerifyAccount(data, p, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong root", func(t *testing.T) {
+		_, err := VerifyAccount(data, tree.Proof(2), hash.GenerateTestHash())
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		_, err := VerifyAccount([]byte("asdfghjkl"), tree.Proof(2), root)
+		assert.Error(t, err)
+	})
+
+	t.Run("No proof", func(t *testing.T) {
+		_, err := VerifyAccount(data, nil, root)
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyValidator(t *testing.T) {
+	val, _ := validator.GenerateTestValidator(1)
+	data, err := val.Encode()
+	require.NoError(t, err)
+	tree := testTree(val.Hash(), 1)
+	root := tree.Root()
+
+	t.Run("Valid proof", func(t *testing.T) {
+		val2, err := VerifyValidator(data, tree.Proof(1), root)
+		assert.NoError(t, err)
+		assert.Equal(t, val.Hash(), val2.Hash())
+	})
+
+	t.Run("Tampered sibling", func(t *testing.T) {
+		p := tree.Proof(1)
+		p.Siblings[0] = hash.GenerateTestHash()
+		_, err := VerifyValidator(data, p, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong index", func(t *testing.T) {
+		p := tree.Proof(1)
+		p.Index = 0
+		_, err := VerifyValidator(data, p, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong root", func(t *testing.T) {
+		_, err := VerifyValidator(data, tree.Proof(1), hash.GenerateTestHash())
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyTransaction(t *testing.T) {
+	b, txs := block.GenerateTestBlock(nil, nil)
+	trx := txs[1]
+	data, err := trx.Encode()
+	require.NoError(t, err)
+	header, err := b.Header().MarshalCBOR()
+	require.NoError(t, err)
+	blockHash := b.Hash()
+
+	t.Run("Valid proof", func(t *testing.T) {
+		trx2, err := VerifyTransaction(data, header, b.TxIDs().Proof(trx.ID()), blockHash)
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID(), trx2.ID())
+	})
+
+	t.Run("Tampered sibling", func(t *testing.T) {
+		p := b.TxIDs().Proof(trx.ID())
+		p.Siblings[0] = hash.GenerateTestHash()
+		_, err := VerifyTransaction(data, header, p, blockHash)
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong index", func(t *testing.T) {
+		p := b.TxIDs().Proof(trx.ID())
+		p.Index ^= 1
+		_, err := VerifyTransaction(data, header, p, blockHash)
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong root", func(t *testing.T) {
+		_, err := VerifyTransaction(data, header, b.TxIDs().Proof(trx.ID()), hash.GenerateTestHash())
+		assert.Error(t, err)
+	})
+
+	t.Run("Tampered header", func(t *testing.T) {
+		b2, _ := block.GenerateTestBlock(nil, nil)
+		header2, _ := b2.Header().MarshalCBOR()
+		_, err := VerifyTransaction(data, header2, b.TxIDs().Proof(trx.ID()), blockHash)
+		assert.Error(t, err)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -19,8 +20,19 @@ type Sandbox interface {
 	UpdateValidator(*validator.Validator)
 	IsInCommittee(crypto.Address) bool
 
+	Delegation(delegator, val crypto.Address) *validator.Delegation
+	MakeNewDelegation(delegator, val crypto.Address) *validator.Delegation
+	UpdateDelegation(*validator.Delegation)
+	ValidatorDelegations(val crypto.Address) []*validator.Delegation
+
+	Proposal(hash.Hash) *param.Proposal
+	MakeNewProposal(id hash.Hash, proposer crypto.Address, changes param.Changes, activationHeight int) *param.Proposal
+	UpdateProposal(*param.Proposal)
+	TotalPower() int64
+
 	VerifySortition(hash.Hash, sortition.Proof, *validator.Validator) bool
 	EnterCommittee(hash.Hash, crypto.Address) error
+	EjectFromCommittee(crypto.Address) error
 
 	FindBlockInfoByStamp(stamp hash.Stamp) (int, hash.Hash)
 	CommitteeSize() int
@@ -32,7 +44,13 @@ type Sandbox interface {
 	MaxMemoLength() int
 	FeeFraction() float64
 	MinFee() int64
+	SlashFraction() float64
+	DowntimeWindow() int
+	ProtocolVersion() int
+	TxVersion() int
 
 	IterateAccounts(consumer func(*AccountStatus))
 	IterateValidators(consumer func(*ValidatorStatus))
+	IterateDelegations(consumer func(*DelegationStatus))
+	IterateProposals(consumer func(*ProposalStatus))
 }
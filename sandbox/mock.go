@@ -2,6 +2,7 @@ package sandbox
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
@@ -18,22 +19,33 @@ var _ Sandbox = &MockSandbox{}
 type MockSandbox struct {
 	Accounts           map[crypto.Address]*account.Account
 	Validators         map[crypto.Address]*validator.Validator
+	Delegations        map[validator.DelegationKey]*validator.Delegation
+	Proposals          map[hash.Hash]*param.Proposal
 	HashToHeight       map[hash.Hash]int
 	CurHeight          int
 	Params             param.Params
+	Rules              param.Rules
 	TotalAccount       int
 	TotalValidator     int
+	TotalDelegation    int
+	TotalProposal      int
 	AcceptSortition    bool
 	WelcomeToCommittee bool
 	InCommittee        bool
+	Ejected            []crypto.Address
 }
 
 func MockingSandbox() *MockSandbox {
+	params := param.DefaultParams()
+	upgrade := param.Upgrade{Version: param.ProtocolVersion, Height: 1, BlockVersion: params.BlockVersion, TxVersion: 1}
 	return &MockSandbox{
 		Accounts:        make(map[crypto.Address]*account.Account),
 		Validators:      make(map[crypto.Address]*validator.Validator),
+		Delegations:     make(map[validator.DelegationKey]*validator.Delegation),
+		Proposals:       make(map[hash.Hash]*param.Proposal),
 		HashToHeight:    make(map[hash.Hash]int),
-		Params:          param.DefaultParams(),
+		Params:          params,
+		Rules:           param.RulesAt(params, []param.Upgrade{upgrade}, 1),
 		AcceptSortition: false,
 	}
 }
@@ -69,12 +81,68 @@ func (m *MockSandbox) UpdateValidator(val *validator.Validator) {
 	m.Validators[val.Address()] = val
 
 }
+func (m *MockSandbox) Delegation(delegator, val crypto.Address) *validator.Delegation {
+	d, ok := m.Delegations[validator.DelegationKey{Delegator: delegator, Validator: val}]
+	if !ok {
+		return nil
+	}
+	return d
+}
+func (m *MockSandbox) MakeNewDelegation(delegator, val crypto.Address) *validator.Delegation {
+	d := validator.NewDelegation(delegator, val, m.TotalDelegation)
+	m.TotalDelegation++
+	return d
+}
+func (m *MockSandbox) UpdateDelegation(d *validator.Delegation) {
+	m.Delegations[d.Key()] = d
+}
+func (m *MockSandbox) ValidatorDelegations(val crypto.Address) []*validator.Delegation {
+	delegations := make([]*validator.Delegation, 0)
+	for _, d := range m.Delegations {
+		if d.Validator().EqualsTo(val) {
+			delegations = append(delegations, d)
+		}
+	}
+	sort.Slice(delegations, func(i, j int) bool {
+		return delegations[i].Number() < delegations[j].Number()
+	})
+	return delegations
+}
+func (m *MockSandbox) Proposal(id hash.Hash) *param.Proposal {
+	p, ok := m.Proposals[id]
+	if !ok {
+		return nil
+	}
+	return p
+}
+func (m *MockSandbox) MakeNewProposal(id hash.Hash, proposer crypto.Address, changes param.Changes, activationHeight int) *param.Proposal {
+	p := param.NewProposal(id, proposer, changes, activationHeight, m.TotalProposal)
+	m.TotalProposal++
+	return p
+}
+func (m *MockSandbox) UpdateProposal(p *param.Proposal) {
+	m.Proposals[p.ID()] = p
+}
+func (m *MockSandbox) TotalPower() int64 {
+	total := int64(0)
+	for _, val := range m.Validators {
+		total += val.Power()
+	}
+	return total
+}
 func (m *MockSandbox) EnterCommittee(hash hash.Hash, addr crypto.Address) error {
 	if !m.WelcomeToCommittee {
 		return fmt.Errorf("cannot enter to the committee")
 	}
 	return nil
 }
+func (m *MockSandbox) EjectFromCommittee(addr crypto.Address) error {
+	if !m.InCommittee {
+		return fmt.Errorf("not in the committee")
+	}
+	m.Ejected = append(m.Ejected, addr)
+	return nil
+}
 func (m *MockSandbox) VerifySortition(blockHash hash.Hash, proof sortition.Proof, val *validator.Validator) bool {
 	return m.AcceptSortition
 }
@@ -100,6 +168,18 @@ func (m *MockSandbox) FeeFraction() float64 {
 func (m *MockSandbox) MinFee() int64 {
 	return m.Params.MinimumFee
 }
+func (m *MockSandbox) SlashFraction() float64 {
+	return m.Params.SlashFraction
+}
+func (m *MockSandbox) DowntimeWindow() int {
+	return m.Params.DowntimeWindow
+}
+func (m *MockSandbox) ProtocolVersion() int {
+	return m.Rules.ProtocolVersion
+}
+func (m *MockSandbox) TxVersion() int {
+	return m.Rules.TxVersion
+}
 
 func (m *MockSandbox) AppendNewBlock(height int, hash hash.Hash) {
 	m.HashToHeight[hash] = height
@@ -151,3 +231,9 @@ func (m *MockSandbox) IterateAccounts(consumer func(*AccountStatus)) {
 func (m *MockSandbox) IterateValidators(consumer func(*ValidatorStatus)) {
 
 }
+func (m *MockSandbox) IterateDelegations(consumer func(*DelegationStatus)) {
+
+}
+func (m *MockSandbox) IterateProposals(consumer func(*ProposalStatus)) {
+
+}
@@ -1,6 +1,7 @@
 package sandbox
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/zarbchain/zarb-go/account"
@@ -31,6 +32,9 @@ func NewBlockInfo(height int, hash hash.Hash) *BlockInfo {
 	}
 }
 
+func (bi *BlockInfo) Height() int     { return bi.height }
+func (bi *BlockInfo) Hash() hash.Hash { return bi.hash }
+
 type sandbox struct {
 	lk sync.RWMutex
 
@@ -40,16 +44,22 @@ type sandbox struct {
 	committee        committee.Reader
 	accounts         map[crypto.Address]*AccountStatus
 	validators       map[crypto.Address]*ValidatorStatus
+	delegations      map[validator.DelegationKey]*DelegationStatus
+	proposals        map[hash.Hash]*ProposalStatus
 	params           param.Params
+	rules            param.Rules
 	totalAccounts    int
 	totalValidators  int
+	totalDelegations int
+	totalProposals   int
 	totalStakeChange int64
 }
 
 type ValidatorStatus struct {
-	Validator       validator.Validator
-	Updated         bool
-	JoinedCommittee bool
+	Validator            validator.Validator
+	Updated              bool
+	JoinedCommittee      bool
+	EjectedFromCommittee bool
 }
 
 type AccountStatus struct {
@@ -57,19 +67,34 @@ type AccountStatus struct {
 	Updated bool
 }
 
-func NewSandbox(store store.Reader, params param.Params, latestBlocks *linkedmap.LinkedMap, sortition *sortition.Sortition, committee committee.Reader) Sandbox {
+type DelegationStatus struct {
+	Delegation validator.Delegation
+	Updated    bool
+}
+
+type ProposalStatus struct {
+	Proposal param.Proposal
+	Updated  bool
+}
+
+func NewSandbox(store store.Reader, params param.Params, rules param.Rules, latestBlocks *linkedmap.LinkedMap, sortition *sortition.Sortition, committee committee.Reader) Sandbox {
 	sb := &sandbox{
 		store:     store,
 		sortition: sortition,
 		committee: committee,
 		params:    params,
+		rules:     rules,
 	}
 
 	sb.accounts = make(map[crypto.Address]*AccountStatus)
 	sb.validators = make(map[crypto.Address]*ValidatorStatus)
+	sb.delegations = make(map[validator.DelegationKey]*DelegationStatus)
+	sb.proposals = make(map[hash.Hash]*ProposalStatus)
 	sb.latestBlocks = latestBlocks
 	sb.totalAccounts = sb.store.TotalAccounts()
 	sb.totalValidators = sb.store.TotalValidators()
+	sb.totalDelegations = sb.store.TotalDelegations()
+	sb.totalProposals = sb.store.TotalProposals()
 	sb.totalStakeChange = 0
 
 	return sb
@@ -200,6 +225,164 @@ func (sb *sandbox) UpdateValidator(val *validator.Validator) {
 	s.Updated = true
 }
 
+func (sb *sandbox) Delegation(delegator, val crypto.Address) *validator.Delegation {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	key := validator.DelegationKey{Delegator: delegator, Validator: val}
+	s, ok := sb.delegations[key]
+	if ok {
+		copy := new(validator.Delegation)
+		*copy = s.Delegation
+		return copy
+	}
+
+	d, err := sb.store.Delegation(delegator, val)
+	if err != nil {
+		return nil
+	}
+	sb.delegations[key] = &DelegationStatus{
+		Delegation: *d,
+	}
+	return d
+}
+
+func (sb *sandbox) MakeNewDelegation(delegator, val crypto.Address) *validator.Delegation {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	if sb.store.HasDelegation(delegator, val) {
+		sb.shouldPanicForDuplicatedAddress()
+	}
+
+	d := validator.NewDelegation(delegator, val, sb.totalDelegations)
+	key := validator.DelegationKey{Delegator: delegator, Validator: val}
+	sb.delegations[key] = &DelegationStatus{
+		Delegation: *d,
+		Updated:    true,
+	}
+	sb.totalDelegations++
+	return d
+}
+
+func (sb *sandbox) UpdateDelegation(d *validator.Delegation) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	key := d.Key()
+	s, ok := sb.delegations[key]
+	if !ok {
+		sb.shouldPanicForUnknownAddress()
+	}
+	s.Delegation = *d
+	s.Updated = true
+}
+
+// ValidatorDelegations returns the delegations of the validator, sorted by their numbers.
+// It includes the delegations that are made or updated in this sandbox.
+func (sb *sandbox) ValidatorDelegations(val crypto.Address) []*validator.Delegation {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	sb.store.IterateValidatorDelegations(val, func(d *validator.Delegation) (stop bool) {
+		key := d.Key()
+		if _, ok := sb.delegations[key]; !ok {
+			sb.delegations[key] = &DelegationStatus{
+				Delegation: *d,
+			}
+		}
+		return false
+	})
+
+	delegations := make([]*validator.Delegation, 0)
+	for _, s := range sb.delegations {
+		if s.Delegation.Validator().EqualsTo(val) {
+			copy := new(validator.Delegation)
+			*copy = s.Delegation
+			delegations = append(delegations, copy)
+		}
+	}
+	sort.Slice(delegations, func(i, j int) bool {
+		return delegations[i].Number() < delegations[j].Number()
+	})
+	return delegations
+}
+
+func (sb *sandbox) Proposal(id hash.Hash) *param.Proposal {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.proposals[id]
+	if ok {
+		copy := new(param.Proposal)
+		*copy = s.Proposal
+		return copy
+	}
+
+	p, err := sb.store.Proposal(id)
+	if err != nil {
+		return nil
+	}
+	sb.proposals[id] = &ProposalStatus{
+		Proposal: *p,
+	}
+	return p
+}
+
+func (sb *sandbox) MakeNewProposal(id hash.Hash, proposer crypto.Address, changes param.Changes, activationHeight int) *param.Proposal {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	if sb.store.HasProposal(id) {
+		sb.shouldPanicForDuplicatedAddress()
+	}
+
+	p := param.NewProposal(id, proposer, changes, activationHeight, sb.totalProposals)
+	sb.proposals[id] = &ProposalStatus{
+		Proposal: *p,
+		Updated:  true,
+	}
+	sb.totalProposals++
+	return p
+}
+
+func (sb *sandbox) UpdateProposal(p *param.Proposal) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.proposals[p.ID()]
+	if !ok {
+		sb.shouldPanicForUnknownAddress()
+	}
+	s.Proposal = *p
+	s.Updated = true
+}
+
+// TotalPower returns the total power of all validators, including the changes inside this sandbox
+func (sb *sandbox) TotalPower() int64 {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	total := int64(0)
+	sb.store.IterateValidators(func(val *validator.Validator) bool {
+		if s, ok := sb.validators[val.Address()]; ok {
+			total += s.Validator.Power()
+		} else {
+			total += val.Power()
+		}
+		return false
+	})
+
+	// New validators that are not in the store yet
+	storeTotal := sb.store.TotalValidators()
+	for _, s := range sb.validators {
+		if s.Validator.Number() >= storeTotal {
+			total += s.Validator.Power()
+		}
+	}
+	return total
+}
+
 func (sb *sandbox) EnterCommittee(blockHash hash.Hash, addr crypto.Address) error {
 	sb.lk.Lock()
 	defer sb.lk.Unlock()
@@ -264,6 +447,38 @@ func (sb *sandbox) EnterCommittee(blockHash hash.Hash, addr crypto.Address) erro
 	return nil
 }
 
+// EjectFromCommittee marks the validator to be removed from the committee on committing the block.
+func (sb *sandbox) EjectFromCommittee(addr crypto.Address) error {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	if !sb.committee.Contains(addr) {
+		return errors.Errorf(errors.ErrGeneric, "this validator is not in the committee")
+	}
+
+	valS, ok := sb.validators[addr]
+	if !ok {
+		return errors.Errorf(errors.ErrGeneric, "unknown validator")
+	}
+
+	if valS.EjectedFromCommittee {
+		return errors.Errorf(errors.ErrGeneric, "this validator has ejected from committee before")
+	}
+
+	ejected := 0
+	for _, s := range sb.validators {
+		if s.EjectedFromCommittee {
+			ejected++
+		}
+	}
+	if sb.committee.Size()-ejected <= 1 {
+		return errors.Errorf(errors.ErrGeneric, "committee can't be empty")
+	}
+
+	valS.EjectedFromCommittee = true
+	return nil
+}
+
 func (sb *sandbox) MaxMemoLength() int {
 	sb.lk.Lock()
 	defer sb.lk.Unlock()
@@ -282,6 +497,34 @@ func (sb *sandbox) MinFee() int64 {
 	return sb.params.MinimumFee
 }
 
+func (sb *sandbox) SlashFraction() float64 {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.params.SlashFraction
+}
+
+func (sb *sandbox) DowntimeWindow() int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.params.DowntimeWindow
+}
+
+func (sb *sandbox) ProtocolVersion() int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.rules.ProtocolVersion
+}
+
+func (sb *sandbox) TxVersion() int {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.rules.TxVersion
+}
+
 func (sb *sandbox) TransactionToLiveInterval() int {
 	sb.lk.RLock()
 	defer sb.lk.RUnlock()
@@ -354,6 +597,24 @@ func (sb *sandbox) IterateValidators(consumer func(*ValidatorStatus)) {
 	}
 }
 
+func (sb *sandbox) IterateDelegations(consumer func(*DelegationStatus)) {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	for _, ds := range sb.delegations {
+		consumer(ds)
+	}
+}
+
+func (sb *sandbox) IterateProposals(consumer func(*ProposalStatus)) {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	for _, ps := range sb.proposals {
+		consumer(ps)
+	}
+}
+
 func (sb *sandbox) FindBlockInfoByStamp(stamp hash.Stamp) (int, hash.Hash) {
 	sb.lk.RLock()
 	defer sb.lk.RUnlock()
@@ -95,7 +95,10 @@ func setup(t *testing.T) {
 	tCommittee, err = committee.NewCommittee([]*validator.Validator{val1, val2, val3, val4}, 4, tValSigners[0].Address())
 	assert.NoError(t, err)
 
-	tSandbox = NewSandbox(tStore, params, latestBlocks, tSortitions, tCommittee).(*sandbox)
+	rules := param.Rules{ProtocolVersion: 2, BlockVersion: 2, TxVersion: 1}
+	tSandbox = NewSandbox(tStore, params, rules, latestBlocks, tSortitions, tCommittee).(*sandbox)
+	assert.Equal(t, tSandbox.ProtocolVersion(), rules.ProtocolVersion)
+	assert.Equal(t, tSandbox.TxVersion(), rules.TxVersion)
 	assert.Equal(t, tSandbox.MaxMemoLength(), params.MaximumMemoLength)
 	assert.Equal(t, tSandbox.FeeFraction(), params.FeeFraction)
 	assert.Equal(t, tSandbox.MinFee(), params.MinimumFee)
@@ -177,6 +180,97 @@ func TestValidatorChange(t *testing.T) {
 	})
 }
 
+func TestProposalChange(t *testing.T) {
+	setup(t)
+
+	t.Run("Should returns nil for invalid proposal", func(t *testing.T) {
+		assert.Nil(t, tSandbox.Proposal(hash.GenerateTestHash()))
+	})
+
+	t.Run("Retrieve a proposal from store, modify it and commit it", func(t *testing.T) {
+		p1 := param.GenerateTestProposal(0)
+		tStore.UpdateProposal(p1)
+
+		p1a := tSandbox.Proposal(p1.ID())
+		assert.Equal(t, p1.Hash(), p1a.Hash())
+
+		p1a.AddVote(crypto.GenerateTestAddress())
+		p1a.UpdateVotedPower(p1.VotedPower() + 100)
+		assert.False(t, tSandbox.proposals[p1.ID()].Updated)
+		tSandbox.UpdateProposal(p1a)
+		assert.True(t, tSandbox.proposals[p1.ID()].Updated)
+		assert.Equal(t, tSandbox.Proposal(p1.ID()).VotedPower(), p1.VotedPower()+100)
+	})
+
+	t.Run("Make new proposal", func(t *testing.T) {
+		id := hash.GenerateTestHash()
+		p2 := tSandbox.MakeNewProposal(id, crypto.GenerateTestAddress(), param.Changes{}, 1000)
+		assert.Equal(t, p2.Number(), tSandbox.totalProposals-1)
+
+		p2.Approve(tSandbox.CurrentHeight())
+		tSandbox.UpdateProposal(p2)
+		assert.True(t, tSandbox.Proposal(id).IsApproved())
+
+		updated := 0
+		tSandbox.IterateProposals(func(ps *ProposalStatus) {
+			if ps.Updated {
+				updated++
+			}
+		})
+		assert.Equal(t, updated, 2)
+	})
+
+	t.Run("Should panic for unknown proposal", func(t *testing.T) {
+		assert.Panics(t, func() { tSandbox.UpdateProposal(param.GenerateTestProposal(5)) })
+	})
+}
+
+func TestValidatorDelegations(t *testing.T) {
+	setup(t)
+
+	valAddr := tValSigners[0].Address()
+	d1 := validator.NewDelegation(crypto.GenerateTestAddress(), valAddr, 0)
+	d1.AddToStake(100)
+	tStore.UpdateDelegation(d1)
+	d2 := validator.NewDelegation(crypto.GenerateTestAddress(), tValSigners[1].Address(), 1)
+	tStore.UpdateDelegation(d2)
+	tSandbox.totalDelegations = 2
+
+	// Modified inside the sandbox
+	d1a := tSandbox.Delegation(d1.Delegator(), valAddr)
+	d1a.AddToStake(50)
+	tSandbox.UpdateDelegation(d1a)
+	// Made inside the sandbox
+	d3 := tSandbox.MakeNewDelegation(crypto.GenerateTestAddress(), valAddr)
+
+	delegations := tSandbox.ValidatorDelegations(valAddr)
+	assert.Equal(t, len(delegations), 2)
+	assert.Equal(t, delegations[0].Hash(), d1a.Hash())
+	assert.Equal(t, delegations[1].Hash(), d3.Hash())
+}
+
+func TestTotalPower(t *testing.T) {
+	setup(t)
+
+	assert.Equal(t, tSandbox.TotalPower(), int64(15100))
+
+	val1 := tSandbox.Validator(tValSigners[0].Address())
+	val1.AddToStake(500)
+	tSandbox.UpdateValidator(val1)
+	assert.Equal(t, tSandbox.TotalPower(), int64(15600))
+
+	val2 := tSandbox.Validator(tValSigners[1].Address())
+	val2.UpdateUnbondingHeight(tSandbox.CurrentHeight())
+	tSandbox.UpdateValidator(val2)
+	assert.Equal(t, tSandbox.TotalPower(), int64(13600))
+
+	pub, _ := bls.GenerateTestKeyPair()
+	val3 := tSandbox.MakeNewValidator(pub)
+	val3.AddToStake(400)
+	tSandbox.UpdateValidator(val3)
+	assert.Equal(t, tSandbox.TotalPower(), int64(14000))
+}
+
 func TestAddValidatorToCommittee(t *testing.T) {
 	setup(t)
 
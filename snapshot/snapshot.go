@@ -0,0 +1,341 @@
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/sortition"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// Snapshot is the state of the blockchain right after committing a block.
+// A fresh node can bootstrap from a snapshot instead of replaying all blocks.
+// The snapshot can be verified against the state hash of the next block.
+// It has the headers of the latest blocks, linked together by their hashes,
+// so the block time, the sortition seed and the block hashes can be verified against the last block hash.
+type Snapshot struct {
+	data snapshotData
+}
+
+type snapshotData struct {
+	BlockHeight     int                     `cbor:"1,keyasint"`
+	Headers         []block.Header          `cbor:"2,keyasint"`
+	Certificate     *block.Certificate      `cbor:"3,keyasint"`
+	Committers      []int                   `cbor:"4,keyasint"`
+	Proposer        crypto.Address          `cbor:"5,keyasint"`
+	SortitionParams []sortition.Param       `cbor:"6,keyasint"`
+	Accounts        []*account.Account      `cbor:"7,keyasint"`
+	Validators      []*validator.Validator  `cbor:"8,keyasint"`
+	Delegations     []*validator.Delegation `cbor:"9,keyasint"`
+	Proposals       []*param.Proposal       `cbor:"10,keyasint"`
+}
+
+// NewSnapshot creates a new snapshot.
+// headers are the headers of the latest blocks, the last one is the header of the block at blockHeight.
+// Accounts, validators, delegations and proposals should be sorted by their numbers.
+func NewSnapshot(blockHeight int, headers []block.Header,
+	cert *block.Certificate, committers []int, proposer crypto.Address,
+	sortitionParams []sortition.Param, accs []*account.Account, vals []*validator.Validator,
+	delegations []*validator.Delegation, proposals []*param.Proposal) *Snapshot {
+	return &Snapshot{
+		data: snapshotData{
+			BlockHeight:     blockHeight,
+			Headers:         headers,
+			Certificate:     cert,
+			Committers:      committers,
+			Proposer:        proposer,
+			SortitionParams: sortitionParams,
+			Accounts:        accs,
+			Validators:      vals,
+			Delegations:     delegations,
+			Proposals:       proposals,
+		},
+	}
+}
+
+func (s *Snapshot) BlockHeight() int                     { return s.data.BlockHeight }
+func (s *Snapshot) Headers() []block.Header              { return s.data.Headers }
+func (s *Snapshot) Certificate() *block.Certificate      { return s.data.Certificate }
+func (s *Snapshot) Committers() []int                    { return s.data.Committers }
+func (s *Snapshot) Proposer() crypto.Address             { return s.data.Proposer }
+func (s *Snapshot) SortitionParams() []sortition.Param   { return s.data.SortitionParams }
+func (s *Snapshot) Accounts() []*account.Account         { return s.data.Accounts }
+func (s *Snapshot) Validators() []*validator.Validator   { return s.data.Validators }
+func (s *Snapshot) Delegations() []*validator.Delegation { return s.data.Delegations }
+func (s *Snapshot) Proposals() []*param.Proposal         { return s.data.Proposals }
+
+// Header returns the header of the block at the given height, or nil if the snapshot doesn't have it.
+func (s *Snapshot) Header(height int) *block.Header {
+	i := len(s.data.Headers) - 1 - (s.data.BlockHeight - height)
+	if i < 0 || i >= len(s.data.Headers) {
+		return nil
+	}
+	return &s.data.Headers[i]
+}
+
+// BlockHash returns the hash of the block at the snapshot height.
+func (s *Snapshot) BlockHash() hash.Hash {
+	h := s.Header(s.data.BlockHeight)
+	if h == nil {
+		return hash.UndefHash
+	}
+	return h.Hash()
+}
+
+// BlockTime returns the time of the block at the snapshot height.
+func (s *Snapshot) BlockTime() time.Time {
+	h := s.Header(s.data.BlockHeight)
+	if h == nil {
+		return time.Time{}
+	}
+	return h.Time()
+}
+
+// SortitionSeed returns the sortition seed of the block at the snapshot height.
+func (s *Snapshot) SortitionSeed() sortition.VerifiableSeed {
+	h := s.Header(s.data.BlockHeight)
+	if h == nil {
+		return sortition.VerifiableSeed{}
+	}
+	return h.SortitionSeed()
+}
+
+// BlockHashes returns the hashes of the latest blocks, the last one is the hash of the block at the snapshot height.
+func (s *Snapshot) BlockHashes() []hash.Hash {
+	hashes := make([]hash.Hash, len(s.data.Headers))
+	for i, h := range s.data.Headers {
+		hashes[i] = h.Hash()
+	}
+	return hashes
+}
+
+// PoolStake returns the total stake of the validators that are not in the committee.
+func (s *Snapshot) PoolStake() int64 {
+	committers := make(map[int]bool)
+	for _, num := range s.data.Committers {
+		committers[num] = true
+	}
+	poolStake := int64(0)
+	for _, val := range s.data.Validators {
+		if !committers[val.Number()] {
+			poolStake += val.Stake()
+		}
+	}
+	return poolStake
+}
+
+// Validator returns the validator with the given number, or nil if it doesn't exist.
+func (s *Snapshot) Validator(number int) *validator.Validator {
+	if number < 0 || number >= len(s.data.Validators) {
+		return nil
+	}
+	return s.data.Validators[number]
+}
+
+func (s *Snapshot) SanityCheck() error {
+	if s.data.BlockHeight <= 0 {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "invalid height")
+	}
+	if s.data.Certificate == nil {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "no certificate")
+	}
+	if err := s.data.Certificate.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "invalid certificate: %v", err)
+	}
+	if len(s.data.Headers) == 0 || len(s.data.Headers) > s.data.BlockHeight {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "invalid number of headers")
+	}
+	for i := 1; i < len(s.data.Headers); i++ {
+		if !s.data.Headers[i].PrevBlockHash().EqualsTo(s.data.Headers[i-1].Hash()) {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "headers are not linked together")
+		}
+	}
+	if !s.data.Certificate.BlockHash().EqualsTo(s.BlockHash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "certificate has invalid block hash")
+	}
+	// Sortition params belong to the latest blocks, in the same order as the headers.
+	if len(s.data.SortitionParams) == 0 || len(s.data.SortitionParams) > len(s.data.Headers) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "invalid number of sortition params")
+	}
+	offset := len(s.data.Headers) - len(s.data.SortitionParams)
+	for i, p := range s.data.SortitionParams {
+		h := s.data.Headers[offset+i]
+		if !p.BlockHash.EqualsTo(h.Hash()) || p.Seed != h.SortitionSeed() {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "invalid sortition params")
+		}
+	}
+	if s.data.SortitionParams[len(s.data.SortitionParams)-1].PoolStake != s.PoolStake() {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "invalid pool stake")
+	}
+	for i, acc := range s.data.Accounts {
+		if acc == nil || acc.Number() != i {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "invalid account number: %v", i)
+		}
+	}
+	for i, val := range s.data.Validators {
+		if val == nil || val.Number() != i {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "invalid validator number: %v", i)
+		}
+	}
+	for i, d := range s.data.Delegations {
+		if d == nil || d.Number() != i {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "invalid delegation number: %v", i)
+		}
+	}
+	for i, p := range s.data.Proposals {
+		if p == nil || p.Number() != i {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "invalid proposal number: %v", i)
+		}
+	}
+	if len(s.data.Committers) == 0 {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "no committer")
+	}
+	hasProposer := false
+	committers := make(map[int]bool)
+	for _, num := range s.data.Committers {
+		val := s.Validator(num)
+		if val == nil {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "invalid committer: %v", num)
+		}
+		if committers[num] {
+			return errors.Errorf(errors.ErrInvalidSnapshot, "duplicated committer: %v", num)
+		}
+		committers[num] = true
+		if val.Address().EqualsTo(s.data.Proposer) {
+			hasProposer = true
+		}
+	}
+	if !hasProposer {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "proposer is not in the committee")
+	}
+
+	return nil
+}
+
+// Committee creates the committee at the snapshot height.
+func (s *Snapshot) Committee(committeeSize int) (*committee.Committee, error) {
+	vals := make([]*validator.Validator, len(s.data.Committers))
+	for i, num := range s.data.Committers {
+		val := s.Validator(num)
+		if val == nil {
+			return nil, errors.Errorf(errors.ErrInvalidSnapshot, "invalid committer: %v", num)
+		}
+		vals[i] = val
+	}
+	return committee.NewCommittee(vals, committeeSize, s.data.Proposer)
+}
+
+// StateHash calculates the state hash of the snapshot.
+// It should be same as the state hash in the header of the next block.
+// The protocol version of the next block defines the layout of the state hash.
+// Before protocol version 2, the delegations and proposals are not part of the state hash.
+func (s *Snapshot) StateHash(protocolVersion int) hash.Hash {
+	accHashes := make([]hash.Hash, len(s.data.Accounts))
+	valHashes := make([]hash.Hash, len(s.data.Validators))
+	for i, acc := range s.data.Accounts {
+		accHashes[i] = acc.Hash()
+	}
+	for i, val := range s.data.Validators {
+		valHashes[i] = val.Hash()
+	}
+	delHashes := make([]hash.Hash, len(s.data.Delegations))
+	for i, d := range s.data.Delegations {
+		delHashes[i] = d.Hash()
+	}
+
+	propHashes := make([]hash.Hash, len(s.data.Proposals))
+	for i, p := range s.data.Proposals {
+		propHashes[i] = p.Hash()
+	}
+
+	accRootHash := simplemerkle.NewTreeFromHashes(accHashes).Root()
+	valRootHash := simplemerkle.NewTreeFromHashes(valHashes).Root()
+	stakingRootHash := simplemerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+	if protocolVersion < 2 {
+		return *stakingRootHash
+	}
+	delRootHash := simplemerkle.NewTreeFromHashes(delHashes).Root()
+	propRootHash := simplemerkle.NewTreeFromHashes(propHashes).Root()
+	extraRootHash := simplemerkle.HashMerkleBranches(&delRootHash, &propRootHash)
+
+	return *simplemerkle.HashMerkleBranches(stakingRootHash, extraRootHash)
+}
+
+func (s *Snapshot) Fingerprint() string {
+	return fmt.Sprintf("{#%d ⌘ %v 👤 %d 🤵 %d}",
+		s.data.BlockHeight,
+		s.BlockHash().Fingerprint(),
+		len(s.data.Accounts),
+		len(s.data.Validators))
+}
+
+func (s *Snapshot) Encode() ([]byte, error) {
+	return cbor.Marshal(s.data)
+}
+
+func (s *Snapshot) Decode(bs []byte) error {
+	return cbor.Unmarshal(bs, &s.data)
+}
+
+func (s *Snapshot) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(s.data)
+}
+
+func (s *Snapshot) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &s.data)
+}
+
+// GenerateTestSnapshot generates a snapshot for testing purpose.
+// If header is nil, some random headers are generated for the latest blocks,
+// otherwise it is used as the header of the block at the snapshot height.
+func GenerateTestSnapshot(height int, header *block.Header) *Snapshot {
+	headers := []block.Header{}
+	if header == nil {
+		prevBlockHash := hash.GenerateTestHash()
+		for i := 0; i < height && i < 3; i++ {
+			b, _ := block.GenerateTestBlock(nil, &prevBlockHash)
+			headers = append(headers, *b.Header())
+			prevBlockHash = b.Hash()
+		}
+	} else {
+		headers = append(headers, *header)
+	}
+	last := headers[len(headers)-1]
+	cert := block.GenerateTestCertificate(last.Hash())
+
+	accs := make([]*account.Account, 10)
+	for i := range accs {
+		accs[i], _ = account.GenerateTestAccount(i)
+	}
+	vals := make([]*validator.Validator, 20)
+	for i := range vals {
+		vals[i], _ = validator.GenerateTestValidator(i)
+	}
+	delegations := make([]*validator.Delegation, 5)
+	for i := range delegations {
+		delegations[i] = validator.GenerateTestDelegation(i)
+	}
+	proposals := make([]*param.Proposal, 2)
+	for i := range proposals {
+		proposals[i] = param.GenerateTestProposal(i)
+	}
+
+	snap := NewSnapshot(height, headers, cert, cert.Committers(), vals[cert.Committers()[0]].Address(),
+		nil, accs, vals, delegations, proposals)
+	snap.data.SortitionParams = []sortition.Param{{
+		BlockHash: last.Hash(),
+		Seed:      last.SortitionSeed(),
+		PoolStake: snap.PoolStake(),
+	}}
+
+	return snap
+}
@@ -0,0 +1,137 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/sortition"
+)
+
+func TestEncodingSnapshot(t *testing.T) {
+	snap1 := GenerateTestSnapshot(100, nil)
+	assert.NoError(t, snap1.SanityCheck())
+
+	bs, err := snap1.Encode()
+	require.NoError(t, err)
+	snap2 := new(Snapshot)
+	require.NoError(t, snap2.Decode(bs))
+
+	assert.Equal(t, snap1.BlockHeight(), snap2.BlockHeight())
+	assert.Equal(t, snap1.BlockTime(), snap2.BlockTime())
+	assert.Equal(t, snap1.SortitionSeed(), snap2.SortitionSeed())
+	assert.Equal(t, snap1.BlockHash(), snap2.BlockHash())
+	assert.Equal(t, snap1.BlockHashes(), snap2.BlockHashes())
+	assert.Equal(t, snap1.Certificate().Hash(), snap2.Certificate().Hash())
+	assert.Equal(t, snap1.SortitionParams(), snap2.SortitionParams())
+	assert.Equal(t, snap1.StateHash(2), snap2.StateHash(2))
+	assert.Equal(t, len(snap1.Delegations()), len(snap2.Delegations()))
+	assert.Equal(t, len(snap1.Proposals()), len(snap2.Proposals()))
+	assert.NoError(t, snap2.SanityCheck())
+}
+
+func TestSnapshotCommittee(t *testing.T) {
+	snap := GenerateTestSnapshot(100, nil)
+
+	cmt, err := snap.Committee(5)
+	require.NoError(t, err)
+	assert.Equal(t, cmt.Committers(), snap.Committers())
+	assert.Equal(t, cmt.Proposer(0).Address(), snap.Proposer())
+	assert.Nil(t, snap.Validator(-1))
+	assert.Nil(t, snap.Validator(len(snap.Validators())))
+}
+
+func TestSnapshotHeaders(t *testing.T) {
+	snap := GenerateTestSnapshot(100, nil)
+	headers := snap.Headers()
+	last := headers[len(headers)-1]
+
+	assert.Equal(t, len(snap.BlockHashes()), 3)
+	assert.Equal(t, snap.BlockHashes()[1], headers[1].Hash())
+	assert.Equal(t, snap.BlockHash(), last.Hash())
+	assert.Equal(t, snap.BlockTime(), last.Time())
+	assert.Equal(t, snap.SortitionSeed(), last.SortitionSeed())
+	assert.Equal(t, snap.Header(98).Hash(), headers[0].Hash())
+	assert.Nil(t, snap.Header(97))
+	assert.Nil(t, snap.Header(101))
+}
+
+func TestSnapshotSanityCheck(t *testing.T) {
+	t.Run("Invalid height", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.BlockHeight = 0
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("No header", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Headers = nil
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Headers are not linked together", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		b, _ := block.GenerateTestBlock(nil, nil)
+		snap.data.Headers[0] = *b.Header()
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Invalid certificate", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Certificate = block.GenerateTestCertificate(hash.GenerateTestHash())
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Invalid sortition params", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.SortitionParams[0].BlockHash = hash.GenerateTestHash()
+		assert.Error(t, snap.SanityCheck())
+
+		snap = GenerateTestSnapshot(100, nil)
+		snap.data.SortitionParams[0].Seed = sortition.GenerateRandomSeed()
+		assert.Error(t, snap.SanityCheck())
+
+		snap = GenerateTestSnapshot(100, nil)
+		snap.data.SortitionParams[0].PoolStake++
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Invalid account number", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Accounts[1] = snap.data.Accounts[2]
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Invalid validator number", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Validators = snap.data.Validators[1:]
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Invalid delegation number", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Delegations[1] = snap.data.Delegations[2]
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Invalid proposal number", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Proposals[0] = snap.data.Proposals[1]
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Duplicated committer", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Committers[1] = snap.data.Committers[0]
+		assert.Error(t, snap.SanityCheck())
+	})
+
+	t.Run("Proposer is not in the committee", func(t *testing.T) {
+		snap := GenerateTestSnapshot(100, nil)
+		snap.data.Proposer = crypto.GenerateTestAddress()
+		assert.Error(t, snap.SanityCheck())
+	})
+}
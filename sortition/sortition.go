@@ -13,6 +13,13 @@ type param struct {
 	stake int64
 }
 
+// Param holds the sortition parameters for a block.
+type Param struct {
+	BlockHash hash.Hash      `cbor:"1,keyasint"`
+	Seed      VerifiableSeed `cbor:"2,keyasint"`
+	PoolStake int64          `cbor:"3,keyasint"`
+}
+
 type Sortition struct {
 	lk sync.RWMutex
 
@@ -50,6 +57,24 @@ func (s *Sortition) GetParams(blockHash hash.Hash) (seed VerifiableSeed, poolSta
 	return p.seed, p.stake
 }
 
+// Params returns the sortition parameters, from the oldest block to the newest one.
+func (s *Sortition) Params() []Param {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	params := make([]Param, 0, s.params.Size())
+	for e := s.params.FirstElement(); e != nil; e = e.Next() {
+		pair := e.Value.(*linkedmap.Pair)
+		p := pair.Second.(*param)
+		params = append(params, Param{
+			BlockHash: pair.First.(hash.Hash),
+			Seed:      p.seed,
+			PoolStake: p.stake,
+		})
+	}
+	return params
+}
+
 func (s *Sortition) EvaluateSortition(blockHash hash.Hash, signer crypto.Signer, threshold int64) (bool, Proof) {
 	s.lk.RLock()
 	defer s.lk.RUnlock()
@@ -134,3 +134,23 @@ func TestGetParam(t *testing.T) {
 	assert.Equal(t, seed, s2)
 	assert.Equal(t, stake, int64(2000))
 }
+
+func TestParams(t *testing.T) {
+	s := NewSortition()
+	hashes := []hash.Hash{}
+	for i := 0; i < 10; i++ {
+		h := hash.GenerateTestHash()
+		hashes = append(hashes, h)
+		s.SetParams(h, GenerateRandomSeed(), int64(i))
+	}
+
+	params := s.Params()
+	assert.Equal(t, len(params), 7)
+	for i, p := range params {
+		assert.Equal(t, p.BlockHash, hashes[i+3])
+		assert.Equal(t, p.PoolStake, int64(i+3))
+		seed, stake := s.GetParams(p.BlockHash)
+		assert.Equal(t, p.Seed, seed)
+		assert.Equal(t, p.PoolStake, stake)
+	}
+}
@@ -0,0 +1,42 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/sandbox"
+)
+
+// updateAvailability updates the missed blocks counter of the committers of the previous block.
+// The previous certificate is part of the block, so all the nodes agree on it.
+// Each counter belongs to a downtime window, so the counters of all validators restart in a new window.
+// A validator that misses more than `MaxMissedBlocks` blocks inside a window is jailed and ejected from the committee.
+func (st *state) updateAvailability(sb sandbox.Sandbox, height int, cert *block.Certificate) {
+	if cert == nil || st.params.DowntimeWindow == 0 || st.params.MaxMissedBlocks == 0 {
+		return
+	}
+
+	window := height / st.params.DowntimeWindow
+	for _, num := range cert.Absentees() {
+		v, err := st.store.ValidatorByNumber(num)
+		if err != nil {
+			st.logger.Panic("unable to retrieve the committer", "number", num, "err", err)
+		}
+		val := sb.Validator(v.Address())
+		if val.IsJailed() {
+			continue
+		}
+
+		val.IncMissedBlocks(window)
+		if val.MissedBlocks() > st.params.MaxMissedBlocks {
+			if sb.IsInCommittee(val.Address()) {
+				if err := sb.EjectFromCommittee(val.Address()); err != nil {
+					st.logger.Warn("unable to eject the absent validator", "address", val.Address(), "err", err)
+					sb.UpdateValidator(val)
+					continue
+				}
+			}
+			st.logger.Info("validator is jailed because of downtime", "address", val.Address(), "missed", val.MissedBlocks())
+			val.Jail(height)
+		}
+		sb.UpdateValidator(val)
+	}
+}
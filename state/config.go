@@ -2,17 +2,23 @@ package state
 
 import (
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/errors"
 )
 
 // Config holds the configuration of the node
 type Config struct {
-	MintbaseAddress string `toml:"" comment:"Mintbase Address to collect the rewards."`
+	MintbaseAddress  string `toml:"" comment:"Mintbase Address to collect the rewards."`
+	SnapshotInterval int    `toml:"" comment:"SnapshotInterval is the number of blocks between two state snapshots. Zero disables snapshots. Default is 8640."`
+	CheckpointHeight int    `toml:"" comment:"CheckpointHeight is the height of a trusted block. Only the snapshot of this block can be imported. Zero disables importing snapshots."`
+	CheckpointHash   string `toml:"" comment:"CheckpointHash is the hash of the trusted block at the CheckpointHeight."`
 }
 
 // DefaultConfig instantiates the default configuration for the node
 func DefaultConfig() *Config {
-	return &Config{}
+	return &Config{
+		SnapshotInterval: 8640, // one day
+	}
 }
 
 // TestConfig instantiates the test configuration
@@ -28,5 +34,17 @@ func (conf *Config) SanityCheck() error {
 			return errors.Errorf(errors.ErrInvalidConfig, "invalid mintbase address: %s", err.Error())
 		}
 	}
+	if conf.SnapshotInterval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "snapshot interval can't be negative")
+	}
+	if conf.CheckpointHeight < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "checkpoint height can't be negative")
+	}
+	if conf.CheckpointHeight > 0 {
+		_, err := hash.FromString(conf.CheckpointHash)
+		if err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid checkpoint hash: %s", err.Error())
+		}
+	}
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto/hash"
 )
 
 func TestDefaultConfigCheck(t *testing.T) {
@@ -14,4 +15,23 @@ func TestDefaultConfigCheck(t *testing.T) {
 		c.MintbaseAddress = "invalid"
 		assert.Error(t, c.SanityCheck())
 	})
+
+	t.Run("Invalid snapshot interval", func(t *testing.T) {
+		c := DefaultConfig()
+		c.SnapshotInterval = -1
+		assert.Error(t, c.SanityCheck())
+	})
+
+	t.Run("Invalid checkpoint", func(t *testing.T) {
+		c := DefaultConfig()
+		c.CheckpointHeight = -1
+		assert.Error(t, c.SanityCheck())
+
+		c.CheckpointHeight = 100
+		c.CheckpointHash = "invalid"
+		assert.Error(t, c.SanityCheck())
+
+		c.CheckpointHash = hash.GenerateTestHash().String()
+		assert.NoError(t, c.SanityCheck())
+	})
 }
@@ -0,0 +1,86 @@
+package state
+
+import (
+	"math/bits"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// mulDiv returns a*b/c, rounded down. The product is computed in 128 bits to avoid overflow.
+// a and b should not be negative, c should be positive and b should not be greater than c.
+func mulDiv(a, b, c int64) int64 {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	q, _ := bits.Div64(hi, lo, uint64(c))
+	return int64(q)
+}
+
+// delegatorsReward returns the part of the block reward that belongs to the delegators of the proposer.
+// The delegators share the reward in proportion to the delegated stake, minus the validator's commission.
+// All the calculations are done in integers, so they are same on all nodes.
+func (st *state) delegatorsReward(proposer crypto.Address, reward int64) int64 {
+	val, err := st.store.Validator(proposer)
+	if err != nil || val.Stake() <= 0 || val.DelegatedStake() <= 0 || reward <= 0 {
+		return 0
+	}
+	delegatedStake := util.Min64(val.DelegatedStake(), val.Stake())
+	share := mulDiv(reward, delegatedStake, val.Stake())
+	rate := util.Max64(0, util.Min64(int64(val.Commission()), validator.MaxCommission))
+	commission := mulDiv(share, rate, validator.MaxCommission)
+
+	return share - commission
+}
+
+// distributeDelegatorsReward pays the delegators' reward from the treasury.
+// Each active delegation of the proposer receives a part in proportion to its stake, rounded down.
+// The remainder of the rounding goes to the delegation with the highest stake (the first one on ties),
+// so the whole reward is paid.
+// The delegations that are changed inside this block are read from the sandbox.
+func (st *state) distributeDelegatorsReward(sb sandbox.Sandbox, proposer crypto.Address, reward int64) {
+	if reward == 0 {
+		return
+	}
+
+	keys := []validator.DelegationKey{}
+	st.store.IterateValidatorDelegations(proposer, func(d *validator.Delegation) (stop bool) {
+		keys = append(keys, d.Key())
+		return false
+	})
+
+	delegations := make([]*validator.Delegation, 0, len(keys))
+	totalStake := int64(0)
+	for _, key := range keys {
+		d := sb.Delegation(key.Delegator, key.Validator)
+		if d.UnbondingHeight() > 0 || d.Stake() == 0 {
+			continue
+		}
+		delegations = append(delegations, d)
+		totalStake += d.Stake()
+	}
+	if totalStake == 0 {
+		return
+	}
+
+	paid := int64(0)
+	rewards := make([]int64, len(delegations))
+	highest := 0
+	for i, d := range delegations {
+		rewards[i] = mulDiv(reward, d.Stake(), totalStake)
+		paid += rewards[i]
+		if d.Stake() > delegations[highest].Stake() {
+			highest = i
+		}
+	}
+	rewards[highest] += reward - paid
+
+	for i, d := range delegations {
+		d.AddToReward(rewards[i])
+		sb.UpdateDelegation(d)
+	}
+
+	treasury := sb.Account(crypto.TreasuryAddress)
+	treasury.SubtractFromBalance(reward)
+	sb.UpdateAccount(treasury)
+}
@@ -0,0 +1,109 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+func TestDelegatorsReward(t *testing.T) {
+	setup(t)
+
+	proposer := tValSigner1.Address()
+	reward := tState1.params.BlockReward
+
+	t.Run("No delegation, proposer takes all the reward", func(t *testing.T) {
+		assert.Zero(t, tState1.delegatorsReward(proposer, reward))
+
+		trx := tState1.createSubsidyTx(0)
+		assert.Equal(t, trx.Payload().Value(), reward)
+	})
+
+	val, _ := tState1.store.Validator(proposer)
+	val.AddToStake(1000)
+	d1 := validator.NewDelegation(crypto.GenerateTestAddress(), proposer, 0)
+	d1.AddToStake(600)
+	d2 := validator.NewDelegation(crypto.GenerateTestAddress(), proposer, 1)
+	d2.AddToStake(400)
+	d3 := validator.NewDelegation(crypto.GenerateTestAddress(), proposer, 2)
+	d3.AddToStake(500)
+	d3.UpdateUnbondingHeight(1)
+	val.AddToStake(1000)
+	val.AddToDelegatedStake(1000)
+	val.UpdateCommission(2000)
+	tState1.store.UpdateValidator(val)
+	tState1.store.UpdateDelegation(d1)
+	tState1.store.UpdateDelegation(d2)
+	tState1.store.UpdateDelegation(d3)
+
+	t.Run("Delegators share the reward", func(t *testing.T) {
+		// Half of the stake is delegated and validator takes 20% commission
+		delegatorsReward := tState1.delegatorsReward(proposer, reward)
+		assert.Equal(t, delegatorsReward, reward*4/10)
+
+		trx := tState1.createSubsidyTx(0)
+		assert.Equal(t, trx.Payload().Value(), reward-delegatorsReward)
+
+		sb := tState1.concreteSandbox()
+		treasuryBalance := sb.Account(crypto.TreasuryAddress).Balance()
+		tState1.distributeDelegatorsReward(sb, proposer, delegatorsReward)
+
+		assert.Equal(t, sb.Delegation(d1.Delegator(), proposer).Reward(), delegatorsReward*6/10)
+		assert.Equal(t, sb.Delegation(d2.Delegator(), proposer).Reward(), delegatorsReward*4/10)
+		assert.Zero(t, sb.Delegation(d3.Delegator(), proposer).Reward())
+		assert.Equal(t, sb.Account(crypto.TreasuryAddress).Balance(), treasuryBalance-delegatorsReward)
+	})
+}
+
+func TestDistributeDelegatorsRewardRemainder(t *testing.T) {
+	setup(t)
+
+	proposer := tValSigner1.Address()
+	stakes := []int64{1000, 1001, 3}
+	delegations := make([]*validator.Delegation, len(stakes))
+	for i, stake := range stakes {
+		delegations[i] = validator.NewDelegation(crypto.GenerateTestAddress(), proposer, i)
+		delegations[i].AddToStake(stake)
+		tState1.store.UpdateDelegation(delegations[i])
+	}
+
+	sb := tState1.concreteSandbox()
+	treasuryBalance := sb.Account(crypto.TreasuryAddress).Balance()
+	reward := int64(1e9 + 7)
+	tState1.distributeDelegatorsReward(sb, proposer, reward)
+
+	paid := int64(0)
+	for _, d := range delegations {
+		paid += sb.Delegation(d.Delegator(), proposer).Reward()
+	}
+	assert.Equal(t, paid, reward)
+	assert.Equal(t, sb.Account(crypto.TreasuryAddress).Balance(), treasuryBalance-reward)
+	// The delegation with the highest stake receives the remainder
+	totalStake := stakes[0] + stakes[1] + stakes[2]
+	assert.Equal(t, sb.Delegation(delegations[0].Delegator(), proposer).Reward(), reward*stakes[0]/totalStake)
+	assert.Equal(t, sb.Delegation(delegations[2].Delegator(), proposer).Reward(), reward*stakes[2]/totalStake)
+	assert.Greater(t, sb.Delegation(delegations[1].Delegator(), proposer).Reward(), reward*stakes[1]/totalStake)
+}
+
+func TestDelegatorsRewardLargeNumbers(t *testing.T) {
+	setup(t)
+
+	proposer := tValSigner1.Address()
+	val, _ := tState1.store.Validator(proposer)
+	// Above 2^53, float64 can't represent all the numbers
+	val.AddToStake(1<<53 + 1)
+	val.AddToDelegatedStake(1<<53 + 1)
+	val.UpdateCommission(1000)
+	tState1.store.UpdateValidator(val)
+
+	reward := int64(1<<53 + 1)
+	share := new(big.Int).Mul(big.NewInt(reward), big.NewInt(val.DelegatedStake()))
+	share.Div(share, big.NewInt(val.Stake()))
+	commission := new(big.Int).Div(share, big.NewInt(10))
+	expected := share.Int64() - commission.Int64()
+
+	assert.Equal(t, tState1.delegatorsReward(proposer, reward), expected)
+}
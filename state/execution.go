@@ -44,7 +44,10 @@ func (st *state) executeBlock(block *block.Block, sb sandbox.Sandbox) ([]*tx.Tx,
 	}
 
 	accumulatedFee := exe.AccumulatedFee()
-	subsidyAmt := st.params.BlockReward + exe.AccumulatedFee()
+	proposer := block.Header().ProposerAddress()
+	reward := st.params.BlockReward + accumulatedFee
+	delegatorsReward := st.delegatorsReward(proposer, reward)
+	subsidyAmt := reward - delegatorsReward
 	if mintbaseTrx.Payload().Value() != subsidyAmt {
 		return nil, errors.Errorf(errors.ErrInvalidTx,
 			"invalid subsidy amount. Expected %v, got %v", subsidyAmt, mintbaseTrx.Payload().Value())
@@ -55,5 +58,7 @@ func (st *state) executeBlock(block *block.Block, sb sandbox.Sandbox) ([]*tx.Tx,
 	acc.AddToBalance(accumulatedFee)
 	sb.UpdateAccount(acc)
 
+	st.distributeDelegatorsReward(sb, proposer, delegatorsReward)
+
 	return trxs, nil
 }
@@ -70,7 +70,7 @@ func TestExecuteBlock(t *testing.T) {
 	t.Run("Subsidy tx is invalid", func(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(invSubsidyTx.ID())
-		invBlock := block.MakeBlock(1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
+		invBlock := block.MakeBlock(1, 1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
 		sb := tState1.concreteSandbox()
 		_, err := tState1.executeBlock(invBlock, sb)
 		assert.Error(t, err)
@@ -80,7 +80,7 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validSubsidyTx.ID())
 		txIDs.Append(invSendTx.ID())
-		invBlock := block.MakeBlock(1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
+		invBlock := block.MakeBlock(1, 1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
 		sb := tState1.concreteSandbox()
 		_, err := tState1.executeBlock(invBlock, sb)
 		assert.Error(t, err)
@@ -90,7 +90,7 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validTx1.ID())
 		txIDs.Append(validSubsidyTx.ID())
-		invBlock := block.MakeBlock(1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
+		invBlock := block.MakeBlock(1, 1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
 		sb := tState1.concreteSandbox()
 		_, err := tState1.executeBlock(invBlock, sb)
 		assert.Error(t, err)
@@ -99,7 +99,7 @@ func TestExecuteBlock(t *testing.T) {
 	t.Run("Has no subsidy", func(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validTx1.ID())
-		invBlock := block.MakeBlock(1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
+		invBlock := block.MakeBlock(1, 1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
 		sb := tState1.concreteSandbox()
 		_, err := tState1.executeBlock(invBlock, sb)
 		assert.Error(t, err)
@@ -109,7 +109,7 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validSubsidyTx.ID())
 		txIDs.Append(validSubsidyTx.ID())
-		invBlock := block.MakeBlock(1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
+		invBlock := block.MakeBlock(1, 1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
 		sb := tState1.concreteSandbox()
 		_, err := tState1.executeBlock(invBlock, sb)
 		assert.Error(t, err)
@@ -119,7 +119,7 @@ func TestExecuteBlock(t *testing.T) {
 		txIDs := block.NewTxIDs()
 		txIDs.Append(validSubsidyTx.ID())
 		txIDs.Append(validTx1.ID())
-		invBlock := block.MakeBlock(1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
+		invBlock := block.MakeBlock(1, 1, util.Now(), txIDs, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState1.signer.Address())
 		sb := tState1.concreteSandbox()
 		_, err := tState1.executeBlock(invBlock, sb)
 		assert.NoError(t, err)
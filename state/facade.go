@@ -5,9 +5,13 @@ import (
 
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -18,9 +22,11 @@ type Facade interface {
 	LastBlockTime() time.Time
 	LastCertificate() *block.Certificate
 	BlockTime() time.Duration
+	ProtocolVersion() int
 	UpdateLastCertificate(lastCertificate *block.Certificate) error
 	ProposeBlock(round int) (*block.Block, error)
 	ValidateBlock(block *block.Block) error
+	VerifyCertificate(height int, cert *block.Certificate) error
 	CommitBlock(height int, block *block.Block, cert *block.Certificate) error
 	CommitteeValidators() []*validator.Validator
 	IsInCommittee(addr crypto.Address) bool
@@ -30,14 +36,24 @@ type Facade interface {
 	CommitteeStake() int64
 	PoolStake() int64
 	Transaction(id tx.ID) *tx.Tx
+	TransactionBlockHeight(id tx.ID) int
+	AccountTransactions(addr crypto.Address, page int) []*tx.Tx
 	PendingTx(id tx.ID) *tx.Tx
+	PendingTxsByShortID(key hash.Hash, shortIDs []uint64) ([]*tx.Tx, error)
 	AddPendingTx(trx *tx.Tx) error
 	AddPendingTxAndBroadcast(trx *tx.Tx) error
+	SimulateTx(trx *tx.Tx) (*Simulation, error)
+	EstimateFee(amount int64, payloadType payload.Type) *FeeEstimation
+	AddEvidence(ev *evidence.Evidence) error
 	Block(height int) *block.Block
 	BlockHeight(hash hash.Hash) int
 	Account(addr crypto.Address) *account.Account
 	Validator(addr crypto.Address) *validator.Validator
 	ValidatorByNumber(number int) *validator.Validator
+	AccountProof(addr crypto.Address) (*account.Account, *simplemerkle.Proof, int)
+	ValidatorProof(addr crypto.Address) (*validator.Validator, *simplemerkle.Proof, int)
+	LastSnapshot() *snapshot.Snapshot
+	ImportSnapshot(snap *snapshot.Snapshot, nextBlock *block.Block, nextCert *block.Certificate) error
 	Close() error
 	Fingerprint() string
 }
@@ -0,0 +1,68 @@
+package state
+
+import (
+	"math"
+
+	"github.com/zarbchain/zarb-go/execution"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+// feeEstimationWindow is the number of recent blocks that are checked to measure the block fullness
+const feeEstimationWindow = 10
+
+// FeeEstimation is the estimated fee of a transaction.
+// Pool occupancy and block fullness are between 0 and 1 and show the network congestion.
+type FeeEstimation struct {
+	MinimumFee     int64
+	RecommendedFee int64
+	PoolOccupancy  float64
+	BlockFullness  float64
+}
+
+// EstimateFee returns the minimum fee that protocol accepts for the transaction and a recommended fee.
+// The recommended fee grows with the congestion, up to twice of the minimum fee
+// when the transaction pool or the recent blocks are full.
+// Before protocol version 2 the fee should be exactly the minimum fee, so it is recommended.
+func (st *state) EstimateFee(amount int64, payloadType payload.Type) *FeeEstimation {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	est := &FeeEstimation{
+		PoolOccupancy: st.txPool.Occupancy(payloadType),
+		BlockFullness: st.blockFullness(),
+	}
+	if tx.IsFreePayload(payloadType) {
+		return est
+	}
+
+	congestion := math.Min(math.Max(est.PoolOccupancy, est.BlockFullness), 1)
+	est.MinimumFee = execution.MinimumFee(amount, st.params.FeeFraction, st.params.MinimumFee)
+	if st.rules(st.lastInfo.BlockHeight()+1).ProtocolVersion < 2 {
+		est.RecommendedFee = est.MinimumFee
+		return est
+	}
+	est.RecommendedFee = est.MinimumFee + int64(float64(est.MinimumFee)*congestion)
+
+	return est
+}
+
+// blockFullness returns the average ratio of the transactions in the recent blocks to the maximum block size.
+func (st *state) blockFullness() float64 {
+	total := 0
+	count := 0
+	lastHeight := st.lastInfo.BlockHeight()
+	for h := lastHeight; h > 0 && h > lastHeight-feeEstimationWindow; h-- {
+		b, err := st.store.Block(h)
+		if err != nil {
+			// The block might be pruned
+			continue
+		}
+		total += b.TxIDs().Len()
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return float64(total) / float64(count*st.params.MaximumTransactionPerBlock)
+}
@@ -0,0 +1,81 @@
+package state
+
+import (
+	"sort"
+
+	"github.com/zarbchain/zarb-go/param"
+)
+
+// activatedProposals returns the approved proposals that are activated at or before the given height,
+// sorted by their activation heights. The proposals that are activated at the same height are sorted by their numbers.
+func activatedProposals(proposals []*param.Proposal, height int) []*param.Proposal {
+	activated := make([]*param.Proposal, 0)
+	for _, p := range proposals {
+		if p.IsApproved() && p.ActivationHeight() <= height {
+			activated = append(activated, p)
+		}
+	}
+	sort.SliceStable(activated, func(i, j int) bool {
+		if activated[i].ActivationHeight() != activated[j].ActivationHeight() {
+			return activated[i].ActivationHeight() < activated[j].ActivationHeight()
+		}
+		return activated[i].Number() < activated[j].Number()
+	})
+	return activated
+}
+
+func (st *state) storedProposals() []*param.Proposal {
+	proposals := make([]*param.Proposal, 0, st.store.TotalProposals())
+	st.store.IterateProposals(func(p *param.Proposal) (stop bool) {
+		proposals = append(proposals, p)
+		return false
+	})
+	return proposals
+}
+
+// activateProposals applies the changes of the approved proposals that are activated at the given height.
+// If more than one proposal is activated at the same height, they are applied by their numbers.
+func (st *state) activateProposals(height int) {
+	proposals := make([]*param.Proposal, 0)
+	st.store.IterateProposalsByActivationHeight(height, func(p *param.Proposal) (stop bool) {
+		proposals = append(proposals, p)
+		return false
+	})
+
+	for _, p := range activatedProposals(proposals, height) {
+		if err := st.applyChanges(p.Changes()); err != nil {
+			st.logger.Error("unable to activate the new parameters", "proposal", p, "err", err)
+			continue
+		}
+		st.logger.Info("new parameters are activated", "proposal", p, "height", height)
+	}
+}
+
+// restoreParams restores the parameters that are effective at the given height,
+// by applying the changes of the approved proposals in the same order that they are activated.
+func (st *state) restoreParams(proposals []*param.Proposal, height int) {
+	for _, p := range activatedProposals(proposals, height) {
+		if err := st.applyChanges(p.Changes()); err != nil {
+			st.logger.Error("unable to restore the parameters", "proposal", p, "err", err)
+		}
+	}
+}
+
+// applyChanges updates the tunable parameters that are changed.
+// Other parameters, like the block version, are kept.
+func (st *state) applyChanges(changes param.Changes) error {
+	params := st.params.Tune(changes)
+	if err := params.SanityCheck(); err != nil {
+		return err
+	}
+
+	st.params = params
+	st.store.SetMinRetainBlocks(params.TransactionToLiveInterval)
+	if st.committee != nil {
+		st.committee.SetCommitteeSize(params.CommitteeSize)
+	}
+	if st.latestBlocks != nil {
+		st.latestBlocks.SetCapacity(params.TransactionToLiveInterval)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/store"
 	"github.com/zarbchain/zarb-go/tx/payload"
@@ -20,6 +21,9 @@ import (
 type lastInfoData struct {
 	LastBlockHeight int                `cbor:"1,keyasint"`
 	LastCertificate *block.Certificate `cbor:"2,keyasint"`
+	Committers      []int              `cbor:"3,keyasint"`
+	Proposer        crypto.Address     `cbor:"4,keyasint"`
+	SortitionParams []sortition.Param  `cbor:"5,keyasint"`
 }
 
 type LastInfo struct {
@@ -107,26 +111,57 @@ func (li *LastInfo) SetBlockTime(lastBlockTime time.Time) {
 	li.lastBlockTime = lastBlockTime
 }
 
-func (li *LastInfo) SaveLastInfo() {
+// SaveLastInfo saves the last info with the committee after committing the last block.
+// The committee is saved because the validators can be ejected from it by the transactions of the block.
+// The sortition params are saved before setting the params of the last block.
+// The pool stakes of the previous blocks can't be recalculated from the current stakes,
+// and the pool stake of the last block is recalculated on restoring.
+func (li *LastInfo) SaveLastInfo(cmt *committee.Committee, srt *sortition.Sortition) {
 	lid := lastInfoData{
 		LastBlockHeight: li.lastBlockHeight,
 		LastCertificate: li.lastCertificate,
+		Committers:      cmt.Committers(),
+		Proposer:        cmt.Proposer(0).Address(),
+		SortitionParams: srt.Params(),
 	}
 
 	bs, _ := cbor.Marshal(&lid)
 	li.store.SaveLastInfo(bs)
 }
 
-func (li *LastInfo) RestoreLastInfo(committeeSize int, srt *sortition.Sortition) (*committee.Committee, error) {
+func (li *LastInfo) restoreLastInfoData() (*lastInfoData, error) {
 	bs := li.store.RestoreLastInfo()
 	lid := new(lastInfoData)
 	err := cbor.Unmarshal(bs, lid)
 	if err != nil {
 		return nil, err
 	}
+	return lid, nil
+}
+
+// RestoreBlockHeight returns the height of the last block that is saved in the last info.
+func (li *LastInfo) RestoreBlockHeight() (int, error) {
+	lid, err := li.restoreLastInfoData()
+	if err != nil {
+		return 0, err
+	}
+	return lid.LastBlockHeight, nil
+}
+
+func (li *LastInfo) RestoreLastInfo(committeeSize int, srt *sortition.Sortition) (*committee.Committee, error) {
+	lid, err := li.restoreLastInfoData()
+	if err != nil {
+		return nil, err
+	}
 
 	logger.Debug("try to restore last state info", "height", lid.LastBlockHeight)
 
+	snap := li.restoreSnapshot()
+	if snap != nil && snap.BlockHeight() == lid.LastBlockHeight {
+		// There is no block after the snapshot. We can restore everything from the snapshot.
+		return li.restoreFromSnapshot(snap, committeeSize, srt)
+	}
+
 	b, err := li.store.Block(lid.LastBlockHeight)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve block %v: %v", lid.LastBlockHeight, err)
@@ -138,19 +173,102 @@ func (li *LastInfo) RestoreLastInfo(committeeSize int, srt *sortition.Sortition)
 	li.lastBlockHash = b.Hash()
 	li.lastBlockTime = b.Header().Time()
 
-	cmt, err := li.restoreCommittee(committeeSize)
+	if len(lid.Committers) == 0 {
+		// The last info is saved by an older version, without the committee and the sortition params.
+		cmt, err := li.restoreCommittee(committeeSize)
+		if err != nil {
+			return nil, err
+		}
+
+		err = li.restoreSortition(srt, cmt, snap)
+		if err != nil {
+			return nil, err
+		}
+
+		return cmt, nil
+	}
+
+	cmt, err := li.loadCommittee(lid.Committers, lid.Proposer, committeeSize)
 	if err != nil {
 		return nil, err
 	}
 
-	err = li.restoreSortition(srt, cmt)
+	li.loadSortition(srt, cmt, lid.SortitionParams)
+
+	return cmt, nil
+}
+
+// restoreSnapshot returns the latest snapshot in the store, or nil if there is no snapshot.
+func (li *LastInfo) restoreSnapshot() *snapshot.Snapshot {
+	bs := li.store.RestoreSnapshot()
+	if bs == nil {
+		return nil
+	}
+	snap := new(snapshot.Snapshot)
+	if err := snap.Decode(bs); err != nil {
+		logger.Error("unable to decode the snapshot", "err", err)
+		return nil
+	}
+	return snap
+}
+
+func (li *LastInfo) restoreFromSnapshot(snap *snapshot.Snapshot, committeeSize int, srt *sortition.Sortition) (*committee.Committee, error) {
+	logger.Debug("restore last state info from snapshot", "snapshot", snap)
+
+	li.lastBlockHeight = snap.BlockHeight()
+	li.lastCertificate = snap.Certificate()
+	li.lastSortitionSeed = snap.SortitionSeed()
+	li.lastBlockHash = snap.BlockHash()
+	li.lastBlockTime = snap.BlockTime()
+
+	cmt, err := snap.Committee(committeeSize)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to restore committee from snapshot: %v", err)
+	}
+
+	for _, p := range snap.SortitionParams() {
+		srt.SetParams(p.BlockHash, p.Seed, p.PoolStake)
+	}
+
+	return cmt, nil
+}
+
+// loadCommittee creates the committee from the saved committers and proposer.
+func (li *LastInfo) loadCommittee(committers []int, proposer crypto.Address, committeeSize int) (*committee.Committee, error) {
+	vals := make([]*validator.Validator, len(committers))
+	for i, num := range committers {
+		val, err := li.store.ValidatorByNumber(num)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve committee member %v: %v", num, err)
+		}
+		vals[i] = val
 	}
 
+	cmt, err := committee.NewCommittee(vals, committeeSize, proposer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create last committee: %v", err)
+	}
 	return cmt, nil
 }
 
+// loadSortition sets the saved sortition params and the params of the last block.
+// The pool stake of the last block is the stake of the validators that are not in the committee.
+func (li *LastInfo) loadSortition(srt *sortition.Sortition, cmt *committee.Committee, params []sortition.Param) {
+	for _, p := range params {
+		srt.SetParams(p.BlockHash, p.Seed, p.PoolStake)
+	}
+
+	poolStake := int64(0)
+	li.store.IterateValidators(func(v *validator.Validator) (stop bool) {
+		if !cmt.Contains(v.Address()) {
+			poolStake += v.Stake()
+		}
+		return false
+	})
+	srt.SetParams(li.lastBlockHash, li.lastSortitionSeed, poolStake)
+}
+
+// restoreCommittee rebuilds the committee from the last certificate and the sortition transactions of the last block.
 func (li *LastInfo) restoreCommittee(committeeSize int) (*committee.Committee, error) {
 	b, _ := li.store.Block(li.lastBlockHeight)
 
@@ -201,7 +319,7 @@ func (li *LastInfo) restoreCommittee(committeeSize int) (*committee.Committee, e
 	return committee, nil
 }
 
-func (li *LastInfo) restoreSortition(srt *sortition.Sortition, cmt *committee.Committee) error {
+func (li *LastInfo) restoreSortition(srt *sortition.Sortition, cmt *committee.Committee, snap *snapshot.Snapshot) error {
 	type sortitionParam struct {
 		blockHash hash.Hash
 		seed      sortition.VerifiableSeed
@@ -221,6 +339,14 @@ func (li *LastInfo) restoreSortition(srt *sortition.Sortition, cmt *committee.Co
 	if start < 0 {
 		start = 0
 	}
+	// Blocks before the snapshot might not be in the store.
+	// Sortition params of these blocks are inside the snapshot.
+	if snap != nil && start < snap.BlockHeight() {
+		start = snap.BlockHeight()
+		for _, p := range snap.SortitionParams() {
+			srt.SetParams(p.BlockHash, p.Seed, p.PoolStake)
+		}
+	}
 
 	stakeChanged := make(map[crypto.Address]int64)
 	cert := li.lastCertificate
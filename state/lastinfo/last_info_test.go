@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/committee"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
@@ -84,7 +86,7 @@ func setup(t *testing.T) {
 	ids1 := block.NewTxIDs()
 	ids1.Append(trx1.ID())
 	seed1 := sortition.GenerateRandomSeed()
-	block1 := block.MakeBlock(1, util.Now(), ids1,
+	block1 := block.MakeBlock(1, 1, util.Now(), ids1,
 		hash.UndefHash,
 		hash.GenerateTestHash(),
 		nil, seed1, val1.Address())
@@ -104,7 +106,7 @@ func setup(t *testing.T) {
 	ids2 := block.NewTxIDs()
 	ids2.Append(trx2.ID())
 	seed2 := sortition.GenerateRandomSeed()
-	block2 := block.MakeBlock(1, util.Now(), ids2,
+	block2 := block.MakeBlock(1, 1, util.Now(), ids2,
 		block1.Hash(),
 		hash.GenerateTestHash(),
 		cert1, seed2, val1.Address())
@@ -134,7 +136,7 @@ func setup(t *testing.T) {
 	ids3.Append(trx32.ID())
 	ids3.Append(trx33.ID())
 	seed3 := sortition.GenerateRandomSeed()
-	block3 := block.MakeBlock(1, util.Now(), ids3,
+	block3 := block.MakeBlock(1, 1, util.Now(), ids3,
 		block2.Hash(),
 		hash.GenerateTestHash(),
 		cert2, seed3, val1.Address())
@@ -158,7 +160,7 @@ func setup(t *testing.T) {
 	ids4.Append(trx41.ID())
 	ids4.Append(trx42.ID())
 	seed4 := sortition.GenerateRandomSeed()
-	block4 := block.MakeBlock(1, util.Now(), ids4,
+	block4 := block.MakeBlock(1, 1, util.Now(), ids4,
 		block3.Hash(),
 		hash.GenerateTestHash(),
 		cert3, seed4, val1.Address())
@@ -183,7 +185,7 @@ func setup(t *testing.T) {
 	ids5.Append(trx51.ID())
 	ids5.Append(trx52.ID())
 	seed5 := sortition.GenerateRandomSeed()
-	block5 := block.MakeBlock(1, util.Now(), ids5,
+	block5 := block.MakeBlock(1, 1, util.Now(), ids5,
 		block4.Hash(),
 		hash.GenerateTestHash(),
 		cert4, seed5, val1.Address())
@@ -193,14 +195,28 @@ func setup(t *testing.T) {
 	tStore.SaveTransaction(trx51)
 	tStore.SaveTransaction(trx52)
 	committers6 := []int{5, 4, 6, 3}
-	setSortitionParams(block5.Hash(), seed5, committers6)
 
 	tLastInfo.SetSortitionSeed(seed5)
 	tLastInfo.SetBlockHeight(5)
 	tLastInfo.SetBlockHash(block5.Hash())
 	tLastInfo.SetCertificate(cert5)
 	tLastInfo.SetBlockTime(block5.Header().Time())
-	tLastInfo.SaveLastInfo()
+	cmt, err := committee.NewCommittee([]*validator.Validator{val5, val4, val6, val3}, 4, val6.Address())
+	assert.NoError(t, err)
+	tLastInfo.SaveLastInfo(cmt, tSortition)
+
+	setSortitionParams(block5.Hash(), seed5, committers6)
+}
+
+// saveLastInfoOfOlderVersion saves the last info as an older version,
+// without the committee and the sortition params.
+func saveLastInfoOfOlderVersion() {
+	lid := lastInfoData{
+		LastBlockHeight: tLastInfo.BlockHeight(),
+		LastCertificate: tLastInfo.Certificate(),
+	}
+	bs, _ := cbor.Marshal(&lid)
+	tStore.SaveLastInfo(bs)
 }
 
 func TestRestore(t *testing.T) {
@@ -209,6 +225,10 @@ func TestRestore(t *testing.T) {
 	li := NewLastInfo(tStore)
 	srt := sortition.NewSortition()
 
+	height, err := li.RestoreBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, height, 5)
+
 	cmt, err := li.RestoreLastInfo(4, srt)
 	assert.NoError(t, err)
 
@@ -218,6 +238,29 @@ func TestRestore(t *testing.T) {
 	assert.Equal(t, tLastInfo.Certificate().Hash(), li.Certificate().Hash())
 	assert.Equal(t, tLastInfo.BlockTime(), li.BlockTime())
 	assert.Equal(t, cmt.Committers(), []int{5, 4, 6, 3})
+	assert.Equal(t, cmt.Proposer(0).Number(), 6)
+
+	for i := 1; i < 6; i++ {
+		b, _ := tStore.Block(i)
+		seed1, stake1 := srt.GetParams(b.Hash())
+		seed2, stake2 := tSortition.GetParams(b.Hash())
+
+		assert.Equal(t, seed1, seed2, "Invalid seed for block %v", i)
+		assert.Equal(t, stake1, stake2, "Invalid stake for block %v", i)
+	}
+}
+
+func TestRestoreOlderVersion(t *testing.T) {
+	setup(t)
+	saveLastInfoOfOlderVersion()
+
+	li := NewLastInfo(tStore)
+	srt := sortition.NewSortition()
+
+	cmt, err := li.RestoreLastInfo(4, srt)
+	assert.NoError(t, err)
+	assert.Equal(t, cmt.Committers(), []int{5, 4, 6, 3})
+	assert.Equal(t, cmt.Proposer(0).Number(), 6)
 
 	for i := 1; i < 6; i++ {
 		b, _ := tStore.Block(i)
@@ -247,6 +290,7 @@ func TestRestoreFailed(t *testing.T) {
 		li := NewLastInfo(tStore)
 		srt := sortition.NewSortition()
 
+		saveLastInfoOfOlderVersion()
 		tStore.Transactions = make(map[hash.Hash]tx.Tx) // Reset transactions
 		_, err := li.RestoreLastInfo(4, srt)
 		assert.Error(t, err)
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/genesis"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
@@ -19,19 +22,19 @@ func TestChangeAcc(t *testing.T) {
 	acc3, _ := account.GenerateTestAccount(3)
 	acc4, _ := account.GenerateTestAccount(4)
 
-	tState1.store.UpdateAccount(acc1)
-	tState1.store.UpdateAccount(acc2)
-	tState1.store.UpdateAccount(acc3)
-	tState1.store.UpdateAccount(acc4)
+	tState1.updateAccount(acc1)
+	tState1.updateAccount(acc2)
+	tState1.updateAccount(acc3)
+	tState1.updateAccount(acc4)
 	root1 := tState1.accountsMerkleRootHash()
 
 	// Change an account
 	acc3.IncSequence()
 
-	tState2.store.UpdateAccount(acc2)
-	tState2.store.UpdateAccount(acc3)
-	tState2.store.UpdateAccount(acc1)
-	tState2.store.UpdateAccount(acc4)
+	tState2.updateAccount(acc2)
+	tState2.updateAccount(acc3)
+	tState2.updateAccount(acc1)
+	tState2.updateAccount(acc4)
 	root2 := tState2.accountsMerkleRootHash()
 
 	assert.NotEqual(t, root1, root2)
@@ -47,19 +50,19 @@ func TestChangeVal(t *testing.T) {
 	val3, _ := validator.GenerateTestValidator(6)
 	val4, _ := validator.GenerateTestValidator(7)
 
-	tState1.store.UpdateValidator(val1)
-	tState1.store.UpdateValidator(val2)
-	tState1.store.UpdateValidator(val3)
-	tState1.store.UpdateValidator(val4)
+	tState1.updateValidator(val1)
+	tState1.updateValidator(val2)
+	tState1.updateValidator(val3)
+	tState1.updateValidator(val4)
 	root1 := tState1.validatorsMerkleRootHash()
 
 	// Change a validtor
 	val3.IncSequence()
 
-	tState2.store.UpdateValidator(val2)
-	tState2.store.UpdateValidator(val3)
-	tState2.store.UpdateValidator(val1)
-	tState2.store.UpdateValidator(val4)
+	tState2.updateValidator(val2)
+	tState2.updateValidator(val3)
+	tState2.updateValidator(val1)
+	tState2.updateValidator(val4)
 	root2 := tState2.validatorsMerkleRootHash()
 
 	assert.NotEqual(t, root1, root2)
@@ -71,3 +74,57 @@ func TestCalculatingGenesisState(t *testing.T) {
 	r := tState1.calculateGenesisStateHashFromGenesisDoc()
 	assert.Equal(t, tState1.stateHash(), r)
 }
+
+func TestIncrementalStateHash(t *testing.T) {
+	setup(t)
+
+	moveToNextHeightForAllStates(t)
+	moveToNextHeightForAllStates(t)
+	moveToNextHeightForAllStates(t)
+
+	stateHash := tState1.stateHash()
+	tState1.loadMerkleTrees()
+	assert.Equal(t, tState1.stateHash(), stateHash)
+}
+
+func TestStateProofs(t *testing.T) {
+	setup(t)
+
+	moveToNextHeightForAllStates(t)
+
+	stateHash := tState1.stateHash()
+
+	acc, proof, height := tState1.AccountProof(crypto.TreasuryAddress)
+	assert.True(t, proof.Verify(acc.Hash(), stateHash))
+	assert.Equal(t, height, tState1.LastBlockHeight()+1)
+
+	val, proof, height := tState1.ValidatorProof(tValSigner3.Address())
+	assert.True(t, proof.Verify(val.Hash(), stateHash))
+	assert.False(t, proof.Verify(acc.Hash(), stateHash))
+	assert.Equal(t, height, tState1.LastBlockHeight()+1)
+
+	acc, proof, _ = tState1.AccountProof(crypto.GenerateTestAddress())
+	assert.Nil(t, acc)
+	assert.Nil(t, proof)
+}
+
+func TestStateHashBeforeUpgrade(t *testing.T) {
+	setup(t)
+
+	// The network has not activated the protocol version 2 yet
+	tState1.genDoc = genesis.MakeGenesis(tState1.genDoc.GenesisTime(), tState1.genDoc.Accounts(),
+		tState1.genDoc.Validators(), tState1.genDoc.Params())
+
+	accRootHash := tState1.accountsMerkleRootHash()
+	valRootHash := tState1.validatorsMerkleRootHash()
+	stakingRootHash := *simplemerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+	assert.Equal(t, tState1.stateHash(), stakingRootHash)
+	assert.Equal(t, tState1.calculateGenesisStateHashFromGenesisDoc(), stakingRootHash)
+	assert.Equal(t, tState1.makeSnapshot().StateHash(1), stakingRootHash)
+
+	acc, proof, _ := tState1.AccountProof(crypto.TreasuryAddress)
+	assert.True(t, proof.Verify(acc.Hash(), stakingRootHash))
+
+	val, proof, _ := tState1.ValidatorProof(tValSigner3.Address())
+	assert.True(t, proof.Verify(val.Hash(), stakingRootHash))
+}
@@ -4,56 +4,187 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
-func (st *state) accountsMerkleRootHash() hash.Hash {
-	total := st.store.TotalAccounts()
-
-	hashes := make([]hash.Hash, total)
+// loadMerkleTrees builds the accounts, validators, delegations and proposals merkle trees from the store.
+// After loading, the trees are updated incrementally on committing each block.
+func (st *state) loadMerkleTrees() {
+	totalAccount := st.store.TotalAccounts()
+	accHashes := make([]hash.Hash, totalAccount)
 	st.store.IterateAccounts(func(acc *account.Account) (stop bool) {
-		if acc.Number() >= total {
+		if acc.Number() >= totalAccount {
 			panic("Account number is out of range")
 		}
-		if !hashes[acc.Number()].IsUndef() {
+		if !accHashes[acc.Number()].IsUndef() {
 			panic("Duplicated account number")
 		}
-		hashes[acc.Number()] = acc.Hash()
+		accHashes[acc.Number()] = acc.Hash()
 
 		return false
 	})
 
-	tree := simplemerkle.NewTreeFromHashes(hashes)
-	return tree.Root()
-}
-
-func (st *state) validatorsMerkleRootHash() hash.Hash {
-	total := st.store.TotalValidators()
-	hashes := make([]hash.Hash, total)
+	totalValidator := st.store.TotalValidators()
+	valHashes := make([]hash.Hash, totalValidator)
 	st.store.IterateValidators(func(val *validator.Validator) (stop bool) {
-		if val.Number() >= total {
+		if val.Number() >= totalValidator {
 			panic("Validator number is out of range")
 		}
-		if !hashes[val.Number()].IsUndef() {
+		if !valHashes[val.Number()].IsUndef() {
 			panic("Duplicated validator number")
 		}
+		valHashes[val.Number()] = val.Hash()
+
+		return false
+	})
+
+	totalDelegation := st.store.TotalDelegations()
+	delHashes := make([]hash.Hash, totalDelegation)
+	st.store.IterateDelegations(func(d *validator.Delegation) (stop bool) {
+		if d.Number() >= totalDelegation {
+			panic("Delegation number is out of range")
+		}
+		if !delHashes[d.Number()].IsUndef() {
+			panic("Duplicated delegation number")
+		}
+		delHashes[d.Number()] = d.Hash()
+
+		return false
+	})
+
+	totalProposal := st.store.TotalProposals()
+	propHashes := make([]hash.Hash, totalProposal)
+	st.store.IterateProposals(func(p *param.Proposal) (stop bool) {
+		if p.Number() >= totalProposal {
+			panic("Proposal number is out of range")
+		}
+		if !propHashes[p.Number()].IsUndef() {
+			panic("Duplicated proposal number")
+		}
+		propHashes[p.Number()] = p.Hash()
 
-		hashes[val.Number()] = val.Hash()
 		return false
 	})
-	tree := simplemerkle.NewTreeFromHashes(hashes)
-	return tree.Root()
+
+	st.accountMerkle = simplemerkle.NewTree()
+	for i, h := range accHashes {
+		st.accountMerkle.SetHash(i, h)
+	}
+
+	st.validatorMerkle = simplemerkle.NewTree()
+	for i, h := range valHashes {
+		st.validatorMerkle.SetHash(i, h)
+	}
+
+	st.delegationMerkle = simplemerkle.NewTree()
+	for i, h := range delHashes {
+		st.delegationMerkle.SetHash(i, h)
+	}
+
+	st.proposalMerkle = simplemerkle.NewTree()
+	for i, h := range propHashes {
+		st.proposalMerkle.SetHash(i, h)
+	}
 }
 
-func (st *state) stateHash() hash.Hash {
+func (st *state) updateAccount(acc *account.Account) {
+	st.store.UpdateAccount(acc)
+	st.accountMerkle.SetHash(acc.Number(), acc.Hash())
+}
+
+func (st *state) updateValidator(val *validator.Validator) {
+	st.store.UpdateValidator(val)
+	st.validatorMerkle.SetHash(val.Number(), val.Hash())
+}
+
+func (st *state) updateDelegation(d *validator.Delegation) {
+	st.store.UpdateDelegation(d)
+	st.delegationMerkle.SetHash(d.Number(), d.Hash())
+}
+
+func (st *state) updateProposal(p *param.Proposal) {
+	st.store.UpdateProposal(p)
+	st.proposalMerkle.SetHash(p.Number(), p.Hash())
+}
+
+func (st *state) accountsMerkleRootHash() hash.Hash {
+	return st.accountMerkle.Root()
+}
+
+func (st *state) validatorsMerkleRootHash() hash.Hash {
+	return st.validatorMerkle.Root()
+}
+
+func (st *state) delegationsMerkleRootHash() hash.Hash {
+	return st.delegationMerkle.Root()
+}
+
+func (st *state) proposalsMerkleRootHash() hash.Hash {
+	return st.proposalMerkle.Root()
+}
+
+// stakingRootHash is the root of the accounts and validators trees.
+func (st *state) stakingRootHash() hash.Hash {
 	accRootHash := st.accountsMerkleRootHash()
 	valRootHash := st.validatorsMerkleRootHash()
 
-	rootHash := simplemerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+	return *simplemerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+}
+
+// governanceRootHash is the root of the delegations and proposals trees.
+func (st *state) governanceRootHash() hash.Hash {
+	delRootHash := st.delegationsMerkleRootHash()
+	propRootHash := st.proposalsMerkleRootHash()
+
+	return *simplemerkle.HashMerkleBranches(&delRootHash, &propRootHash)
+}
+
+// hasGovernanceRoot returns true if the state hash of the next block includes the governance trees.
+// Before protocol version 2, the state hash is the root of the accounts and validators trees.
+func (st *state) hasGovernanceRoot() bool {
+	return st.rules(st.lastInfo.BlockHeight()+1).ProtocolVersion >= 2
+}
+
+// stateHash returns the state hash of the next block.
+func (st *state) stateHash() hash.Hash {
+	stakingRootHash := st.stakingRootHash()
+	if !st.hasGovernanceRoot() {
+		return stakingRootHash
+	}
+	govRootHash := st.governanceRootHash()
+
+	rootHash := simplemerkle.HashMerkleBranches(&stakingRootHash, &govRootHash)
 
 	return *rootHash
 }
 
+// accountProof returns the merkle proof of the account against the state hash.
+func (st *state) accountProof(acc *account.Account) *simplemerkle.Proof {
+	proof := st.accountMerkle.Proof(acc.Number())
+	if proof == nil {
+		return nil
+	}
+	proof.AppendSibling(st.validatorsMerkleRootHash(), false)
+	if st.hasGovernanceRoot() {
+		proof.AppendSibling(st.governanceRootHash(), false)
+	}
+	return proof
+}
+
+// validatorProof returns the merkle proof of the validator against the state hash.
+func (st *state) validatorProof(val *validator.Validator) *simplemerkle.Proof {
+	proof := st.validatorMerkle.Proof(val.Number())
+	if proof == nil {
+		return nil
+	}
+	proof.AppendSibling(st.accountsMerkleRootHash(), true)
+	if st.hasGovernanceRoot() {
+		proof.AppendSibling(st.governanceRootHash(), false)
+	}
+	return proof
+}
+
 func (st *state) calculateGenesisStateHashFromGenesisDoc() hash.Hash {
 	accs := st.genDoc.Accounts()
 	vals := st.genDoc.Validators()
@@ -71,6 +202,14 @@ func (st *state) calculateGenesisStateHashFromGenesisDoc() hash.Hash {
 	valTree := simplemerkle.NewTreeFromHashes(valHashes)
 	accRootHash := accTree.Root()
 	valRootHash := valTree.Root()
+	stakingRootHash := simplemerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+	if st.rules(1).ProtocolVersion < 2 {
+		return *stakingRootHash
+	}
+	// There is no delegation or proposal at genesis
+	delRootHash := hash.UndefHash
+	propRootHash := hash.UndefHash
+	govRootHash := simplemerkle.HashMerkleBranches(&delRootHash, &propRootHash)
 
-	return *simplemerkle.HashMerkleBranches(&accRootHash, &valRootHash)
+	return *simplemerkle.HashMerkleBranches(stakingRootHash, govRootHash)
 }
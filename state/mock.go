@@ -8,11 +8,17 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/execution"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/snapshot"
 	"github.com/zarbchain/zarb-go/store"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/txpool"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
@@ -27,6 +33,9 @@ type MockState struct {
 	TxPool               *txpool.MockTxPool
 	InvalidBlockHash     hash.Hash
 	Committee            *committee.Committee
+	Snapshot             *snapshot.Snapshot
+	Evidences            []*evidence.Evidence
+	Version              int
 	Lock                 sync.RWMutex
 }
 
@@ -36,6 +45,7 @@ func MockingState(committee *committee.Committee) *MockState {
 		Store:     store.MockingStore(),
 		TxPool:    txpool.MockingTxPool(),
 		Committee: committee,
+		Version:   param.ProtocolVersion,
 	}
 }
 
@@ -74,6 +84,11 @@ func (m *MockState) BlockTime() time.Duration {
 	defer m.Lock.RUnlock()
 	return time.Second
 }
+func (m *MockState) ProtocolVersion() int {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	return m.Version
+}
 func (m *MockState) UpdateLastCertificate(cert *block.Certificate) error {
 	m.Lock.Lock()
 	defer m.Lock.Unlock()
@@ -107,6 +122,18 @@ func (m *MockState) ProposeBlock(round int) (*block.Block, error) {
 func (m *MockState) ValidateBlock(block *block.Block) error {
 	return nil
 }
+func (m *MockState) VerifyCertificate(height int, cert *block.Certificate) error {
+	if height != m.LastBlockHeight()+1 {
+		return errors.Errorf(errors.ErrUnknownCommittee, "committee at height %v is not known", height)
+	}
+	if err := cert.SanityCheck(); err != nil {
+		return err
+	}
+	if cert.BlockHash().EqualsTo(m.InvalidBlockHash) {
+		return errors.Errorf(errors.ErrInvalidBlock, "invalid certificate")
+	}
+	return nil
+}
 func (m *MockState) AddBlock(h int, b *block.Block, trxs []*tx.Tx) {
 	m.Lock.Lock()
 	defer m.Lock.Unlock()
@@ -176,6 +203,24 @@ func (m *MockState) Transaction(id tx.ID) *tx.Tx {
 	tx, _ := m.Store.Transaction(id)
 	return tx
 }
+func (m *MockState) TransactionBlockHeight(id tx.ID) int {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	h, _ := m.Store.TransactionBlockHeight(id)
+	return h
+}
+func (m *MockState) AccountTransactions(addr crypto.Address, page int) []*tx.Tx {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	ids := m.Store.AddressTransactions(addr, page*TransactionsPerPage, TransactionsPerPage)
+	trxs := make([]*tx.Tx, 0, len(ids))
+	for _, id := range ids {
+		if trx, err := m.Store.Transaction(id); err == nil {
+			trxs = append(trxs, trx)
+		}
+	}
+	return trxs
+}
 func (m *MockState) Block(height int) *block.Block {
 	m.Lock.RLock()
 	defer m.Lock.RUnlock()
@@ -206,11 +251,73 @@ func (m *MockState) ValidatorByNumber(n int) *validator.Validator {
 	v, _ := m.Store.ValidatorByNumber(n)
 	return v
 }
+func (m *MockState) AccountProof(addr crypto.Address) (*account.Account, *simplemerkle.Proof, int) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	height := m.Store.LastBlockHeight() + 1
+	a, _ := m.Store.Account(addr)
+	if a == nil {
+		return nil, nil, height
+	}
+	accTree, valTree, govRootHash := m.merkleTrees()
+	proof := accTree.Proof(a.Number())
+	if proof != nil {
+		proof.AppendSibling(valTree.Root(), false)
+		proof.AppendSibling(govRootHash, false)
+	}
+	return a, proof, height
+}
+func (m *MockState) ValidatorProof(addr crypto.Address) (*validator.Validator, *simplemerkle.Proof, int) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	height := m.Store.LastBlockHeight() + 1
+	v, _ := m.Store.Validator(addr)
+	if v == nil {
+		return nil, nil, height
+	}
+	accTree, valTree, govRootHash := m.merkleTrees()
+	proof := valTree.Proof(v.Number())
+	if proof != nil {
+		proof.AppendSibling(accTree.Root(), true)
+		proof.AppendSibling(govRootHash, false)
+	}
+	return v, proof, height
+}
+func (m *MockState) merkleTrees() (*simplemerkle.Tree, *simplemerkle.Tree, hash.Hash) {
+	accTree := simplemerkle.NewTree()
+	m.Store.IterateAccounts(func(acc *account.Account) bool {
+		accTree.SetHash(acc.Number(), acc.Hash())
+		return false
+	})
+	valTree := simplemerkle.NewTree()
+	m.Store.IterateValidators(func(val *validator.Validator) bool {
+		valTree.SetHash(val.Number(), val.Hash())
+		return false
+	})
+	delTree := simplemerkle.NewTree()
+	m.Store.IterateDelegations(func(d *validator.Delegation) bool {
+		delTree.SetHash(d.Number(), d.Hash())
+		return false
+	})
+	propTree := simplemerkle.NewTree()
+	m.Store.IterateProposals(func(p *param.Proposal) bool {
+		propTree.SetHash(p.Number(), p.Hash())
+		return false
+	})
+	delRootHash := delTree.Root()
+	propRootHash := propTree.Root()
+	return accTree, valTree, *simplemerkle.HashMerkleBranches(&delRootHash, &propRootHash)
+}
 func (m *MockState) PendingTx(id tx.ID) *tx.Tx {
 	m.Lock.RLock()
 	defer m.Lock.RUnlock()
 	return m.TxPool.PendingTx(id)
 }
+func (m *MockState) PendingTxsByShortID(key hash.Hash, shortIDs []uint64) ([]*tx.Tx, error) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	return m.TxPool.PendingTxsByShortID(key, shortIDs)
+}
 func (m *MockState) AddPendingTx(trx *tx.Tx) error {
 	m.Lock.Lock()
 	defer m.Lock.Unlock()
@@ -219,6 +326,31 @@ func (m *MockState) AddPendingTx(trx *tx.Tx) error {
 	}
 	return m.TxPool.AppendTx(trx)
 }
+func (m *MockState) SimulateTx(trx *tx.Tx) (*Simulation, error) {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+
+	if err := trx.SanityCheck(); err != nil {
+		return nil, err
+	}
+	sim := &Simulation{Fee: trx.Fee()}
+	acc, err := m.Store.Account(trx.Payload().Signer())
+	if err == nil {
+		sim.Accounts = append(sim.Accounts, acc)
+	}
+	return sim, nil
+}
+func (m *MockState) EstimateFee(amount int64, payloadType payload.Type) *FeeEstimation {
+	est := &FeeEstimation{
+		PoolOccupancy: m.TxPool.Occupancy(payloadType),
+	}
+	if !tx.IsFreePayload(payloadType) {
+		params := param.DefaultParams()
+		est.MinimumFee = execution.MinimumFee(amount, params.FeeFraction, params.MinimumFee)
+		est.RecommendedFee = est.MinimumFee
+	}
+	return est
+}
 func (m *MockState) AddPendingTxAndBroadcast(trx *tx.Tx) error {
 	m.Lock.Lock()
 	defer m.Lock.Unlock()
@@ -228,3 +360,30 @@ func (m *MockState) AddPendingTxAndBroadcast(trx *tx.Tx) error {
 	}
 	return m.TxPool.AppendTxAndBroadcast(trx)
 }
+func (m *MockState) AddEvidence(ev *evidence.Evidence) error {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+
+	if err := ev.SanityCheck(); err != nil {
+		return err
+	}
+	m.Evidences = append(m.Evidences, ev)
+	return nil
+}
+func (m *MockState) LastSnapshot() *snapshot.Snapshot {
+	m.Lock.RLock()
+	defer m.Lock.RUnlock()
+	return m.Snapshot
+}
+func (m *MockState) ImportSnapshot(snap *snapshot.Snapshot, nextBlock *block.Block, nextCert *block.Certificate) error {
+	m.Lock.Lock()
+	defer m.Lock.Unlock()
+	if err := snap.SanityCheck(); err != nil {
+		return err
+	}
+	if !nextBlock.Header().PrevBlockHash().EqualsTo(snap.BlockHash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "next block doesn't follow the snapshot")
+	}
+	m.Snapshot = snap
+	return nil
+}
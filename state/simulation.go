@@ -0,0 +1,56 @@
+package state
+
+import (
+	"sort"
+
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/execution"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// Simulation is the result of executing a transaction against the current state.
+// Accounts and validators are the ones that would be changed by the transaction.
+type Simulation struct {
+	Fee        int64
+	Accounts   []*account.Account
+	Validators []*validator.Validator
+}
+
+// SimulateTx executes the transaction in a throwaway sandbox. Nothing is committed to the state.
+func (st *state) SimulateTx(trx *tx.Tx) (*Simulation, error) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	sb := st.concreteSandbox()
+	checker := execution.NewChecker()
+	if err := checker.Execute(trx, sb); err != nil {
+		return nil, err
+	}
+
+	return makeSimulation(sb, checker.AccumulatedFee()), nil
+}
+
+func makeSimulation(sb sandbox.Sandbox, fee int64) *Simulation {
+	sim := &Simulation{Fee: fee}
+	sb.IterateAccounts(func(as *sandbox.AccountStatus) {
+		if as.Updated {
+			acc := as.Account
+			sim.Accounts = append(sim.Accounts, &acc)
+		}
+	})
+	sb.IterateValidators(func(vs *sandbox.ValidatorStatus) {
+		if vs.Updated {
+			val := vs.Validator
+			sim.Validators = append(sim.Validators, &val)
+		}
+	})
+	sort.Slice(sim.Accounts, func(i, j int) bool {
+		return sim.Accounts[i].Number() < sim.Accounts[j].Number()
+	})
+	sort.Slice(sim.Validators, func(i, j int) bool {
+		return sim.Validators[i].Number() < sim.Validators[j].Number()
+	})
+	return sim
+}
@@ -0,0 +1,247 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/sandbox"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+// makeSnapshot takes a snapshot from the current state.
+func (st *state) makeSnapshot() *snapshot.Snapshot {
+	accs := make([]*account.Account, st.store.TotalAccounts())
+	st.store.IterateAccounts(func(acc *account.Account) (stop bool) {
+		accs[acc.Number()] = acc
+		return false
+	})
+
+	vals := make([]*validator.Validator, st.store.TotalValidators())
+	st.store.IterateValidators(func(val *validator.Validator) (stop bool) {
+		vals[val.Number()] = val
+		return false
+	})
+
+	delegations := make([]*validator.Delegation, st.store.TotalDelegations())
+	st.store.IterateDelegations(func(d *validator.Delegation) (stop bool) {
+		delegations[d.Number()] = d
+		return false
+	})
+
+	proposals := make([]*param.Proposal, st.store.TotalProposals())
+	st.store.IterateProposals(func(p *param.Proposal) (stop bool) {
+		proposals[p.Number()] = p
+		return false
+	})
+
+	return snapshot.NewSnapshot(
+		st.lastInfo.BlockHeight(),
+		st.latestHeaders(),
+		st.lastInfo.Certificate(),
+		st.committee.Committers(),
+		st.committee.Proposer(0).Address(),
+		st.sortition.Params(),
+		accs,
+		vals,
+		delegations,
+		proposals)
+}
+
+// latestHeaders returns the headers of the latest blocks, from the oldest to the newest.
+func (st *state) latestHeaders() []block.Header {
+	// Blocks before an imported snapshot might not be in the store,
+	// their headers are inside the snapshot.
+	var lastSnap *snapshot.Snapshot
+	headers := make([]block.Header, 0, st.latestBlocks.Size())
+	for e := st.latestBlocks.FirstElement(); e != nil; e = e.Next() {
+		bi := e.Value.(*linkedmap.Pair).Second.(*sandbox.BlockInfo)
+		if bi.Height() == 0 {
+			// Ignore the genesis block info
+			continue
+		}
+		b, err := st.store.Block(bi.Height())
+		if err == nil {
+			headers = append(headers, *b.Header())
+			continue
+		}
+		if lastSnap == nil {
+			lastSnap = st.loadSnapshot()
+		}
+		var h *block.Header
+		if lastSnap != nil {
+			h = lastSnap.Header(bi.Height())
+		}
+		if h == nil {
+			st.logger.Panic("unable to retrieve the block header", "height", bi.Height(), "err", err)
+		}
+		headers = append(headers, *h)
+	}
+	return headers
+}
+
+func (st *state) saveSnapshot(snap *snapshot.Snapshot) error {
+	data, err := snap.Encode()
+	if err != nil {
+		return err
+	}
+	st.store.SaveSnapshot(data)
+	return st.store.WriteBatch()
+}
+
+// loadSnapshot loads the latest snapshot from the store. It returns nil if there is no snapshot.
+func (st *state) loadSnapshot() *snapshot.Snapshot {
+	data := st.store.RestoreSnapshot()
+	if data == nil {
+		return nil
+	}
+	snap := new(snapshot.Snapshot)
+	if err := snap.Decode(data); err != nil {
+		st.logger.Error("unable to decode the snapshot", "err", err)
+		return nil
+	}
+	return snap
+}
+
+// LastSnapshot returns the latest snapshot that this node has taken or imported.
+func (st *state) LastSnapshot() *snapshot.Snapshot {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.loadSnapshot()
+}
+
+// ImportSnapshot replaces the state of a fresh node with the snapshot.
+// The snapshot should be taken at the trusted checkpoint and
+// it is verified against the state hash of the next block.
+// The next block should be certified by the committee of the snapshot.
+// The pool stakes of the blocks before the snapshot height are not committed by the blockchain,
+// so they can't be verified and they are taken from the snapshot as they are.
+func (st *state) ImportSnapshot(snap *snapshot.Snapshot, nextBlock *block.Block, nextCert *block.Certificate) error {
+	st.lk.Lock()
+	defer st.lk.Unlock()
+
+	if st.lastInfo.BlockHeight() != 0 {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "state is not empty")
+	}
+
+	if err := st.verifySnapshot(snap, nextBlock, nextCert); err != nil {
+		return err
+	}
+
+	committee, err := snap.Committee(st.params.CommitteeSize)
+	if err != nil {
+		return err
+	}
+
+	for _, acc := range snap.Accounts() {
+		st.store.UpdateAccount(acc)
+	}
+	for _, val := range snap.Validators() {
+		st.store.UpdateValidator(val)
+	}
+	for _, d := range snap.Delegations() {
+		st.store.UpdateDelegation(d)
+	}
+	for _, p := range snap.Proposals() {
+		st.store.UpdateProposal(p)
+	}
+
+	st.lastInfo.SetBlockHeight(snap.BlockHeight())
+	st.lastInfo.SetBlockHash(snap.BlockHash())
+	st.lastInfo.SetBlockTime(snap.BlockTime())
+	st.lastInfo.SetSortitionSeed(snap.SortitionSeed())
+	st.lastInfo.SetCertificate(snap.Certificate())
+	st.lastInfo.SaveLastInfo(committee, st.sortition)
+
+	if err := st.saveSnapshot(snap); err != nil {
+		st.logger.Panic("unable to import snapshot", "err", err)
+	}
+
+	st.committee = committee
+	st.restoreParams(snap.Proposals(), snap.BlockHeight()+1)
+	st.loadMerkleTrees()
+	for _, p := range snap.SortitionParams() {
+		st.sortition.SetParams(p.BlockHash, p.Seed, p.PoolStake)
+	}
+
+	st.latestBlocks.Clear()
+	first := snap.BlockHeight() - len(snap.BlockHashes()) + 1
+	for i, h := range snap.BlockHashes() {
+		st.latestBlocks.PushBack(h.Stamp(), sandbox.NewBlockInfo(first+i, h))
+	}
+
+	st.logger.Info("snapshot imported", "snapshot", snap)
+
+	st.txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
+
+	return nil
+}
+
+func (st *state) verifySnapshot(snap *snapshot.Snapshot, nextBlock *block.Block, nextCert *block.Certificate) error {
+	if err := snap.SanityCheck(); err != nil {
+		return err
+	}
+	// The certificates inside the snapshot are signed by the validators of the snapshot itself,
+	// so the snapshot should be anchored to a block that we trust.
+	if st.config.CheckpointHeight == 0 {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "no trusted checkpoint is set")
+	}
+	if snap.BlockHeight() != st.config.CheckpointHeight {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"snapshot is not taken at the checkpoint. Expected %v, got %v", st.config.CheckpointHeight, snap.BlockHeight())
+	}
+	checkpointHash, err := hash.FromString(st.config.CheckpointHash)
+	if err != nil {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "invalid checkpoint hash: %v", err)
+	}
+	if !snap.BlockHash().EqualsTo(checkpointHash) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"snapshot block hash is not same as the checkpoint. Expected %v, got %v", checkpointHash, snap.BlockHash())
+	}
+	if err := nextBlock.SanityCheck(); err != nil {
+		return err
+	}
+	if !nextBlock.Header().PrevBlockHash().EqualsTo(snap.BlockHash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "next block doesn't follow the snapshot")
+	}
+	if !nextBlock.Header().PrevCertificateHash().EqualsTo(snap.Certificate().Hash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "next block has different certificate")
+	}
+	stateHash := snap.StateHash(st.rules(snap.BlockHeight() + 1).ProtocolVersion)
+	if !nextBlock.Header().StateHash().EqualsTo(stateHash) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"state hash is not same as we expected. Expected %v, got %v", nextBlock.Header().StateHash(), stateHash)
+	}
+	if err := verifyCertificate(snap.Certificate(), snap.Validator); err != nil {
+		return err
+	}
+	if err := verifyCertificate(nextCert, snap.Validator); err != nil {
+		return err
+	}
+	if !nextCert.BlockHash().EqualsTo(nextBlock.Hash()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "certificate has invalid block hash")
+	}
+	if !util.Equal(nextCert.Committers(), snap.Committers()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "certificate has invalid committers")
+	}
+	committee, err := snap.Committee(st.params.CommitteeSize)
+	if err != nil {
+		return err
+	}
+	proposer := committee.Proposer(nextCert.Round())
+	if !proposer.Address().EqualsTo(nextBlock.Header().ProposerAddress()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot,
+			"snapshot has invalid proposer. Expected %v, got %v", nextBlock.Header().ProposerAddress(), proposer.Address())
+	}
+	nextSeed := nextBlock.Header().SortitionSeed()
+	if !nextSeed.Verify(proposer.PublicKey(), snap.SortitionSeed()) {
+		return errors.Errorf(errors.ErrInvalidSnapshot, "next block has invalid sortition seed")
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/event"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/sortition"
+	"github.com/zarbchain/zarb-go/store"
+)
+
+func TestMakeSnapshot(t *testing.T) {
+	setup(t)
+
+	tState1.config.SnapshotInterval = 4
+	assert.Nil(t, tState1.LastSnapshot())
+
+	for i := 0; i < 5; i++ {
+		moveToNextHeightForAllStates(t)
+	}
+
+	snap := tState1.LastSnapshot()
+	require.NotNil(t, snap)
+	assert.NoError(t, snap.SanityCheck())
+	assert.Equal(t, snap.BlockHeight(), 4)
+	assert.Equal(t, snap.BlockHash(), tState1.Block(4).Hash())
+	assert.Equal(t, snap.StateHash(param.ProtocolVersion), tState1.Block(5).Header().StateHash())
+	assert.Equal(t, len(snap.BlockHashes()), 4)
+	assert.Nil(t, tState2.LastSnapshot())
+}
+
+func TestImportSnapshot(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < 5; i++ {
+		moveToNextHeightForAllStates(t)
+	}
+
+	snap := tState1.makeSnapshot()
+	assert.Equal(t, snap.StateHash(param.ProtocolVersion), tState1.stateHash())
+	b6, c6 := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+
+	newStateWithCheckpoint := func(height int, h hash.Hash) *state {
+		conf := TestConfig()
+		conf.CheckpointHeight = height
+		conf.CheckpointHash = h.String()
+		st, err := LoadOrNewState(conf, tState1.genDoc, tValSigner1, store.MockingStore(), tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		return st.(*state)
+	}
+	newState := func() *state {
+		return newStateWithCheckpoint(5, tState1.LastBlockHash())
+	}
+
+	t.Run("No checkpoint, should fail", func(t *testing.T) {
+		st, err := LoadOrNewState(TestConfig(), tState1.genDoc, tValSigner1, store.MockingStore(), tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		assert.Error(t, st.ImportSnapshot(snap, b6, c6))
+		assert.Equal(t, st.LastBlockHeight(), 0)
+	})
+
+	t.Run("Snapshot doesn't match the checkpoint, should fail", func(t *testing.T) {
+		st := newStateWithCheckpoint(4, tState1.Block(4).Hash())
+		assert.Error(t, st.ImportSnapshot(snap, b6, c6))
+		assert.Equal(t, st.LastBlockHeight(), 0)
+
+		st = newStateWithCheckpoint(5, hash.GenerateTestHash())
+		assert.Error(t, st.ImportSnapshot(snap, b6, c6))
+		assert.Equal(t, st.LastBlockHeight(), 0)
+	})
+
+	t.Run("Invalid certificate, should fail", func(t *testing.T) {
+		st := newState()
+		c := makeCertificateAndSign(t, b6.Hash(), 0, tValSigner1)
+		assert.Error(t, st.ImportSnapshot(snap, b6, c))
+		assert.Equal(t, st.LastBlockHeight(), 0)
+	})
+
+	t.Run("Invalid next block, should fail", func(t *testing.T) {
+		st := newState()
+		b, _ := block.GenerateTestBlock(nil, nil)
+		c := makeCertificateAndSign(t, b.Hash(), 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+		assert.Error(t, st.ImportSnapshot(snap, b, c))
+		assert.Equal(t, st.LastBlockHeight(), 0)
+	})
+
+	t.Run("Tampered snapshot, should fail", func(t *testing.T) {
+		tamper := func(headers []block.Header, proposer crypto.Address, params []sortition.Param) *snapshot.Snapshot {
+			return snapshot.NewSnapshot(snap.BlockHeight(), headers, snap.Certificate(), snap.Committers(),
+				proposer, params, snap.Accounts(), snap.Validators(), snap.Delegations(), snap.Proposals())
+		}
+		changeHeader := func(h block.Header, t time.Time, seed sortition.VerifiableSeed) block.Header {
+			return block.NewHeader(h.Version(), h.ProtocolVersion(), t, h.TxIDsHash(), h.PrevBlockHash(),
+				h.StateHash(), h.PrevCertificateHash(), seed, h.ProposerAddress())
+		}
+		cloneHeaders := func() []block.Header {
+			return append([]block.Header{}, snap.Headers()...)
+		}
+		cloneParams := func() []sortition.Param {
+			return append([]sortition.Param{}, snap.SortitionParams()...)
+		}
+		last := len(snap.Headers()) - 1
+
+		headers := cloneHeaders()
+		headers[last] = changeHeader(headers[last], headers[last].Time().Add(time.Hour), headers[last].SortitionSeed())
+		assert.Error(t, newState().ImportSnapshot(tamper(headers, snap.Proposer(), cloneParams()), b6, c6), "block time")
+
+		headers = cloneHeaders()
+		headers[last] = changeHeader(headers[last], headers[last].Time(), sortition.GenerateRandomSeed())
+		assert.Error(t, newState().ImportSnapshot(tamper(headers, snap.Proposer(), cloneParams()), b6, c6), "sortition seed")
+
+		headers = cloneHeaders()
+		headers[0] = changeHeader(headers[0], headers[0].Time().Add(time.Hour), headers[0].SortitionSeed())
+		assert.Error(t, newState().ImportSnapshot(tamper(headers, snap.Proposer(), cloneParams()), b6, c6), "older block hashes")
+
+		proposer := tState1.committee.Proposer(1).Address()
+		assert.Error(t, newState().ImportSnapshot(tamper(cloneHeaders(), proposer, cloneParams()), b6, c6), "proposer")
+
+		params := cloneParams()
+		params[len(params)-1].Seed = sortition.GenerateRandomSeed()
+		assert.Error(t, newState().ImportSnapshot(tamper(cloneHeaders(), snap.Proposer(), params), b6, c6), "sortition seed param")
+
+		params = cloneParams()
+		params[len(params)-1].PoolStake++
+		assert.Error(t, newState().ImportSnapshot(tamper(cloneHeaders(), snap.Proposer(), params), b6, c6), "pool stake param")
+	})
+
+	t.Run("Not a fresh node, should fail", func(t *testing.T) {
+		assert.Error(t, tState2.ImportSnapshot(snap, b6, c6))
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		st := newState()
+		require.NoError(t, st.ImportSnapshot(snap, b6, c6))
+
+		assert.Equal(t, st.LastBlockHeight(), 5)
+		assert.Equal(t, st.LastBlockHash(), tState1.LastBlockHash())
+		assert.Equal(t, st.LastCertificate().Hash(), tState1.LastCertificate().Hash())
+		assert.Equal(t, st.stateHash(), tState1.stateHash())
+		assert.Equal(t, st.committee.Committers(), tState1.committee.Committers())
+		assert.Equal(t, st.PoolStake(), tState1.PoolStake())
+		assert.NotNil(t, st.LastSnapshot())
+
+		// Restart the node before committing any block
+		st2, err := LoadOrNewState(st.config, st.genDoc, tValSigner1, st.store, tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		assert.Equal(t, st2.LastBlockHeight(), 5)
+		assert.Equal(t, st2.(*state).stateHash(), tState1.stateHash())
+		assert.Equal(t, st2.(*state).committee.Committers(), tState1.committee.Committers())
+
+		require.NoError(t, st2.CommitBlock(6, b6, c6))
+		CommitBlockForAllStates(t, b6, c6)
+		moveToNextHeightForAllStates(t)
+		b8, c8 := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+		require.NoError(t, st2.CommitBlock(7, tState1.Block(7), tState1.LastCertificate()))
+
+		// Restart the node after committing some blocks
+		st3, err := LoadOrNewState(st.config, st.genDoc, tValSigner1, st.store, tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		assert.Equal(t, st3.LastBlockHeight(), 7)
+		assert.Equal(t, st3.(*state).committee.Committers(), tState1.committee.Committers())
+		assert.Equal(t, st3.PoolStake(), tState1.PoolStake())
+		assert.NoError(t, st3.CommitBlock(8, b8, c8))
+	})
+}
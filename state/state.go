@@ -8,13 +8,17 @@ import (
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/execution"
 	"github.com/zarbchain/zarb-go/genesis"
 	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/metrics"
 	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/sortition"
@@ -26,21 +30,29 @@ import (
 	"github.com/zarbchain/zarb-go/validator"
 )
 
+// TransactionsPerPage is the maximum number of transactions in each page of the account transactions
+const TransactionsPerPage = 20
+
 type state struct {
 	lk sync.RWMutex
 
-	config       *Config
-	signer       crypto.Signer
-	mintbaseAddr crypto.Address
-	genDoc       *genesis.Genesis
-	store        store.Store
-	params       param.Params
-	txPool       txpool.TxPool
-	committee    *committee.Committee
-	sortition    *sortition.Sortition
-	lastInfo     *lastinfo.LastInfo
-	latestBlocks *linkedmap.LinkedMap
-	logger       *logger.Logger
+	config           *Config
+	signer           crypto.Signer
+	mintbaseAddr     crypto.Address
+	genDoc           *genesis.Genesis
+	store            store.Store
+	params           param.Params
+	txPool           txpool.TxPool
+	committee        *committee.Committee
+	sortition        *sortition.Sortition
+	lastInfo         *lastinfo.LastInfo
+	latestBlocks     *linkedmap.LinkedMap
+	accountMerkle    *simplemerkle.Tree
+	validatorMerkle  *simplemerkle.Tree
+	delegationMerkle *simplemerkle.Tree
+	proposalMerkle   *simplemerkle.Tree
+	eventBus         *event.Bus
+	logger           *logger.Logger
 }
 
 func LoadOrNewState(
@@ -48,7 +60,8 @@ func LoadOrNewState(
 	genDoc *genesis.Genesis,
 	signer crypto.Signer,
 	store store.Store,
-	txPool txpool.TxPool) (Facade, error) {
+	txPool txpool.TxPool,
+	eventBus *event.Bus) (Facade, error) {
 
 	var mintbaseAddr crypto.Address
 	if conf.MintbaseAddress != "" {
@@ -72,11 +85,14 @@ func LoadOrNewState(
 		mintbaseAddr: mintbaseAddr,
 		sortition:    sortition.NewSortition(),
 		lastInfo:     lastinfo.NewLastInfo(store),
+		eventBus:     eventBus,
 	}
 	st.logger = logger.NewLogger("_state", st)
 	st.store = store
+	st.loadMerkleTrees()
 
-	if store.HasAnyBlock() {
+	snap := st.loadSnapshot()
+	if store.HasAnyBlock() || snap != nil {
 		err := st.tryLoadLastInfo()
 		if err != nil {
 			return nil, err
@@ -89,17 +105,32 @@ func LoadOrNewState(
 	}
 
 	// A cache of the latest block info
+	st.store.SetMinRetainBlocks(st.params.TransactionToLiveInterval)
 	st.latestBlocks = linkedmap.NewLinkedMap(st.params.TransactionToLiveInterval)
 	last := st.lastInfo.BlockHeight()
-	first := st.lastInfo.BlockHeight() - st.params.TransactionToLiveInterval
+	first := st.lastInfo.BlockHeight() - st.params.TransactionToLiveInterval + 1
 	if first < 1 {
 		// Adding genesis block info
 		st.latestBlocks.PushBack(hash.UndefHash.Stamp(), sandbox.NewBlockInfo(0, hash.UndefHash))
 		first = 1
 	}
 	for h := first; h <= last; h++ {
+		// Blocks before the snapshot might not be in the store.
+		if snap != nil && h <= snap.BlockHeight() {
+			i := len(snap.BlockHashes()) - 1 - (snap.BlockHeight() - h)
+			if i >= 0 {
+				bh := snap.BlockHashes()[i]
+				st.latestBlocks.PushBack(bh.Stamp(), sandbox.NewBlockInfo(h, bh))
+				continue
+			}
+		}
 		b, err := st.store.Block(h)
 		if err != nil {
+			// The oldest blocks might be pruned before the transaction to live interval is increased.
+			// These blocks were not in the cache before restarting either.
+			if st.latestBlocks.Size() == 0 {
+				continue
+			}
 			return nil, err
 		}
 		st.latestBlocks.PushBack(b.Stamp(), sandbox.NewBlockInfo(h, b.Hash()))
@@ -111,7 +142,8 @@ func LoadOrNewState(
 }
 
 func (st *state) concreteSandbox() sandbox.Sandbox {
-	return sandbox.NewSandbox(st.store, st.params, st.latestBlocks, st.sortition, st.committee)
+	rules := st.rules(st.lastInfo.BlockHeight() + 1)
+	return sandbox.NewSandbox(st.store, st.params, rules, st.latestBlocks, st.sortition, st.committee)
 }
 
 func (st *state) tryLoadLastInfo() error {
@@ -119,16 +151,27 @@ func (st *state) tryLoadLastInfo() error {
 	//
 	// This check is not important because genesis state is committed.
 	// But it is good to have it to make sure genesis doc hasn't changed
+	//
+	// The nodes that are bootstrapped from a snapshot don't have the first block.
 	genHash := st.calculateGenesisStateHashFromGenesisDoc()
 	blockOne, err := st.store.Block(1)
 	if err != nil {
-		return err
-	}
-	if !genHash.EqualsTo(blockOne.Header().StateHash()) {
+		if st.store.RestoreSnapshot() == nil {
+			return err
+		}
+	} else if !genHash.EqualsTo(blockOne.Header().StateHash()) {
 		return fmt.Errorf("invalid genesis doc")
 	}
 
 	logger.Info("try to load the last state info")
+	lastHeight, err := st.lastInfo.RestoreBlockHeight()
+	if err != nil {
+		return err
+	}
+	// The committee size might be changed by the proposals,
+	// so the parameters should be restored before the committee.
+	st.restoreParams(st.storedProposals(), lastHeight+1)
+
 	committee, err := st.lastInfo.RestoreLastInfo(st.params.CommitteeSize, st.sortition)
 	if err != nil {
 		return err
@@ -142,13 +185,13 @@ func (st *state) tryLoadLastInfo() error {
 func (st *state) makeGenesisState(genDoc *genesis.Genesis) error {
 	accs := genDoc.Accounts()
 	for _, acc := range accs {
-		st.store.UpdateAccount(acc)
+		st.updateAccount(acc)
 	}
 
 	totalStake := int64(0)
 	vals := genDoc.Validators()
 	for _, val := range vals {
-		st.store.UpdateValidator(val)
+		st.updateValidator(val)
 		totalStake += val.Stake()
 	}
 
@@ -216,6 +259,14 @@ func (st *state) BlockTime() time.Duration {
 	return st.params.BlockTime()
 }
 
+// ProtocolVersion returns the version of the protocol for the next block.
+func (st *state) ProtocolVersion() int {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.rules(st.lastInfo.BlockHeight() + 1).ProtocolVersion
+}
+
 func (st *state) UpdateLastCertificate(cert *block.Certificate) error {
 	st.lk.Lock()
 	defer st.lk.Unlock()
@@ -239,7 +290,10 @@ func (st *state) createSubsidyTx(fee int64) *tx.Tx {
 	}
 	stamp := st.lastInfo.BlockHash().Stamp()
 	seq := acc.Sequence() + 1
-	tx := tx.NewMintbaseTx(stamp, seq, st.mintbaseAddr, st.params.BlockReward+fee, "")
+	reward := st.params.BlockReward + fee
+	// The delegators' part is paid to them on committing the block
+	amt := reward - st.delegatorsReward(st.signer.Address(), reward)
+	tx := tx.NewMintbaseTx(stamp, seq, st.mintbaseAddr, amt, "")
 	return tx
 }
 
@@ -251,6 +305,11 @@ func (st *state) ProposeBlock(round int) (*block.Block, error) {
 		return nil, errors.Errorf(errors.ErrInvalidAddress, "we are not propser for this round")
 	}
 
+	rules := st.rules(st.lastInfo.BlockHeight() + 1)
+	if err := st.checkProtocolVersion(rules); err != nil {
+		return nil, err
+	}
+
 	// Create new sandbox and execute transactions
 	sb := st.concreteSandbox()
 	exe := execution.NewExecution()
@@ -296,8 +355,15 @@ func (st *state) ProposeBlock(round int) (*block.Block, error) {
 	seed := st.lastInfo.SortitionSeed()
 	newSortitionSeed := seed.Generate(st.signer)
 
+	// Signalling the protocol version is not supported before protocol version 2
+	signal := 0
+	if rules.ProtocolVersion >= 2 {
+		signal = param.ProtocolVersion
+	}
+
 	block := block.MakeBlock(
-		st.params.BlockVersion,
+		rules.BlockVersion,
+		signal,
 		timestamp,
 		txIDs,
 		st.lastInfo.BlockHash(),
@@ -379,6 +445,10 @@ func (st *state) CommitBlock(height int, block *block.Block, cert *block.Certifi
 	if err != nil {
 		return err
 	}
+	if sb.ProtocolVersion() >= 2 {
+		st.updateAvailability(sb, height, block.PrevCertificate())
+		st.recordProtocolSignal(sb, block)
+	}
 
 	// -----------------------------------
 	// Commit block
@@ -387,10 +457,10 @@ func (st *state) CommitBlock(height int, block *block.Block, cert *block.Certifi
 	st.lastInfo.SetBlockTime(block.Header().Time())
 	st.lastInfo.SetSortitionSeed(block.Header().SortitionSeed())
 	st.lastInfo.SetCertificate(cert)
-	st.lastInfo.SaveLastInfo()
 
 	// Commit and update the committee
-	st.commitSandbox(sb, cert.Round())
+	joined, ejected := st.commitSandbox(sb, cert.Round())
+	st.lastInfo.SaveLastInfo(st.committee, st.sortition)
 
 	st.store.SaveBlock(height, block)
 
@@ -400,6 +470,9 @@ func (st *state) CommitBlock(height int, block *block.Block, cert *block.Certifi
 	for _, trx := range trxs {
 		st.txPool.RemoveTx(trx.ID())
 		st.store.SaveTransaction(trx)
+		for _, addr := range trx.Addresses() {
+			st.store.SaveAddressTransaction(addr, height, trx.ID())
+		}
 	}
 
 	if err := st.store.WriteBatch(); err != nil {
@@ -408,10 +481,44 @@ func (st *state) CommitBlock(height int, block *block.Block, cert *block.Certifi
 
 	st.logger.Info("new block is committed", "block", block, "round", cert.Round())
 
+	// Activate the approved parameters and the scheduled upgrades for the next block
+	st.activateProposals(height + 1)
+	st.checkUpgrade(height + 1)
+
+	st.eventBus.Publish(&event.BlockCommittedEvent{
+		Height:      height,
+		Block:       block,
+		Certificate: cert,
+	})
+
+	if len(joined) > 0 || len(ejected) > 0 {
+		addrs := make([]crypto.Address, len(joined))
+		for i, val := range joined {
+			addrs[i] = val.Address()
+		}
+		st.eventBus.Publish(&event.CommitteeChangedEvent{
+			Height:     height,
+			Joined:     addrs,
+			Ejected:    ejected,
+			Committers: st.committee.Committers(),
+		})
+	}
+
 	// -----------------------------------
 	// Update sortition params and evaluate sortition
 	st.sortition.SetParams(block.Hash(), block.Header().SortitionSeed(), st.poolStake())
 
+	// -----------------------------------
+	// Take a snapshot of the state
+	if st.config.SnapshotInterval > 0 && height%st.config.SnapshotInterval == 0 {
+		snap := st.makeSnapshot()
+		if err := st.saveSnapshot(snap); err != nil {
+			st.logger.Error("unable to save the snapshot", "err", err)
+		} else {
+			st.logger.Info("snapshot is taken", "snapshot", snap)
+		}
+	}
+
 	// Evaluate sortition before updating the committee
 	if st.evaluateSortition() {
 		st.logger.Info("👏 this validator is chosen to be in the committee", "address", st.signer.Address())
@@ -446,8 +553,14 @@ func (st *state) evaluateSortition() bool {
 		return false
 	}
 
+	if val.IsJailed() {
+		// We are in the jail
+		return false
+	}
+
 	ok, proof := st.sortition.EvaluateSortition(st.lastInfo.BlockHash(), st.signer, val.Stake())
 	if ok {
+		metrics.SortitionWon()
 		trx := tx.NewSortitionTx(st.lastInfo.BlockHash().Stamp(), val.Sequence()+1, val.Address(), proof)
 		st.signer.SignMsg(trx)
 
@@ -469,7 +582,9 @@ func (st *state) Fingerprint() string {
 		st.lastInfo.BlockTime().Format("15.04.05"))
 }
 
-func (st *state) commitSandbox(sb sandbox.Sandbox, round int) {
+// commitSandbox commits the changes of the sandbox into the state
+// and returns the validators that joined or ejected from the committee.
+func (st *state) commitSandbox(sb sandbox.Sandbox, round int) ([]*validator.Validator, []crypto.Address) {
 	joined := make([]*validator.Validator, 0)
 	sb.IterateValidators(func(vs *sandbox.ValidatorStatus) {
 		if vs.JoinedCommittee {
@@ -486,17 +601,43 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int) {
 		logger.Panic("an error occurred", "err", err)
 	}
 
+	ejected := make([]crypto.Address, 0)
+	sb.IterateValidators(func(vs *sandbox.ValidatorStatus) {
+		if vs.EjectedFromCommittee {
+			st.logger.Info("validator ejected from committee", "address", vs.Validator.Address())
+
+			if err := st.committee.Eject(vs.Validator.Address()); err != nil {
+				logger.Panic("an error occurred", "err", err)
+			}
+			ejected = append(ejected, vs.Validator.Address())
+		}
+	})
+
 	sb.IterateAccounts(func(as *sandbox.AccountStatus) {
 		if as.Updated {
-			st.store.UpdateAccount(&as.Account)
+			st.updateAccount(&as.Account)
 		}
 	})
 
 	sb.IterateValidators(func(vs *sandbox.ValidatorStatus) {
 		if vs.Updated {
-			st.store.UpdateValidator(&vs.Validator)
+			st.updateValidator(&vs.Validator)
+		}
+	})
+
+	sb.IterateDelegations(func(ds *sandbox.DelegationStatus) {
+		if ds.Updated {
+			st.updateDelegation(&ds.Delegation)
+		}
+	})
+
+	sb.IterateProposals(func(ps *sandbox.ProposalStatus) {
+		if ps.Updated {
+			st.updateProposal(&ps.Proposal)
 		}
 	})
+
+	return joined, ejected
 }
 
 func (st *state) validateBlockTime(t time.Time) error {
@@ -602,6 +743,33 @@ func (st *state) Transaction(id tx.ID) *tx.Tx {
 	return tx
 }
 
+func (st *state) TransactionBlockHeight(id tx.ID) int {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	h, err := st.store.TransactionBlockHeight(id)
+	if err != nil {
+		st.logger.Trace("error on retrieving transaction block height", "err", err)
+	}
+	return h
+}
+
+// AccountTransactions returns the committed transactions of the given address, from the newest to the oldest.
+// The first page is zero.
+func (st *state) AccountTransactions(addr crypto.Address, page int) []*tx.Tx {
+	ids := st.store.AddressTransactions(addr, page*TransactionsPerPage, TransactionsPerPage)
+	trxs := make([]*tx.Tx, 0, len(ids))
+	for _, id := range ids {
+		trx, err := st.store.Transaction(id)
+		if err != nil {
+			st.logger.Trace("error on retrieving transaction", "id", id, "err", err)
+			continue
+		}
+		trxs = append(trxs, trx)
+	}
+	return trxs
+}
+
 func (st *state) Block(height int) *block.Block {
 	b, err := st.store.Block(height)
 	if err != nil {
@@ -634,6 +802,24 @@ func (st *state) Validator(addr crypto.Address) *validator.Validator {
 	return val
 }
 
+// VerifyCertificate checks the certificate of the block at this height against the committee of that height.
+// Only the committee of the next height is known, for the later heights an ErrUnknownCommittee error is returned.
+func (st *state) VerifyCertificate(height int, cert *block.Certificate) error {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	if height != st.lastInfo.BlockHeight()+1 {
+		return errors.Errorf(errors.ErrUnknownCommittee, "committee at height %v is not known", height)
+	}
+	if err := st.checkCertificate(cert); err != nil {
+		return err
+	}
+	if !util.Equal(st.committee.Committers(), cert.Committers()) {
+		return errors.Errorf(errors.ErrInvalidBlock, "invalid committers")
+	}
+	return nil
+}
+
 // ValidatorByNumber returns validator data based on validator number
 func (st *state) ValidatorByNumber(n int) *validator.Validator {
 	val, err := st.store.ValidatorByNumber(n)
@@ -642,12 +828,91 @@ func (st *state) ValidatorByNumber(n int) *validator.Validator {
 	}
 	return val
 }
+
+// AccountProof returns the account and its merkle proof against the current state hash.
+// The current state hash will be committed in the header of the next block,
+// and the height of that block is returned as well.
+func (st *state) AccountProof(addr crypto.Address) (*account.Account, *simplemerkle.Proof, int) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	height := st.lastInfo.BlockHeight() + 1
+	acc, err := st.store.Account(addr)
+	if err != nil {
+		st.logger.Trace("error on retrieving account", "err", err)
+		return nil, nil, height
+	}
+	return acc, st.accountProof(acc), height
+}
+
+// ValidatorProof returns the validator and its merkle proof against the current state hash.
+// The current state hash will be committed in the header of the next block,
+// and the height of that block is returned as well.
+func (st *state) ValidatorProof(addr crypto.Address) (*validator.Validator, *simplemerkle.Proof, int) {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	height := st.lastInfo.BlockHeight() + 1
+	val, err := st.store.Validator(addr)
+	if err != nil {
+		st.logger.Trace("error on retrieving validator", "err", err)
+		return nil, nil, height
+	}
+	return val, st.validatorProof(val), height
+}
+
 func (st *state) PendingTx(id tx.ID) *tx.Tx {
 	return st.txPool.PendingTx(id)
 }
+func (st *state) PendingTxsByShortID(key hash.Hash, shortIDs []uint64) ([]*tx.Tx, error) {
+	return st.txPool.PendingTxsByShortID(key, shortIDs)
+}
 func (st *state) AddPendingTx(trx *tx.Tx) error {
 	return st.txPool.AppendTx(trx)
 }
 func (st *state) AddPendingTxAndBroadcast(trx *tx.Tx) error {
 	return st.txPool.AppendTxAndBroadcast(trx)
 }
+
+// AddEvidence verifies the evidence of double-signing.
+// If we are a validator, we report the offender by broadcasting a slash transaction.
+func (st *state) AddEvidence(ev *evidence.Evidence) error {
+	st.lk.Lock()
+	defer st.lk.Unlock()
+
+	if err := ev.SanityCheck(); err != nil {
+		return err
+	}
+	offender, err := st.store.Validator(ev.Offender())
+	if err != nil {
+		// We might not know the offender yet
+		return errors.Errorf(errors.ErrInvalidAddress, "unknown offender")
+	}
+	if err := ev.Verify(offender.PublicKey()); err != nil {
+		return err
+	}
+	if ev.Height() <= offender.SlashedHeight() ||
+		(offender.UnbondingHeight() > 0 && offender.Stake() == 0) {
+		// Offender has slashed for this offence or has withdrawn the stake
+		return nil
+	}
+
+	reporter, _ := st.store.Validator(st.signer.Address())
+	if reporter == nil {
+		// We are not a validator
+		return nil
+	}
+	if reporter.Address().EqualsTo(offender.Address()) {
+		return nil
+	}
+
+	trx := tx.NewSlashTx(st.lastInfo.BlockHash().Stamp(), reporter.Sequence()+1, reporter.Address(), ev)
+	st.signer.SignMsg(trx)
+
+	if err := st.txPool.AppendTxAndBroadcast(trx); err != nil {
+		return err
+	}
+	st.logger.Info("slash transaction broadcasted", "offender", offender.Address(), "tx", trx)
+
+	return nil
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
+	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/genesis"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/param"
@@ -64,15 +68,16 @@ func setup(t *testing.T) {
 	params := param.DefaultParams()
 	params.CommitteeSize = 5
 	params.BondInterval = 10
-	gnDoc := genesis.MakeGenesis(tGenTime, []*account.Account{acc}, []*validator.Validator{val1, val2, val3, val4}, params)
+	upgrade := param.Upgrade{Version: param.ProtocolVersion, Height: 1, BlockVersion: params.BlockVersion, TxVersion: 1}
+	gnDoc := genesis.MakeGenesis(tGenTime, []*account.Account{acc}, []*validator.Validator{val1, val2, val3, val4}, params, upgrade)
 
-	st1, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner1, store1, tCommonTxPool)
+	st1, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner1, store1, tCommonTxPool, event.NewBus())
 	require.NoError(t, err)
-	st2, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner2, store2, tCommonTxPool)
+	st2, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner2, store2, tCommonTxPool, event.NewBus())
 	require.NoError(t, err)
-	st3, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner3, store3, tCommonTxPool)
+	st3, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner3, store3, tCommonTxPool, event.NewBus())
 	require.NoError(t, err)
-	st4, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner4, store4, tCommonTxPool)
+	st4, err := LoadOrNewState(TestConfig(), gnDoc, tValSigner4, store4, tCommonTxPool, event.NewBus())
 	require.NoError(t, err)
 
 	tState1, _ = st1.(*state)
@@ -183,14 +188,14 @@ func TestBlockSubsidyTx(t *testing.T) {
 	// With ivalid mintbase address in config
 	tState1.config.MintbaseAddress = "invalid"
 	tState1.Close()
-	_, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, store, tCommonTxPool)
+	_, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, store, tCommonTxPool, event.NewBus())
 	assert.Error(t, err)
 
 	// With mintbase address in config
 	addr := crypto.GenerateTestAddress()
 	tState1.config.MintbaseAddress = addr.String()
 	tState1.Close()
-	st, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, store, tCommonTxPool)
+	st, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, store, tCommonTxPool, event.NewBus())
 	assert.NoError(t, err)
 	trx = st.(*state).createSubsidyTx(0)
 	assert.Equal(t, trx.Payload().(*payload.SendPayload).Receiver, addr)
@@ -213,6 +218,25 @@ func TestCommitBlocks(t *testing.T) {
 	assert.Equal(t, tState1.GenesisHash(), tState2.GenesisHash())
 }
 
+func TestPublishCommittedBlock(t *testing.T) {
+	setup(t)
+
+	sub := tState1.eventBus.Subscribe()
+	defer sub.Unsubscribe()
+
+	b1, c1 := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3)
+	assert.NoError(t, tState1.CommitBlock(1, b1, c1))
+	// Committing the same block again should not publish any event
+	assert.NoError(t, tState1.CommitBlock(1, b1, c1))
+
+	e := <-sub.Events()
+	require.Equal(t, e.Type(), event.TypeBlockCommitted)
+	assert.Equal(t, e.(*event.BlockCommittedEvent).Height, 1)
+	assert.Equal(t, e.(*event.BlockCommittedEvent).Block.Hash(), b1.Hash())
+	assert.Equal(t, e.(*event.BlockCommittedEvent).Certificate.Hash(), c1.Hash())
+	assert.Empty(t, sub.Events())
+}
+
 func TestCommitSandbox(t *testing.T) {
 
 	t.Run("Add new account", func(t *testing.T) {
@@ -377,7 +401,7 @@ func TestSortition(t *testing.T) {
 	pub, prv := bls.GenerateTestKeyPair()
 	signer := crypto.NewSigner(prv)
 	store := store.MockingStore()
-	st, err := LoadOrNewState(TestConfig(), tState1.genDoc, signer, store, tCommonTxPool)
+	st, err := LoadOrNewState(TestConfig(), tState1.genDoc, signer, store, tCommonTxPool, event.NewBus())
 	assert.NoError(t, err)
 	st1 := st.(*state)
 
@@ -409,10 +433,17 @@ func TestSortition(t *testing.T) {
 
 	// ---------------------------------------------
 	// Certificate next block, new validator should be in the committee now
+	sub := tState1.eventBus.Subscribe(event.TypeCommitteeChanged)
+	defer sub.Unsubscribe()
 	b, c = makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
 	CommitBlockForAllStates(t, b, c)
 	require.NoError(t, st1.CommitBlock(height, b, c))
 
+	e := <-sub.Events()
+	assert.Equal(t, e.(*event.CommitteeChangedEvent).Height, height)
+	assert.Equal(t, e.(*event.CommitteeChangedEvent).Joined, []crypto.Address{pub.Address()})
+	assert.Equal(t, e.(*event.CommitteeChangedEvent).Committers, tState1.committee.Committers())
+
 	assert.False(t, st1.evaluateSortition()) // already in the committee
 	assert.True(t, tState1.committee.Contains(tValSigner1.Address()))
 	assert.True(t, tState1.committee.Contains(pub.Address()))
@@ -420,7 +451,7 @@ func TestSortition(t *testing.T) {
 	// ---------------------------------------------
 	// Let's save and load tState1
 	tState1.Close()
-	state1, _ := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, store, tCommonTxPool)
+	state1, _ := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, store, tCommonTxPool, event.NewBus())
 	st2 := state1.(*state)
 
 	// ---------------------------------------------
@@ -443,6 +474,251 @@ func TestSortition(t *testing.T) {
 	assert.NoError(t, tState2.CommitBlock(height, b1, c1))
 }
 
+func TestSlashing(t *testing.T) {
+	setup(t)
+
+	moveToNextHeightForAllStates(t)
+
+	v1 := vote.NewVote(vote.VoteTypePrecommit, 2, 0, hash.GenerateTestHash(), tValSigner4.Address())
+	v2 := vote.NewVote(vote.VoteTypePrecommit, 2, 0, hash.GenerateTestHash(), tValSigner4.Address())
+	tValSigner4.SignMsg(v1)
+	tValSigner4.SignMsg(v2)
+
+	t.Run("Invalid evidence", func(t *testing.T) {
+		ev, _ := evidence.GenerateTestEvidence(2, 0)
+		assert.Error(t, tState1.AddEvidence(ev))
+	})
+
+	size := tCommonTxPool.Size()
+	t.Run("Should not report itself", func(t *testing.T) {
+		assert.NoError(t, tState4.AddEvidence(evidence.NewEvidence(v1, v2)))
+		assert.Equal(t, tCommonTxPool.Size(), size)
+	})
+
+	assert.NoError(t, tState1.AddEvidence(evidence.NewEvidence(v1, v2)))
+	require.Equal(t, tCommonTxPool.Size(), size+1)
+	assert.True(t, tCommonTxPool.Txs[size].IsSlashTx())
+
+	// Genesis validators have no stake to burn
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		val, _ := st.store.Validator(tValSigner4.Address())
+		val.AddToStake(1000000)
+		st.store.UpdateValidator(val)
+	}
+
+	sub := tState1.eventBus.Subscribe(event.TypeCommitteeChanged)
+	defer sub.Unsubscribe()
+
+	b, c := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+	CommitBlockForAllStates(t, b, c)
+
+	e := <-sub.Events()
+	assert.Equal(t, e.(*event.CommitteeChangedEvent).Ejected, []crypto.Address{tValSigner4.Address()})
+
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		assert.False(t, st.committee.Contains(tValSigner4.Address()))
+		assert.Equal(t, st.committee.Size(), 3)
+		assert.Equal(t, st.Validator(tValSigner4.Address()).UnbondingHeight(), 2)
+		assert.Less(t, st.Validator(tValSigner4.Address()).Stake(), int64(1000000))
+	}
+
+	// Already slashed, no more report
+	size = tCommonTxPool.Size()
+	assert.NoError(t, tState2.AddEvidence(evidence.NewEvidence(v1, v2)))
+	assert.Equal(t, tCommonTxPool.Size(), size)
+
+	t.Run("Restoring the state should not return the slashed validator to the committee", func(t *testing.T) {
+		tState1.Close()
+		st, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		assert.False(t, st.(*state).committee.Contains(tValSigner4.Address()))
+		assert.Equal(t, st.(*state).committee.Committers(), tState1.committee.Committers())
+		assert.Equal(t, st.(*state).committee.Proposer(0).Address(), tState1.committee.Proposer(0).Address())
+		assert.Equal(t, st.(*state).sortition.Params(), tState1.sortition.Params())
+	})
+}
+
+func TestJailingAbsentValidators(t *testing.T) {
+	setup(t)
+
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		st.params.DowntimeWindow = 1000
+		st.params.MaxMissedBlocks = 2
+	}
+
+	sub := tState1.eventBus.Subscribe(event.TypeCommitteeChanged)
+	defer sub.Unsubscribe()
+
+	// The certificate of each block is recorded inside the next block
+	for i := 0; i < 3; i++ {
+		b, c := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3)
+		CommitBlockForAllStates(t, b, c)
+	}
+	assert.Equal(t, tState1.Validator(tValSigner4.Address()).MissedBlocks(), 2)
+	assert.Zero(t, tState1.Validator(tValSigner1.Address()).MissedBlocks())
+	assert.False(t, tState1.Validator(tValSigner4.Address()).IsJailed())
+
+	b, c := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3)
+	CommitBlockForAllStates(t, b, c)
+
+	e := <-sub.Events()
+	assert.Equal(t, e.(*event.CommitteeChangedEvent).Ejected, []crypto.Address{tValSigner4.Address()})
+
+	height := tState1.LastBlockHeight()
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		val := st.Validator(tValSigner4.Address())
+		assert.True(t, val.IsJailed())
+		assert.Equal(t, val.JailedHeight(), height)
+		assert.Zero(t, val.MissedBlocks())
+		assert.False(t, st.committee.Contains(tValSigner4.Address()))
+		assert.Equal(t, st.committee.Size(), 3)
+	}
+
+	t.Run("Restoring the state should not return the jailed validator to the committee", func(t *testing.T) {
+		tState1.Close()
+		st, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		assert.False(t, st.(*state).committee.Contains(tValSigner4.Address()))
+		assert.Equal(t, st.(*state).committee.Committers(), tState1.committee.Committers())
+		assert.Equal(t, st.(*state).committee.Proposer(0).Address(), tState1.committee.Proposer(0).Address())
+	})
+}
+
+func TestMissedBlocksInNewWindow(t *testing.T) {
+	setup(t)
+
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		st.params.DowntimeWindow = 4
+		st.params.MaxMissedBlocks = 2
+	}
+
+	// Missing two blocks in the first window and two blocks in the second window
+	for i := 0; i < 5; i++ {
+		b, c := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3)
+		CommitBlockForAllStates(t, b, c)
+	}
+
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		val := st.Validator(tValSigner4.Address())
+		assert.False(t, val.IsJailed())
+		assert.Equal(t, val.MissedBlocks(), 2)
+		assert.Equal(t, val.MissedWindow(), 1)
+	}
+}
+
+func TestParameterGovernance(t *testing.T) {
+	setup(t)
+
+	moveToNextHeightForAllStates(t)
+
+	params := tState1.params
+	params.BlockReward = 2 * params.BlockReward
+	params.CommitteeSize = 6
+	params.MinimumFee = 2 * params.MinimumFee
+	// Genesis validators have no stake to pay the proposal fee and to vote
+	fee := tState1.params.MinimumFee
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		for _, signer := range []crypto.Signer{tValSigner1, tValSigner2} {
+			val, _ := st.store.Validator(signer.Address())
+			val.AddToStake(2 * fee)
+			st.store.UpdateValidator(val)
+		}
+	}
+	// Two overlapping proposals that change different parameters
+	reward := params.BlockReward
+	committeeSize := params.CommitteeSize
+	minFee := params.MinimumFee
+	stamp := tState1.lastInfo.BlockHash().Stamp()
+	trx1 := tx.NewProposalTx(stamp, 1, tValSigner1.Address(),
+		param.Changes{BlockReward: &reward, CommitteeSize: &committeeSize}, 3, fee, "")
+	trx2 := tx.NewProposalTx(stamp, 2, tValSigner2.Address(),
+		param.Changes{MinimumFee: &minFee}, 4, fee, "")
+	tValSigner1.SignMsg(trx1)
+	tValSigner2.SignMsg(trx2)
+	vote1 := tx.NewProposalVoteTx(stamp, 1, tValSigner2.Address(), trx1.ID(), "")
+	vote2 := tx.NewProposalVoteTx(stamp, 2, tValSigner1.Address(), trx2.ID(), "")
+	tValSigner2.SignMsg(vote1)
+	tValSigner1.SignMsg(vote2)
+	for _, trx := range []*tx.Tx{trx1, vote1, trx2, vote2} {
+		assert.NoError(t, tCommonTxPool.AppendTx(trx))
+	}
+
+	b, c := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+	CommitBlockForAllStates(t, b, c)
+
+	for _, id := range []tx.ID{trx1.ID(), trx2.ID()} {
+		p, err := tState1.store.Proposal(id)
+		require.NoError(t, err)
+		assert.True(t, p.IsApproved())
+	}
+	assert.Equal(t, tState1.params.BlockReward, params.BlockReward)
+	assert.NotEqual(t, tState1.params.MinimumFee, params.MinimumFee)
+
+	moveToNextHeightForAllStates(t)
+
+	// The second proposal doesn't reset the parameters that are changed by the first one
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		assert.Equal(t, st.params, params)
+	}
+	assert.Equal(t, tState1.createSubsidyTx(0).Payload().Value(), params.BlockReward)
+
+	t.Run("Restoring the state should restore the parameters", func(t *testing.T) {
+		tState1.Close()
+		st, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, tCommonTxPool, event.NewBus())
+		require.NoError(t, err)
+		assert.Equal(t, st.(*state).params, params)
+		assert.Equal(t, st.(*state).committee.Committers(), tState1.committee.Committers())
+		// The proposal fee is taken from the proposer's stake
+		assert.Equal(t, st.(*state).sortition.Params(), tState1.sortition.Params())
+	})
+}
+
+func TestProtocolUpgrade(t *testing.T) {
+	setup(t)
+
+	upgrade2 := param.Upgrade{Version: param.ProtocolVersion, Height: 2, BlockVersion: 1, TxVersion: 1}
+	upgrade3 := param.Upgrade{Version: param.ProtocolVersion + 1, Height: 3, BlockVersion: 2, TxVersion: 1}
+	genDoc := genesis.MakeGenesis(tState1.genDoc.GenesisTime(), tState1.genDoc.Accounts(),
+		tState1.genDoc.Validators(), tState1.genDoc.Params(), upgrade2, upgrade3)
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		st.genDoc = genDoc
+	}
+
+	// Signalling is not supported before protocol version 2
+	b1, c1 := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+	assert.Zero(t, b1.Header().ProtocolVersion())
+	invalidBlock := block.MakeBlock(
+		b1.Header().Version(),
+		param.ProtocolVersion,
+		b1.Header().Time(),
+		b1.TxIDs(),
+		b1.Header().PrevBlockHash(),
+		b1.Header().StateHash(),
+		b1.PrevCertificate(),
+		b1.Header().SortitionSeed(),
+		b1.Header().ProposerAddress())
+	assert.Error(t, tState2.ValidateBlock(invalidBlock))
+	CommitBlockForAllStates(t, b1, c1)
+
+	b2, c2 := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
+	assert.Equal(t, b2.Header().ProtocolVersion(), param.ProtocolVersion)
+	assert.Equal(t, tState1.rules(2).ProtocolVersion, param.ProtocolVersion)
+	assert.Equal(t, tState1.rules(3).BlockVersion, upgrade3.BlockVersion)
+	CommitBlockForAllStates(t, b2, c2)
+
+	proposer := tState1.Validator(b2.Header().ProposerAddress())
+	assert.Equal(t, proposer.ProtocolVersion(), param.ProtocolVersion)
+	assert.Equal(t, tState1.upgradeReadiness(param.ProtocolVersion), 0.25)
+	assert.Zero(t, tState1.upgradeReadiness(upgrade3.Version))
+
+	// This node doesn't support the new protocol version
+	for _, st := range []*state{tState1, tState2, tState3, tState4} {
+		_, err := st.ProposeBlock(0)
+		assert.Error(t, err)
+	}
+	assert.Error(t, tState1.ValidateBlock(b2))
+}
+
 func TestValidateBlockTime(t *testing.T) {
 	setup(t)
 	fmt.Printf("BlockTimeInSecond: %d\n", tState1.params.BlockTimeInSecond)
@@ -512,7 +788,9 @@ func TestValidateBlockTime(t *testing.T) {
 func TestInvalidBlockVersion(t *testing.T) {
 	setup(t)
 
-	tState1.params.BlockVersion = 2
+	upgrade := param.Upgrade{Version: param.ProtocolVersion, Height: 1, BlockVersion: 2, TxVersion: 1}
+	tState1.genDoc = genesis.MakeGenesis(tState1.genDoc.GenesisTime(), tState1.genDoc.Accounts(),
+		tState1.genDoc.Validators(), tState1.genDoc.Params(), upgrade)
 	b, _ := tState1.ProposeBlock(0)
 	assert.Error(t, tState2.ValidateBlock(b))
 }
@@ -524,6 +802,7 @@ func TestInvalidBlockTime(t *testing.T) {
 	validBlock, _ := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
 	invalidBlock := block.MakeBlock(
 		validBlock.Header().Version(),
+		validBlock.Header().ProtocolVersion(),
 		validBlock.Header().Time().Add(30*time.Second),
 		validBlock.TxIDs(),
 		validBlock.Header().PrevBlockHash(),
@@ -579,6 +858,12 @@ func TestLoadState(t *testing.T) {
 
 	assert.NoError(t, tCommonTxPool.AppendTx(tx2))
 
+	// Add a delegate transaction to change the stake of the new validator
+	tx3 := tx.NewDelegateTx(tState1.LastBlockHash().Stamp(), 2, tValSigner1.Address(), pub.Address(), 1000000, 1000, "")
+	tValSigner1.SignMsg((tx3))
+
+	assert.NoError(t, tCommonTxPool.AppendTx(tx3))
+
 	for i := 0; i < 4; i++ {
 		moveToNextHeightForAllStates(t)
 	}
@@ -588,7 +873,7 @@ func TestLoadState(t *testing.T) {
 	b6, c6 := makeBlockAndCertificate(t, 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
 
 	// Load last state info
-	st2, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, tCommonTxPool)
+	st2, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, tCommonTxPool, event.NewBus())
 	require.NoError(t, err)
 
 	assert.Equal(t, tState1.store.TotalAccounts(), st2.(*state).store.TotalAccounts())
@@ -597,12 +882,41 @@ func TestLoadState(t *testing.T) {
 	assert.Equal(t, tState1.TotalStake(), st2.(*state).TotalStake())
 	assert.Equal(t, tState1.committeeStake(), st2.(*state).committeeStake())
 	assert.Equal(t, tState1.PoolStake(), st2.(*state).PoolStake())
+	assert.Equal(t, tState1.sortition.Params(), st2.(*state).sortition.Params())
 	assert.Equal(t, tState1.store.TotalAccounts(), 5)
+	assert.Equal(t, tState1.Validator(pub.Address()).DelegatedStake(), int64(1000000))
 
 	require.NoError(t, st2.CommitBlock(6, b6, c6))
 	require.NoError(t, tState2.CommitBlock(6, b6, c6))
 }
 
+func TestLoadStateWithPrunedBlocks(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < 6; i++ {
+		moveToNextHeightForAllStates(t)
+	}
+
+	// The first block inside the transaction to live interval is pruned,
+	// like the blocks that are pruned before increasing the interval
+	params := tState1.genDoc.Params()
+	params.TransactionToLiveInterval = 4
+	genDoc := genesis.MakeGenesis(tState1.genDoc.GenesisTime(), tState1.genDoc.Accounts(),
+		tState1.genDoc.Validators(), params, tState1.genDoc.Upgrades()...)
+	mockStore := tState1.store.(*store.MockStore)
+	delete(mockStore.Blocks, 2)
+	delete(mockStore.Blocks, 3)
+
+	st, err := LoadOrNewState(tState1.config, genDoc, tValSigner1, mockStore, tCommonTxPool, event.NewBus())
+	require.NoError(t, err)
+	assert.Equal(t, st.(*state).latestBlocks.Size(), 3)
+
+	// The latest blocks can't be missed
+	delete(mockStore.Blocks, 5)
+	_, err = LoadOrNewState(tState1.config, genDoc, tValSigner1, mockStore, tCommonTxPool, event.NewBus())
+	assert.Error(t, err)
+}
+
 func TestLoadStateAfterChangingGenesis(t *testing.T) {
 	setup(t)
 
@@ -612,7 +926,7 @@ func TestLoadStateAfterChangingGenesis(t *testing.T) {
 		moveToNextHeightForAllStates(t)
 	}
 
-	_, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, txpool.MockingTxPool())
+	_, err := LoadOrNewState(tState1.config, tState1.genDoc, tValSigner1, tState1.store, txpool.MockingTxPool(), event.NewBus())
 	require.NoError(t, err)
 
 	// Load last state info after modifying genesis
@@ -621,7 +935,7 @@ func TestLoadStateAfterChangingGenesis(t *testing.T) {
 	val := validator.NewValidator(tValSigner1.PublicKey().(*bls.PublicKey), 0)
 	genDoc := genesis.MakeGenesis(tGenTime, []*account.Account{acc}, []*validator.Validator{val}, param.DefaultParams())
 
-	_, err = LoadOrNewState(tState1.config, genDoc, tValSigner1, tState1.store, txpool.MockingTxPool())
+	_, err = LoadOrNewState(tState1.config, genDoc, tValSigner1, tState1.store, txpool.MockingTxPool(), event.NewBus())
 	require.Error(t, err)
 }
 
@@ -663,3 +977,110 @@ func TestSetBlockTime(t *testing.T) {
 		assert.Zero(t, b.Header().Time().Second()%10)
 	})
 }
+
+func TestAccountTransactions(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < 22; i++ {
+		moveToNextHeightForAllStates(t)
+	}
+
+	// Each block has a subsidy transaction from the treasury address
+	page0 := tState1.AccountTransactions(crypto.TreasuryAddress, 0)
+	page1 := tState1.AccountTransactions(crypto.TreasuryAddress, 1)
+	page2 := tState1.AccountTransactions(crypto.TreasuryAddress, 2)
+	assert.Len(t, page0, TransactionsPerPage)
+	assert.Len(t, page1, 2)
+	assert.Empty(t, page2)
+	assert.Equal(t, page0[0].ID(), tState1.Block(22).TxIDs().IDs()[0])
+	assert.Equal(t, page1[1].ID(), tState1.Block(1).TxIDs().IDs()[0])
+
+	total := 0
+	for _, s := range []crypto.Signer{tValSigner1, tValSigner2, tValSigner3, tValSigner4} {
+		for _, trx := range tState1.AccountTransactions(s.Address(), 0) {
+			assert.True(t, trx.IsMintbaseTx())
+			total++
+		}
+	}
+	assert.Equal(t, total, 22)
+}
+
+func TestSimulateTx(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < 4; i++ {
+		moveToNextHeightForAllStates(t)
+	}
+
+	var signer crypto.Signer
+	for _, s := range []crypto.Signer{tValSigner1, tValSigner2, tValSigner3, tValSigner4} {
+		if tState1.Account(s.Address()) != nil {
+			signer = s
+			break
+		}
+	}
+	require.NotNil(t, signer)
+	acc := tState1.Account(signer.Address())
+	receiver := crypto.GenerateTestAddress()
+	stamp := tState1.lastInfo.BlockHash().Stamp()
+	stateHash := tState1.stateHash()
+
+	t.Run("Invalid sequence", func(t *testing.T) {
+		trx := tx.NewSendTx(stamp, acc.Sequence()+2, signer.Address(), receiver, 1000, 1000, "")
+		signer.SignMsg(trx)
+		_, err := tState1.SimulateTx(trx)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewSendTx(stamp, acc.Sequence()+1, signer.Address(), receiver, 1000, 1000, "")
+		signer.SignMsg(trx)
+		sim, err := tState1.SimulateTx(trx)
+		require.NoError(t, err)
+		assert.Equal(t, sim.Fee, int64(1000))
+		assert.Empty(t, sim.Validators)
+		require.Len(t, sim.Accounts, 2)
+		assert.Equal(t, sim.Accounts[0].Balance(), acc.Balance()-2000)
+		assert.Equal(t, sim.Accounts[1].Address(), receiver)
+		assert.Equal(t, sim.Accounts[1].Balance(), int64(1000))
+	})
+
+	// Nothing should be committed
+	assert.Nil(t, tState1.Account(receiver))
+	assert.Equal(t, tState1.stateHash(), stateHash)
+}
+
+func TestEstimateFee(t *testing.T) {
+	setup(t)
+
+	est := tState1.EstimateFee(1e8, payload.PayloadTypeSend)
+	assert.Equal(t, est.MinimumFee, int64(1e5))
+	assert.Equal(t, est.RecommendedFee, est.MinimumFee)
+	assert.Zero(t, est.BlockFullness)
+
+	est = tState1.EstimateFee(1, payload.PayloadTypeSend)
+	assert.Equal(t, est.MinimumFee, tState1.params.MinimumFee)
+
+	est = tState1.EstimateFee(1e8, payload.PayloadTypeUnbond)
+	assert.Zero(t, est.MinimumFee)
+	assert.Zero(t, est.RecommendedFee)
+
+	// Each block has only one subsidy transaction
+	for i := 0; i < 12; i++ {
+		moveToNextHeightForAllStates(t)
+	}
+	tState1.params.MaximumTransactionPerBlock = 4
+	est = tState1.EstimateFee(1e8, payload.PayloadTypeSend)
+	assert.Equal(t, est.BlockFullness, 0.25)
+	assert.Equal(t, est.RecommendedFee, int64(1e5+0.25*1e5))
+
+	t.Run("Before protocol version 2, the exact fee is recommended", func(t *testing.T) {
+		upgrade := param.Upgrade{Version: param.ProtocolVersion, Height: 1000, BlockVersion: 1, TxVersion: 1}
+		tState1.genDoc = genesis.MakeGenesis(tState1.genDoc.GenesisTime(), tState1.genDoc.Accounts(),
+			tState1.genDoc.Validators(), tState1.genDoc.Params(), upgrade)
+
+		est := tState1.EstimateFee(1e8, payload.PayloadTypeSend)
+		assert.Equal(t, est.BlockFullness, 0.25)
+		assert.Equal(t, est.RecommendedFee, est.MinimumFee)
+	})
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/sandbox"
+)
+
+// rules returns the protocol rules at the given height, based on the upgrade schedule in genesis.
+func (st *state) rules(height int) param.Rules {
+	return param.RulesAt(st.params, st.genDoc.Upgrades(), height)
+}
+
+// checkProtocolVersion makes sure this node can follow the rules.
+func (st *state) checkProtocolVersion(rules param.Rules) error {
+	if rules.ProtocolVersion > param.ProtocolVersion {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"protocol version %v is not supported, please upgrade the node", rules.ProtocolVersion)
+	}
+	return nil
+}
+
+// recordProtocolSignal records the protocol version that the proposer signalled in the block header.
+func (st *state) recordProtocolSignal(sb sandbox.Sandbox, block *block.Block) {
+	val := sb.Validator(block.Header().ProposerAddress())
+	if val == nil {
+		return
+	}
+	if val.ProtocolVersion() != block.Header().ProtocolVersion() {
+		val.SetProtocolVersion(block.Header().ProtocolVersion())
+		sb.UpdateValidator(val)
+	}
+}
+
+// upgradeReadiness returns the fraction of the committee power that signalled
+// their readiness for the given protocol version.
+func (st *state) upgradeReadiness(version int) float64 {
+	total := int64(0)
+	ready := int64(0)
+	for _, v := range st.committee.Validators() {
+		total += v.Power()
+		val, err := st.store.Validator(v.Address())
+		if err != nil {
+			continue
+		}
+		if val.ProtocolVersion() >= version {
+			ready += val.Power()
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(ready) / float64(total)
+}
+
+// checkUpgrade informs about the protocol upgrade that is activated at the given height.
+func (st *state) checkUpgrade(height int) {
+	for _, u := range st.genDoc.Upgrades() {
+		if u.Height != height {
+			continue
+		}
+		st.logger.Info("protocol upgrade is activated", "version", u.Version,
+			"height", u.Height, "readiness", st.upgradeReadiness(u.Version))
+	}
+
+	if err := st.checkProtocolVersion(st.rules(height)); err != nil {
+		st.logger.Error("unable to follow the protocol", "err", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/util"
+	"github.com/zarbchain/zarb-go/validator"
 )
 
 func (st *state) validateBlock(block *block.Block) error {
@@ -13,10 +14,18 @@ func (st *state) validateBlock(block *block.Block) error {
 		return err
 	}
 
-	if block.Header().Version() != st.params.BlockVersion {
+	rules := st.rules(st.lastInfo.BlockHeight() + 1)
+	if err := st.checkProtocolVersion(rules); err != nil {
+		return err
+	}
+	if block.Header().Version() != rules.BlockVersion {
 		return errors.Errorf(errors.ErrInvalidBlock,
 			"invalid version")
 	}
+	if rules.ProtocolVersion < 2 && block.Header().ProtocolVersion() != 0 {
+		return errors.Errorf(errors.ErrInvalidBlock,
+			"protocol version signal is not supported")
+	}
 
 	if !block.Header().StateHash().EqualsTo(st.stateHash()) {
 		return errors.Errorf(errors.ErrInvalidBlock,
@@ -27,6 +36,15 @@ func (st *state) validateBlock(block *block.Block) error {
 }
 
 func (st *state) checkCertificate(cert *block.Certificate) error {
+	return verifyCertificate(cert, func(num int) *validator.Validator {
+		val, _ := st.store.ValidatorByNumber(num)
+		return val
+	})
+}
+
+// verifyCertificate checks the signature of the certificate.
+// validatorByNumber returns the committee members by their numbers.
+func verifyCertificate(cert *block.Certificate, validatorByNumber func(num int) *validator.Validator) error {
 	if err := cert.SanityCheck(); err != nil {
 		return err
 	}
@@ -36,7 +54,7 @@ func (st *state) checkCertificate(cert *block.Certificate) error {
 	signersStake := int64(0)
 
 	for _, num := range cert.Committers() {
-		val, _ := st.store.ValidatorByNumber(num)
+		val := validatorByNumber(num)
 		if val == nil {
 			return errors.Errorf(errors.ErrInvalidBlock,
 				"certificate has invalid committer: %x", num)
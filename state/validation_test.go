@@ -8,6 +8,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
@@ -195,6 +196,52 @@ func TestCertificateValidation(t *testing.T) {
 	})
 }
 
+func TestVerifyCertificate(t *testing.T) {
+	setup(t)
+	moveToNextHeightForAllStates(t)
+
+	nextBlock, _ := tState2.ProposeBlock(0)
+	nextBlockHash := nextBlock.Hash()
+	committers := tState2.committee.Committers()
+	signBytes := block.CertificateSignBytes(nextBlockHash, 0)
+	sig1 := tValSigner1.SignData(signBytes)
+	sig2 := tValSigner2.SignData(signBytes)
+	sig4 := tValSigner4.SignData(signBytes)
+
+	height := tState1.LastBlockHeight() + 1
+
+	t.Run("Committee is not known, should return unknown committee error", func(t *testing.T) {
+		aggSig := aggregate([]crypto.Signature{sig1, sig2, sig4})
+		cert := block.NewCertificate(nextBlockHash, 0, committers, []int{2}, aggSig)
+
+		err := tState1.VerifyCertificate(height+1, cert)
+		assert.Equal(t, errors.Code(err), errors.ErrUnknownCommittee)
+	})
+
+	t.Run("Committers are not the committee, should return error", func(t *testing.T) {
+		aggSig := aggregate([]crypto.Signature{sig1, sig2, sig4})
+		cert := block.NewCertificate(nextBlockHash, 0, []int{0, 1, 3}, []int{}, aggSig)
+
+		err := tState1.VerifyCertificate(height, cert)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidBlock)
+	})
+
+	t.Run("Invalid signature, should return error", func(t *testing.T) {
+		aggSig := aggregate([]crypto.Signature{sig1, sig2})
+		cert := block.NewCertificate(nextBlockHash, 0, committers, []int{2}, aggSig)
+
+		err := tState1.VerifyCertificate(height, cert)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidBlock)
+	})
+
+	t.Run("Ok, should return no error", func(t *testing.T) {
+		aggSig := aggregate([]crypto.Signature{sig1, sig2, sig4})
+		cert := block.NewCertificate(nextBlockHash, 0, committers, []int{2}, aggSig)
+
+		assert.NoError(t, tState1.VerifyCertificate(height, cert))
+	})
+}
+
 func TestBlockValidation(t *testing.T) {
 	setup(t)
 
@@ -221,27 +268,27 @@ func TestBlockValidation(t *testing.T) {
 	ids := block.NewTxIDs()
 	ids.Append(trx.ID())
 
-	b := block.MakeBlock(2, util.Now(), ids, invHash, tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState2.signer.Address())
+	b := block.MakeBlock(2, 1, util.Now(), ids, invHash, tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState2.signer.Address())
 	assert.Error(t, tState1.validateBlock(b))
 
-	b = block.MakeBlock(1, util.Now(), ids, tState1.lastInfo.BlockHash(), invHash, tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState2.signer.Address())
+	b = block.MakeBlock(1, 1, util.Now(), ids, tState1.lastInfo.BlockHash(), invHash, tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), tState2.signer.Address())
 	assert.Error(t, tState1.validateBlock(b))
 
-	b = block.MakeBlock(1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), invCert, tState1.lastInfo.SortitionSeed(), tState2.signer.Address())
+	b = block.MakeBlock(1, 1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), invCert, tState1.lastInfo.SortitionSeed(), tState2.signer.Address())
 	assert.Error(t, tState1.validateBlock(b))
 
-	b = block.MakeBlock(1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), invAddr)
+	b = block.MakeBlock(1, 1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), tState1.lastInfo.SortitionSeed(), invAddr)
 	assert.NoError(t, tState1.validateBlock(b))
 	c := makeCertificateAndSign(t, b.Hash(), 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
 	assert.Error(t, tState1.CommitBlock(2, b, c))
 
-	b = block.MakeBlock(1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), invSeed, tState2.signer.Address())
+	b = block.MakeBlock(1, 1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), invSeed, tState2.signer.Address())
 	assert.NoError(t, tState1.validateBlock(b))
 	c = makeCertificateAndSign(t, b.Hash(), 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
 	assert.Error(t, tState1.CommitBlock(2, b, c))
 
 	seed := tState1.lastInfo.SortitionSeed()
-	b = block.MakeBlock(1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), seed.Generate(tState2.signer), tState2.signer.Address())
+	b = block.MakeBlock(1, 1, util.Now(), ids, tState1.lastInfo.BlockHash(), tState1.stateHash(), tState1.lastInfo.Certificate(), seed.Generate(tState2.signer), tState2.signer.Address())
 	assert.NoError(t, tState1.validateBlock(b))
 	c = makeCertificateAndSign(t, b.Hash(), 0, tValSigner1, tValSigner2, tValSigner3, tValSigner4)
 	assert.NoError(t, tState1.CommitBlock(2, b, c))
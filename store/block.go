@@ -5,11 +5,13 @@ import (
 	dbutil "github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 )
 
 func blockKey(height int) []byte         { return append(blockPrefix, util.IntToSlice(height)...) }
 func blockHashKey(hash hash.Hash) []byte { return append(blockHashPrefix, hash.RawBytes()...) }
+func txBlockKey(id tx.ID) []byte         { return append(txBlockPrefix, id.RawBytes()...) }
 
 type blockStore struct {
 	db *leveldb.DB
@@ -31,6 +33,9 @@ func (bs *blockStore) saveBlock(batch *leveldb.Batch, height int, block *block.B
 
 	batch.Put(blockKey, blockData)
 	batch.Put(blockHashKey, util.IntToSlice(height))
+	for _, id := range block.TxIDs().IDs() {
+		batch.Put(txBlockKey(id), util.IntToSlice(height))
+	}
 
 	return nil
 }
@@ -58,6 +63,56 @@ func (bs *blockStore) blockHeight(hash hash.Hash) (int, error) {
 	return util.SliceToInt(heightData), nil
 }
 
+func (bs *blockStore) txBlockHeight(id tx.ID) (int, error) {
+	txBlockKey := txBlockKey(id)
+	heightData, err := tryGet(bs.db, txBlockKey)
+	if err != nil {
+		return -1, err
+	}
+	return util.SliceToInt(heightData), nil
+}
+
+// indexTxs puts the block height of the transactions of all the saved blocks in the batch,
+// and indexes the transactions by their addresses.
+// It returns the number of the indexed blocks.
+func (bs *blockStore) indexTxs(batch *leveldb.Batch, ts *txStore) (int, error) {
+	iter := bs.db.NewIterator(dbutil.BytesPrefix(blockPrefix), nil)
+	defer iter.Release()
+
+	count := 0
+	for iter.Next() {
+		height := util.SliceToInt(iter.Key()[len(blockPrefix):])
+		b := new(block.Block)
+		if err := b.Decode(iter.Value()); err != nil {
+			return count, err
+		}
+		for _, id := range b.TxIDs().IDs() {
+			batch.Put(txBlockKey(id), util.IntToSlice(height))
+
+			trx, err := ts.tx(id)
+			if err != nil {
+				// The transaction is not saved, nothing to index
+				continue
+			}
+			for _, addr := range trx.Addresses() {
+				ts.saveAddressTx(batch, addr, height, id)
+			}
+		}
+		count++
+	}
+	return count, iter.Error()
+}
+
+// pruneBlock removes the block and its transactions.
+func (bs *blockStore) pruneBlock(batch *leveldb.Batch, height int, b *block.Block) {
+	for _, id := range b.TxIDs().IDs() {
+		batch.Delete(txKey(id))
+		batch.Delete(txBlockKey(id))
+	}
+	batch.Delete(blockHashKey(b.Hash()))
+	batch.Delete(blockKey(height))
+}
+
 func (bs *blockStore) hasAnyBlock() bool {
 	iter := bs.db.NewIterator(dbutil.BytesPrefix(blockHashPrefix), nil)
 	return iter.First()
@@ -6,7 +6,8 @@ import (
 )
 
 type Config struct {
-	Path string `toml:"" comment:"Path contains database directory. Default is ./store.db"`
+	Path         string `toml:"" comment:"Path contains database directory. Default is ./store.db"`
+	RetainBlocks int    `toml:"" comment:"RetainBlocks is the number of the latest blocks to keep. Older blocks and their transactions are pruned. It can't be less than TransactionToLiveInterval, and the blocks inside the TransactionToLiveInterval are always kept. Zero keeps all the blocks."`
 }
 
 func DefaultConfig() *Config {
@@ -30,5 +31,8 @@ func (conf *Config) SanityCheck() error {
 	if !util.IsValidDirPath(conf.Path) {
 		return errors.Errorf(errors.ErrInvalidConfig, "path is not valid")
 	}
+	if conf.RetainBlocks < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "retain blocks can't be negative")
+	}
 	return nil
 }
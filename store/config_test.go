@@ -13,3 +13,9 @@ func TestDefaultConfigCheck(t *testing.T) {
 	c.Path = "/tmp/zarb"
 	assert.NoError(t, c.SanityCheck())
 }
+
+func TestConfigCheck(t *testing.T) {
+	c := TestConfig()
+	c.RetainBlocks = -1
+	assert.Error(t, c.SanityCheck())
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+type delegationStore struct {
+	db    *leveldb.DB
+	total int
+}
+
+// delegationKey is prefixed by the validator address,
+// so the delegations of a validator can be iterated together.
+func delegationKey(delegator, val crypto.Address) []byte {
+	key := append(delegationPrefix, val.RawBytes()...)
+	return append(key, delegator.RawBytes()...)
+}
+
+func newDelegationStore(db *leveldb.DB) *delegationStore {
+	ds := &delegationStore{
+		db: db,
+	}
+
+	total := 0
+	ds.iterateDelegations(delegationPrefix, func(d *validator.Delegation) bool {
+		total++
+		return false
+	})
+	ds.total = total
+
+	return ds
+}
+
+func (ds *delegationStore) hasDelegation(delegator, val crypto.Address) bool {
+	has, err := ds.db.Has(delegationKey(delegator, val), nil)
+	if err != nil {
+		return false
+	}
+	return has
+}
+
+func (ds *delegationStore) delegation(delegator, val crypto.Address) (*validator.Delegation, error) {
+	data, err := tryGet(ds.db, delegationKey(delegator, val))
+	if err != nil {
+		return nil, err
+	}
+
+	d := new(validator.Delegation)
+	if err := d.Decode(data); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (ds *delegationStore) iterateDelegations(prefix []byte, consumer func(*validator.Delegation) (stop bool)) {
+	r := util.BytesPrefix(prefix)
+	iter := ds.db.NewIterator(r, nil)
+	defer iter.Release()
+	for iter.Next() {
+		value := iter.Value()
+
+		d := new(validator.Delegation)
+		if err := d.Decode(value); err != nil {
+			panic(err)
+		}
+
+		stopped := consumer(d)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (ds *delegationStore) iterateValidatorDelegations(val crypto.Address, consumer func(*validator.Delegation) (stop bool)) {
+	prefix := append(delegationPrefix, val.RawBytes()...)
+	ds.iterateDelegations(prefix, consumer)
+}
+
+func (ds *delegationStore) updateDelegation(batch *leveldb.Batch, d *validator.Delegation) error {
+	data, err := d.Encode()
+	if err != nil {
+		return err
+	}
+	if !ds.hasDelegation(d.Delegator(), d.Validator()) {
+		ds.total++
+	}
+
+	batch.Put(delegationKey(d.Delegator(), d.Validator()), data)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/validator"
+)
+
+func TestDelegationCounter(t *testing.T) {
+	store, _ := NewStore(TestConfig())
+	d := validator.GenerateTestDelegation(0)
+
+	t.Run("Update count after adding new delegation", func(t *testing.T) {
+		assert.Equal(t, store.TotalDelegations(), 0)
+		store.UpdateDelegation(d)
+		assert.NoError(t, store.WriteBatch())
+		assert.Equal(t, store.TotalDelegations(), 1)
+	})
+
+	t.Run("Update delegation, should not increase counter", func(t *testing.T) {
+		d.AddToReward(1)
+
+		store.UpdateDelegation(d)
+		assert.NoError(t, store.WriteBatch())
+		assert.Equal(t, store.TotalDelegations(), 1)
+
+		d2, err := store.Delegation(d.Delegator(), d.Validator())
+		assert.NoError(t, err)
+		assert.Equal(t, d2.Hash(), d.Hash())
+		assert.True(t, store.HasDelegation(d.Delegator(), d.Validator()))
+	})
+}
+
+func TestIterateValidatorDelegations(t *testing.T) {
+	conf := TestConfig()
+	store, _ := NewStore(conf)
+
+	val1 := crypto.GenerateTestAddress()
+	val2 := crypto.GenerateTestAddress()
+	for i := 0; i < 10; i++ {
+		val := val1
+		if i%2 == 0 {
+			val = val2
+		}
+		d := validator.NewDelegation(crypto.GenerateTestAddress(), val, i)
+		d.AddToStake(int64(i + 1))
+		store.UpdateDelegation(d)
+	}
+	assert.NoError(t, store.WriteBatch())
+
+	t.Run("Iterate by validator", func(t *testing.T) {
+		count := 0
+		store.IterateValidatorDelegations(val1, func(d *validator.Delegation) bool {
+			assert.Equal(t, d.Validator(), val1)
+			count++
+			return false
+		})
+		assert.Equal(t, count, 5)
+	})
+
+	t.Run("Close and load db", func(t *testing.T) {
+		store.Close()
+		store, _ = NewStore(conf)
+
+		assert.Equal(t, store.TotalDelegations(), 10)
+
+		total := int64(0)
+		store.IterateDelegations(func(d *validator.Delegation) bool {
+			total += d.Stake()
+			return false
+		})
+		assert.Equal(t, total, int64(55))
+	})
+}
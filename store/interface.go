@@ -5,6 +5,7 @@ import (
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -14,6 +15,8 @@ type Reader interface {
 	HasAnyBlock() bool
 	BlockHeight(hash hash.Hash) (int, error)
 	Transaction(hash hash.Hash) (*tx.Tx, error)
+	TransactionBlockHeight(id tx.ID) (int, error)
+	AddressTransactions(addr crypto.Address, offset, count int) []tx.ID
 	HasAccount(crypto.Address) bool
 	Account(addr crypto.Address) (*account.Account, error)
 	TotalAccounts() int
@@ -23,7 +26,18 @@ type Reader interface {
 	IterateValidators(consumer func(*validator.Validator) (stop bool))
 	IterateAccounts(consumer func(*account.Account) (stop bool))
 	TotalValidators() int
+	HasDelegation(delegator, val crypto.Address) bool
+	Delegation(delegator, val crypto.Address) (*validator.Delegation, error)
+	TotalDelegations() int
+	IterateDelegations(consumer func(*validator.Delegation) (stop bool))
+	IterateValidatorDelegations(val crypto.Address, consumer func(*validator.Delegation) (stop bool))
+	HasProposal(id hash.Hash) bool
+	Proposal(id hash.Hash) (*param.Proposal, error)
+	TotalProposals() int
+	IterateProposals(consumer func(*param.Proposal) (stop bool))
+	IterateProposalsByActivationHeight(height int, consumer func(*param.Proposal) (stop bool))
 	RestoreLastInfo() []byte
+	RestoreSnapshot() []byte
 }
 
 type Store interface {
@@ -31,9 +45,14 @@ type Store interface {
 
 	UpdateAccount(acc *account.Account)
 	UpdateValidator(acc *validator.Validator)
+	UpdateDelegation(d *validator.Delegation)
+	UpdateProposal(p *param.Proposal)
 	SaveBlock(height int, block *block.Block)
 	SaveTransaction(trx *tx.Tx)
+	SaveAddressTransaction(addr crypto.Address, height int, id tx.ID)
 	SaveLastInfo(info []byte)
+	SaveSnapshot(data []byte)
+	SetMinRetainBlocks(count int)
 	WriteBatch() error
 	Close() error
 }
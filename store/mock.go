@@ -7,6 +7,7 @@ import (
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -15,8 +16,12 @@ type MockStore struct {
 	Blocks       map[int]*block.Block
 	Accounts     map[crypto.Address]account.Account
 	Validators   map[crypto.Address]validator.Validator
+	Delegations  map[validator.DelegationKey]validator.Delegation
+	Proposals    map[hash.Hash]param.Proposal
 	Transactions map[hash.Hash]tx.Tx
+	AddressTxs   map[crypto.Address][]tx.ID
 	LastInfo     []byte
+	Snapshot     []byte
 }
 
 func MockingStore() *MockStore {
@@ -24,7 +29,10 @@ func MockingStore() *MockStore {
 		Blocks:       make(map[int]*block.Block),
 		Accounts:     make(map[crypto.Address]account.Account),
 		Validators:   make(map[crypto.Address]validator.Validator),
+		Delegations:  make(map[validator.DelegationKey]validator.Delegation),
+		Proposals:    make(map[hash.Hash]param.Proposal),
 		Transactions: make(map[hash.Hash]tx.Tx),
+		AddressTxs:   make(map[crypto.Address][]tx.ID),
 	}
 }
 func (m *MockStore) Block(height int) (*block.Block, error) {
@@ -49,6 +57,27 @@ func (m *MockStore) Transaction(id tx.ID) (*tx.Tx, error) {
 	}
 	return nil, fmt.Errorf("not found")
 }
+func (m *MockStore) TransactionBlockHeight(id tx.ID) (int, error) {
+	for i, b := range m.Blocks {
+		for _, txID := range b.TxIDs().IDs() {
+			if txID.EqualsTo(id) {
+				return i, nil
+			}
+		}
+	}
+	return -1, fmt.Errorf("not found")
+}
+func (m *MockStore) SaveAddressTransaction(addr crypto.Address, height int, id tx.ID) {
+	m.AddressTxs[addr] = append(m.AddressTxs[addr], id)
+}
+func (m *MockStore) AddressTransactions(addr crypto.Address, offset, count int) []tx.ID {
+	ids := make([]tx.ID, 0, count)
+	txs := m.AddressTxs[addr]
+	for i := len(txs) - 1 - offset; i >= 0 && len(ids) < count; i-- {
+		ids = append(ids, txs[i])
+	}
+	return ids
+}
 func (m *MockStore) HasAccount(addr crypto.Address) bool {
 	_, ok := m.Accounts[addr]
 	return ok
@@ -91,6 +120,23 @@ func (m *MockStore) UpdateValidator(val *validator.Validator) {
 func (m *MockStore) TotalValidators() int {
 	return len(m.Validators)
 }
+func (m *MockStore) HasDelegation(delegator, val crypto.Address) bool {
+	_, ok := m.Delegations[validator.DelegationKey{Delegator: delegator, Validator: val}]
+	return ok
+}
+func (m *MockStore) Delegation(delegator, val crypto.Address) (*validator.Delegation, error) {
+	d, ok := m.Delegations[validator.DelegationKey{Delegator: delegator, Validator: val}]
+	if ok {
+		return &d, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+func (m *MockStore) UpdateDelegation(d *validator.Delegation) {
+	m.Delegations[d.Key()] = *d
+}
+func (m *MockStore) TotalDelegations() int {
+	return len(m.Delegations)
+}
 func (m *MockStore) LastBlockHeight() int {
 	return len(m.Blocks)
 }
@@ -123,6 +169,69 @@ func (m *MockStore) IterateValidators(consumer func(*validator.Validator) (stop
 	}
 }
 
+func (m *MockStore) IterateDelegations(consumer func(*validator.Delegation) (stop bool)) {
+	for _, v := range m.Delegations {
+		d := v
+		stopped := consumer(&d)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (m *MockStore) IterateValidatorDelegations(val crypto.Address, consumer func(*validator.Delegation) (stop bool)) {
+	for _, v := range m.Delegations {
+		if !v.Validator().EqualsTo(val) {
+			continue
+		}
+		d := v
+		stopped := consumer(&d)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (m *MockStore) HasProposal(id hash.Hash) bool {
+	_, ok := m.Proposals[id]
+	return ok
+}
+func (m *MockStore) Proposal(id hash.Hash) (*param.Proposal, error) {
+	p, ok := m.Proposals[id]
+	if ok {
+		return &p, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+func (m *MockStore) UpdateProposal(p *param.Proposal) {
+	m.Proposals[p.ID()] = *p
+}
+func (m *MockStore) TotalProposals() int {
+	return len(m.Proposals)
+}
+func (m *MockStore) IterateProposals(consumer func(*param.Proposal) (stop bool)) {
+	for _, v := range m.Proposals {
+		p := v
+		stopped := consumer(&p)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (m *MockStore) IterateProposalsByActivationHeight(height int, consumer func(*param.Proposal) (stop bool)) {
+	for _, v := range m.Proposals {
+		if v.ActivationHeight() != height {
+			continue
+		}
+		p := v
+		stopped := consumer(&p)
+		if stopped {
+			return
+		}
+	}
+}
+
 func (m *MockStore) SaveBlock(height int, block *block.Block) {
 	m.Blocks[height] = block
 }
@@ -137,6 +246,14 @@ func (m *MockStore) SaveLastInfo(info []byte) {
 func (m *MockStore) RestoreLastInfo() []byte {
 	return m.LastInfo
 }
+func (m *MockStore) SaveSnapshot(data []byte) {
+	m.Snapshot = data
+}
+func (m *MockStore) RestoreSnapshot() []byte {
+	return m.Snapshot
+}
+func (m *MockStore) SetMinRetainBlocks(count int) {
+}
 func (m *MockStore) WriteBatch() error {
 	return nil
 }
@@ -0,0 +1,116 @@
+package store
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	dbutil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type proposalStore struct {
+	db    *leveldb.DB
+	total int
+}
+
+func proposalKey(id hash.Hash) []byte { return append(proposalPrefix, id.RawBytes()...) }
+
+// proposalHeightKey indexes the proposals by their activation heights.
+func proposalHeightKey(height int, id hash.Hash) []byte {
+	key := append(activationPrefix, util.IntToSlice(height)...)
+	return append(key, id.RawBytes()...)
+}
+
+func newProposalStore(db *leveldb.DB) *proposalStore {
+	ps := &proposalStore{
+		db: db,
+	}
+
+	total := 0
+	ps.iterateProposals(func(p *param.Proposal) bool {
+		total++
+		return false
+	})
+	ps.total = total
+
+	return ps
+}
+
+func (ps *proposalStore) hasProposal(id hash.Hash) bool {
+	has, err := ps.db.Has(proposalKey(id), nil)
+	if err != nil {
+		return false
+	}
+	return has
+}
+
+func (ps *proposalStore) proposal(id hash.Hash) (*param.Proposal, error) {
+	data, err := tryGet(ps.db, proposalKey(id))
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(param.Proposal)
+	if err := p.Decode(data); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (ps *proposalStore) iterateProposals(consumer func(*param.Proposal) (stop bool)) {
+	r := dbutil.BytesPrefix(proposalPrefix)
+	iter := ps.db.NewIterator(r, nil)
+	defer iter.Release()
+	for iter.Next() {
+		value := iter.Value()
+
+		p := new(param.Proposal)
+		if err := p.Decode(value); err != nil {
+			panic(err)
+		}
+
+		stopped := consumer(p)
+		if stopped {
+			return
+		}
+	}
+}
+
+// iterateProposalsByActivationHeight iterates over the proposals that are activated at the given height.
+func (ps *proposalStore) iterateProposalsByActivationHeight(height int, consumer func(*param.Proposal) (stop bool)) {
+	prefix := append(activationPrefix, util.IntToSlice(height)...)
+	r := dbutil.BytesPrefix(prefix)
+	iter := ps.db.NewIterator(r, nil)
+	defer iter.Release()
+	for iter.Next() {
+		id, err := hash.FromRawBytes(iter.Key()[len(prefix):])
+		if err != nil {
+			panic(err)
+		}
+		p, err := ps.proposal(id)
+		if err != nil {
+			panic(err)
+		}
+
+		stopped := consumer(p)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (ps *proposalStore) updateProposal(batch *leveldb.Batch, p *param.Proposal) error {
+	data, err := p.Encode()
+	if err != nil {
+		return err
+	}
+	if !ps.hasProposal(p.ID()) {
+		ps.total++
+	}
+
+	batch.Put(proposalKey(p.ID()), data)
+	batch.Put(proposalHeightKey(p.ActivationHeight(), p.ID()), nil)
+
+	return nil
+}
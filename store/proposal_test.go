@@ -0,0 +1,81 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
+)
+
+func TestProposalCounter(t *testing.T) {
+	store, _ := NewStore(TestConfig())
+	p := param.GenerateTestProposal(0)
+
+	t.Run("Update count after adding new proposal", func(t *testing.T) {
+		assert.Equal(t, store.TotalProposals(), 0)
+		store.UpdateProposal(p)
+		assert.NoError(t, store.WriteBatch())
+		assert.Equal(t, store.TotalProposals(), 1)
+	})
+
+	t.Run("Update proposal, should not increase counter", func(t *testing.T) {
+		p.Approve(10)
+
+		store.UpdateProposal(p)
+		assert.NoError(t, store.WriteBatch())
+		assert.Equal(t, store.TotalProposals(), 1)
+
+		p2, err := store.Proposal(p.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, p2.Hash(), p.Hash())
+		assert.True(t, store.HasProposal(p.ID()))
+	})
+}
+
+func TestIterateProposals(t *testing.T) {
+	s, _ := NewStore(TestConfig())
+
+	for i := 0; i < 5; i++ {
+		s.UpdateProposal(param.GenerateTestProposal(i))
+	}
+	assert.NoError(t, s.WriteBatch())
+
+	numbers := make(map[int]bool)
+	s.IterateProposals(func(p *param.Proposal) bool {
+		numbers[p.Number()] = true
+		return false
+	})
+	assert.Len(t, numbers, 5)
+
+	// Reopening the store should count the proposals
+	ps := newProposalStore(s.(*store).db)
+	assert.Equal(t, ps.total, 5)
+}
+
+func TestIterateProposalsByActivationHeight(t *testing.T) {
+	s, _ := NewStore(TestConfig())
+
+	p1 := param.NewProposal(hash.GenerateTestHash(), crypto.GenerateTestAddress(), param.Changes{}, 100, 0)
+	p2 := param.NewProposal(hash.GenerateTestHash(), crypto.GenerateTestAddress(), param.Changes{}, 200, 1)
+	p3 := param.NewProposal(hash.GenerateTestHash(), crypto.GenerateTestAddress(), param.Changes{}, 100, 2)
+	s.UpdateProposal(p1)
+	s.UpdateProposal(p2)
+	s.UpdateProposal(p3)
+	assert.NoError(t, s.WriteBatch())
+
+	numbers := make(map[int]bool)
+	s.IterateProposalsByActivationHeight(100, func(p *param.Proposal) bool {
+		numbers[p.Number()] = true
+		return false
+	})
+	assert.Equal(t, numbers, map[int]bool{0: true, 2: true})
+
+	count := 0
+	s.IterateProposalsByActivationHeight(300, func(p *param.Proposal) bool {
+		count++
+		return false
+	})
+	assert.Zero(t, count)
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/zarbchain/zarb-go/account"
@@ -9,29 +11,48 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/metrics"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
 var (
-	infoKey         = []byte{0x00}
-	blockPrefix     = []byte{0x01}
-	blockHashPrefix = []byte{0x03}
-	accountPrefix   = []byte{0x05}
-	validatorPrefix = []byte{0x07}
-	txPrefix        = []byte{0x09}
+	infoKey          = []byte{0x00}
+	blockPrefix      = []byte{0x01}
+	blockHashPrefix  = []byte{0x03}
+	accountPrefix    = []byte{0x05}
+	validatorPrefix  = []byte{0x07}
+	txPrefix         = []byte{0x09}
+	txBlockPrefix    = []byte{0x0b}
+	snapshotKey      = []byte{0x0d}
+	prunedKey        = []byte{0x0f}
+	addressTxPrefix  = []byte{0x11}
+	delegationPrefix = []byte{0x13}
+	proposalPrefix   = []byte{0x15}
+	txIndexedKey     = []byte{0x17}
+	activationPrefix = []byte{0x19}
+	addrIndexedKey   = []byte{0x1b}
 )
 
 type store struct {
 	lk sync.RWMutex
 
-	config         *Config
-	db             *leveldb.DB
-	batch          *leveldb.Batch
-	blockStore     *blockStore
-	txStore        *txStore
-	accountStore   *accountStore
-	validatorStore *validatorStore
+	config          *Config
+	db              *leveldb.DB
+	batch           *leveldb.Batch
+	blockStore      *blockStore
+	txStore         *txStore
+	accountStore    *accountStore
+	validatorStore  *validatorStore
+	delegationStore *delegationStore
+	proposalStore   *proposalStore
+	lastHeight      int
+	prunedHeight    int
+	minRetainBlocks int
+	pruning         int32
+	pruneWG         sync.WaitGroup
 }
 
 func NewStore(conf *Config) (Store, error) {
@@ -40,18 +61,58 @@ func NewStore(conf *Config) (Store, error) {
 		return nil, err
 	}
 
-	return &store{
-		config:         conf,
-		db:             db,
-		batch:          new(leveldb.Batch),
-		blockStore:     newBlockStore(db),
-		txStore:        newTxStore(db),
-		accountStore:   newAccountStore(db),
-		validatorStore: newValidatorStore(db),
-	}, nil
+	s := &store{
+		config:          conf,
+		db:              db,
+		batch:           new(leveldb.Batch),
+		blockStore:      newBlockStore(db),
+		txStore:         newTxStore(db),
+		accountStore:    newAccountStore(db),
+		validatorStore:  newValidatorStore(db),
+		delegationStore: newDelegationStore(db),
+		proposalStore:   newProposalStore(db),
+	}
+
+	// The first block is never pruned, it is used to check the genesis state.
+	s.prunedHeight = 1
+	if data, err := tryGet(db, prunedKey); err == nil {
+		s.prunedHeight = util.SliceToInt(data)
+	}
+
+	// The databases created before the transaction indexes don't have them for the old blocks.
+	_, errTxIndexed := tryGet(db, txIndexedKey)
+	_, errAddrIndexed := tryGet(db, addrIndexedKey)
+	if errTxIndexed != nil || errAddrIndexed != nil {
+		if err := s.indexTransactions(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// indexTransactions rebuilds the transaction to block height index and
+// the address to transactions index for all the saved blocks.
+func (s *store) indexTransactions() error {
+	batch := new(leveldb.Batch)
+	count, err := s.blockStore.indexTxs(batch, s.txStore)
+	if err != nil {
+		return err
+	}
+	batch.Put(txIndexedKey, nil)
+	batch.Put(addrIndexedKey, nil)
+	if err := s.db.Write(batch, nil); err != nil {
+		return err
+	}
+	logger.Info("transactions indexed", "blocks", count)
+
+	return nil
 }
 
 func (s *store) Close() error {
+	atomic.StoreInt32(&s.pruning, -1) // Stop pruning
+	s.pruneWG.Wait()
+
 	return s.db.Close()
 }
 
@@ -61,6 +122,9 @@ func (s *store) SaveBlock(height int, block *block.Block) {
 	if err := s.blockStore.saveBlock(s.batch, height, block); err != nil {
 		logger.Panic("error on saving block: %v", err)
 	}
+	if height > s.lastHeight {
+		s.lastHeight = height
+	}
 }
 
 func (s *store) Block(height int) (*block.Block, error) {
@@ -93,6 +157,27 @@ func (s *store) Transaction(hash hash.Hash) (*tx.Tx, error) {
 	return s.txStore.tx(hash)
 }
 
+func (s *store) SaveAddressTransaction(addr crypto.Address, height int, id tx.ID) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.txStore.saveAddressTx(s.batch, addr, height, id)
+}
+
+func (s *store) AddressTransactions(addr crypto.Address, offset, count int) []tx.ID {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.txStore.addressTxs(addr, offset, count)
+}
+
+func (s *store) TransactionBlockHeight(id tx.ID) (int, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.blockStore.txBlockHeight(id)
+}
+
 func (s *store) HasAccount(addr crypto.Address) bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -174,6 +259,94 @@ func (s *store) UpdateValidator(acc *validator.Validator) {
 	}
 }
 
+func (s *store) HasDelegation(delegator, val crypto.Address) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.delegationStore.hasDelegation(delegator, val)
+}
+
+func (s *store) Delegation(delegator, val crypto.Address) (*validator.Delegation, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.delegationStore.delegation(delegator, val)
+}
+
+func (s *store) TotalDelegations() int {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.delegationStore.total
+}
+
+func (s *store) IterateDelegations(consumer func(*validator.Delegation) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.delegationStore.iterateDelegations(delegationPrefix, consumer)
+}
+
+func (s *store) IterateValidatorDelegations(val crypto.Address, consumer func(*validator.Delegation) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.delegationStore.iterateValidatorDelegations(val, consumer)
+}
+
+func (s *store) UpdateDelegation(d *validator.Delegation) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if err := s.delegationStore.updateDelegation(s.batch, d); err != nil {
+		logger.Panic("error on updating a delegation: %v", err)
+	}
+}
+
+func (s *store) HasProposal(id hash.Hash) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.hasProposal(id)
+}
+
+func (s *store) Proposal(id hash.Hash) (*param.Proposal, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.proposal(id)
+}
+
+func (s *store) TotalProposals() int {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.proposalStore.total
+}
+
+func (s *store) IterateProposals(consumer func(*param.Proposal) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.proposalStore.iterateProposals(consumer)
+}
+
+func (s *store) IterateProposalsByActivationHeight(height int, consumer func(*param.Proposal) (stop bool)) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.proposalStore.iterateProposalsByActivationHeight(height, consumer)
+}
+
+func (s *store) UpdateProposal(p *param.Proposal) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if err := s.proposalStore.updateProposal(s.batch, p); err != nil {
+		logger.Panic("error on updating a proposal: %v", err)
+	}
+}
+
 func (s *store) HasAnyBlock() bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -189,10 +362,113 @@ func (s *store) RestoreLastInfo() []byte {
 	return info
 }
 
+func (s *store) SaveSnapshot(data []byte) {
+	s.batch.Put(snapshotKey, data)
+}
+
+func (s *store) RestoreSnapshot() []byte {
+	data, _ := tryGet(s.db, snapshotKey)
+	return data
+}
+
 func (s *store) WriteBatch() error {
+	start := time.Now()
 	if err := s.db.Write(s.batch, nil); err != nil {
 		return err
 	}
+	metrics.ObserveStoreWrite(time.Since(start))
 	s.batch.Reset()
+	s.tryPrune()
+	return nil
+}
+
+// SetMinRetainBlocks sets the minimum number of the latest blocks that are kept on pruning.
+// The stamps of the transactions should be resolved, so at least the blocks
+// inside the transaction to live interval should be kept, even if it grows by the proposals.
+func (s *store) SetMinRetainBlocks(count int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.minRetainBlocks = count
+}
+
+// tryPrune starts pruning the old blocks in background, if pruning is enabled.
+func (s *store) tryPrune() {
+	if s.config.RetainBlocks == 0 {
+		return
+	}
+
+	s.lk.Lock()
+	retainBlocks := s.config.RetainBlocks
+	if retainBlocks < s.minRetainBlocks {
+		retainBlocks = s.minRetainBlocks
+	}
+	to := s.lastHeight - retainBlocks
+	from := s.prunedHeight + 1
+	s.lk.Unlock()
+
+	if to < from {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&s.pruning, 0, 1) {
+		// Pruning is in progress or the store is closed
+		return
+	}
+
+	s.pruneWG.Add(1)
+	go func() {
+		defer s.pruneWG.Done()
+		defer atomic.CompareAndSwapInt32(&s.pruning, 1, 0)
+
+		if err := s.pruneBlocks(from, to); err != nil {
+			logger.Error("error on pruning blocks", "err", err)
+		}
+	}()
+}
+
+// pruneBlock removes the block at the given height, its transactions and their address indices.
+func (s *store) pruneBlock(batch *leveldb.Batch, height int) error {
+	b, err := s.blockStore.block(height)
+	if err != nil {
+		return err
+	}
+	for _, id := range b.TxIDs().IDs() {
+		trx, err := s.txStore.tx(id)
+		if err != nil {
+			continue
+		}
+		for _, addr := range trx.Addresses() {
+			batch.Delete(addressTxKey(addr, height, id))
+		}
+	}
+	s.blockStore.pruneBlock(batch, height, b)
+
+	return nil
+}
+
+// pruneBlocks removes the blocks and their transactions in the given range.
+func (s *store) pruneBlocks(from, to int) error {
+	for h := from; h <= to; h++ {
+		if atomic.LoadInt32(&s.pruning) < 0 {
+			return nil
+		}
+
+		batch := new(leveldb.Batch)
+		err := s.pruneBlock(batch, h)
+		// Nodes that are bootstrapped from a snapshot don't have the old blocks
+		if err != nil && err != leveldb.ErrNotFound {
+			return err
+		}
+		batch.Put(prunedKey, util.IntToSlice(h))
+		if err := s.db.Write(batch, nil); err != nil {
+			return err
+		}
+
+		s.lk.Lock()
+		s.prunedHeight = h
+		s.lk.Unlock()
+	}
+	logger.Debug("blocks pruned", "from", from, "to", to)
+
 	return nil
 }
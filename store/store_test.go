@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/validator"
 )
@@ -38,6 +41,10 @@ func TestReturnNilForNonExistingItems(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, tx)
 
+	height, err = tStore.TransactionBlockHeight(txs[0].ID())
+	assert.Error(t, err)
+	assert.Equal(t, height, -1)
+
 	acc, err := tStore.Account(b.Header().ProposerAddress())
 	assert.Error(t, err)
 	assert.Nil(t, acc)
@@ -79,6 +86,10 @@ func TestRetrieveBlockAndTransactions(t *testing.T) {
 		assert.NoError(t, err)
 
 		assert.Equal(t, trx.ID(), trx2.ID())
+
+		h3, err := tStore.TransactionBlockHeight(trx.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, h, h3)
 	}
 
 	// After closing db, we should not crash
@@ -222,3 +233,170 @@ func TestReestoreLastInfo(t *testing.T) {
 	assert.NoError(t, tStore.WriteBatch())
 	assert.NotNil(t, tStore.RestoreLastInfo())
 }
+
+func TestPruneBlocks(t *testing.T) {
+	conf := TestConfig()
+	conf.RetainBlocks = 5
+	s, err := NewStore(conf)
+	assert.NoError(t, err)
+	tStore = s.(*store)
+
+	trxsByHeight := make(map[int][]*tx.Tx)
+	for h := 1; h <= 10; h++ {
+		b, trxs := block.GenerateTestBlock(nil, nil)
+		tStore.SaveBlock(h, b)
+		for _, trx := range trxs {
+			tStore.SaveTransaction(trx)
+		}
+		trxsByHeight[h] = trxs
+		for _, addr := range trxs[0].Addresses() {
+			tStore.SaveAddressTransaction(addr, h, trxs[0].ID())
+		}
+		assert.NoError(t, tStore.WriteBatch())
+		tStore.pruneWG.Wait()
+	}
+
+	// The first block is kept
+	_, err = tStore.Block(1)
+	assert.NoError(t, err)
+	for h := 2; h <= 5; h++ {
+		_, err := tStore.Block(h)
+		assert.Error(t, err)
+		_, err = tStore.Transaction(trxsByHeight[h][0].ID())
+		assert.Error(t, err)
+		_, err = tStore.TransactionBlockHeight(trxsByHeight[h][0].ID())
+		assert.Error(t, err)
+		addr := trxsByHeight[h][0].Addresses()[0]
+		assert.Empty(t, tStore.AddressTransactions(addr, 0, 1))
+	}
+	for h := 6; h <= 10; h++ {
+		_, err := tStore.Block(h)
+		assert.NoError(t, err)
+		_, err = tStore.Transaction(trxsByHeight[h][0].ID())
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tStore.Close())
+
+	// Reopen the store
+	s, err = NewStore(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, s.(*store).prunedHeight, 5)
+	assert.NoError(t, s.Close())
+}
+
+func TestMinRetainBlocks(t *testing.T) {
+	conf := TestConfig()
+	conf.RetainBlocks = 5
+	s, err := NewStore(conf)
+	assert.NoError(t, err)
+	tStore = s.(*store)
+
+	// The transaction to live interval is more than the retain blocks
+	tStore.SetMinRetainBlocks(7)
+	for h := 1; h <= 10; h++ {
+		b, _ := block.GenerateTestBlock(nil, nil)
+		tStore.SaveBlock(h, b)
+		assert.NoError(t, tStore.WriteBatch())
+		tStore.pruneWG.Wait()
+	}
+
+	for h := 2; h <= 3; h++ {
+		_, err := tStore.Block(h)
+		assert.Error(t, err)
+	}
+	for h := 4; h <= 10; h++ {
+		_, err := tStore.Block(h)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, tStore.prunedHeight, 3)
+	assert.NoError(t, tStore.Close())
+}
+
+func TestIndexTransactions(t *testing.T) {
+	conf := TestConfig()
+	s, err := NewStore(conf)
+	assert.NoError(t, err)
+	tStore = s.(*store)
+
+	b, trxs := block.GenerateTestBlock(nil, nil)
+	tStore.SaveBlock(10, b)
+	for _, trx := range trxs {
+		tStore.SaveTransaction(trx)
+	}
+	assert.NoError(t, tStore.WriteBatch())
+
+	// Simulate a database that is created before indexing the transactions
+	batch := new(leveldb.Batch)
+	for _, trx := range trxs {
+		batch.Delete(txBlockKey(trx.ID()))
+	}
+	batch.Delete(txIndexedKey)
+	batch.Delete(addrIndexedKey)
+	assert.NoError(t, tStore.db.Write(batch, nil))
+	_, err = tStore.TransactionBlockHeight(trxs[0].ID())
+	assert.Error(t, err)
+	assert.Empty(t, tStore.AddressTransactions(trxs[0].Addresses()[0], 0, 10))
+	assert.NoError(t, tStore.Close())
+
+	// Reopen the store
+	s, err = NewStore(conf)
+	assert.NoError(t, err)
+	for _, trx := range trxs {
+		height, err := s.TransactionBlockHeight(trx.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, height, 10)
+
+		for _, addr := range trx.Addresses() {
+			assert.Contains(t, s.AddressTransactions(addr, 0, 10), trx.ID())
+		}
+	}
+	assert.NoError(t, s.Close())
+}
+
+func TestIndexAddressTransactions(t *testing.T) {
+	conf := TestConfig()
+	s, err := NewStore(conf)
+	assert.NoError(t, err)
+	tStore = s.(*store)
+
+	b, trxs := block.GenerateTestBlock(nil, nil)
+	tStore.SaveBlock(10, b)
+	for _, trx := range trxs {
+		tStore.SaveTransaction(trx)
+	}
+	assert.NoError(t, tStore.WriteBatch())
+
+	// Simulate a database that has the transaction index but not the address index
+	batch := new(leveldb.Batch)
+	batch.Delete(addrIndexedKey)
+	assert.NoError(t, tStore.db.Write(batch, nil))
+	addr := trxs[0].Addresses()[0]
+	assert.Empty(t, tStore.AddressTransactions(addr, 0, 10))
+	assert.NoError(t, tStore.Close())
+
+	// Reopen the store
+	s, err = NewStore(conf)
+	assert.NoError(t, err)
+	assert.Contains(t, s.AddressTransactions(addr, 0, 10), trxs[0].ID())
+	assert.NoError(t, s.Close())
+}
+
+func TestAddressTransactions(t *testing.T) {
+	setup(t)
+
+	addr1 := crypto.GenerateTestAddress()
+	addr2 := crypto.GenerateTestAddress()
+	ids := make([]tx.ID, 0)
+	for h := 1; h <= 10; h++ {
+		trx, _ := tx.GenerateTestSendTx()
+		ids = append(ids, trx.ID())
+		tStore.SaveAddressTransaction(addr1, h, trx.ID())
+	}
+	assert.NoError(t, tStore.WriteBatch())
+
+	assert.Empty(t, tStore.AddressTransactions(addr2, 0, 5))
+	assert.Equal(t, tStore.AddressTransactions(addr1, 0, 3), []tx.ID{ids[9], ids[8], ids[7]})
+	assert.Equal(t, tStore.AddressTransactions(addr1, 3, 3), []tx.ID{ids[6], ids[5], ids[4]})
+	assert.Equal(t, tStore.AddressTransactions(addr1, 8, 3), []tx.ID{ids[1], ids[0]})
+	assert.Empty(t, tStore.AddressTransactions(addr1, 10, 3))
+}
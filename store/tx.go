@@ -3,10 +3,22 @@ package store
 import (
 	"github.com/fxamacker/cbor/v2"
 	"github.com/syndtr/goleveldb/leveldb"
+	dbutil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
 )
 
 func txKey(id tx.ID) []byte { return append(txPrefix, id.RawBytes()...) }
+func addressTxKeyPrefix(addr crypto.Address) []byte {
+	return append(append([]byte{}, addressTxPrefix...), addr.RawBytes()...)
+}
+func addressTxKey(addr crypto.Address, height int, id tx.ID) []byte {
+	key := addressTxKeyPrefix(addr)
+	key = append(key, util.IntToSlice(height)...)
+	return append(key, id.RawBytes()...)
+}
 
 type txStore struct {
 	db *leveldb.DB
@@ -49,3 +61,28 @@ func (ts *txStore) tx(id tx.ID) (*tx.Tx, error) {
 	}
 	return trx, nil
 }
+
+func (ts *txStore) saveAddressTx(batch *leveldb.Batch, addr crypto.Address, height int, id tx.ID) {
+	batch.Put(addressTxKey(addr, height, id), nil)
+}
+
+// addressTxs returns the transaction IDs of the given address, from the newest to the oldest.
+func (ts *txStore) addressTxs(addr crypto.Address, offset, count int) []tx.ID {
+	ids := make([]tx.ID, 0, count)
+	iter := ts.db.NewIterator(dbutil.BytesPrefix(addressTxKeyPrefix(addr)), nil)
+	defer iter.Release()
+
+	for ok := iter.Last(); ok && len(ids) < count; ok = iter.Prev() {
+		if offset > 0 {
+			offset--
+			continue
+		}
+		key := iter.Key()
+		id, err := hash.FromRawBytes(key[len(key)-hash.HashSize:])
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/proposal"
 	"github.com/zarbchain/zarb-go/consensus/vote"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/tx"
@@ -60,6 +61,25 @@ func TestMessageCompress(t *testing.T) {
 	assert.True(t, util.IsFlagSet(bdl.Flags, BundleFlagCompressed))
 }
 
+func TestCompactProposalSize(t *testing.T) {
+	p, _ := proposal.GenerateTestProposal(100, 0)
+	bdl1 := NewBundle(util.RandomPeerID(), message.NewProposalMessage(p))
+	bdl2 := NewBundle(util.RandomPeerID(), message.NewCompactProposalMessage(p))
+	bs1, err := bdl1.Encode()
+	assert.NoError(t, err)
+	bs2, err := bdl2.Encode()
+	assert.NoError(t, err)
+	assert.Less(t, len(bs2), len(bs1))
+
+	bdl3 := new(Bundle)
+	_, err = bdl3.Decode(bytes.NewReader(bs2))
+	assert.NoError(t, err)
+	assert.NoError(t, bdl3.SanityCheck())
+	p2, err := bdl3.Message.(*message.CompactProposalMessage).Proposal(p.Block().TxIDs().IDs())
+	assert.NoError(t, err)
+	assert.Equal(t, p2.Hash(), p.Hash())
+}
+
 func TestDecodeVoteMessage(t *testing.T) {
 	v, _ := vote.GenerateTestPrecommitVote(88, 0)
 	msg := message.NewVoteMessage(v)
@@ -0,0 +1,116 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/proposal"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+// CompactProposalMessage carries a proposal without the full transaction IDs of its block.
+// Transactions are identified by their short IDs, that are keyed by the header hash,
+// so the receiver can reconstruct the block from its transaction pool.
+type CompactProposalMessage struct {
+	Height          int                `cbor:"1,keyasint"`
+	Round           int                `cbor:"2,keyasint"`
+	Header          *block.Header      `cbor:"3,keyasint"`
+	PrevCertificate *block.Certificate `cbor:"4,keyasint"`
+	ShortIDs        []uint64           `cbor:"5,keyasint"`
+	Signature       *bls.Signature     `cbor:"6,keyasint"`
+}
+
+func NewCompactProposalMessage(p *proposal.Proposal) *CompactProposalMessage {
+	b := p.Block()
+	ids := b.TxIDs().IDs()
+	key := b.Header().Hash()
+	shortIDs := make([]uint64, len(ids))
+	for i, id := range ids {
+		shortIDs[i] = id.ShortID(key)
+	}
+	sig, _ := p.Signature().(*bls.Signature)
+
+	return &CompactProposalMessage{
+		Height:          p.Height(),
+		Round:           p.Round(),
+		Header:          b.Header(),
+		PrevCertificate: b.PrevCertificate(),
+		ShortIDs:        shortIDs,
+		Signature:       sig,
+	}
+}
+
+func (m *CompactProposalMessage) SanityCheck() error {
+	if m.Height <= 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid height")
+	}
+	if m.Round < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid round")
+	}
+	if m.Header == nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "no header")
+	}
+	if err := m.Header.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, err.Error())
+	}
+	if m.PrevCertificate != nil {
+		if err := m.PrevCertificate.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidMessage, err.Error())
+		}
+	}
+	if len(m.ShortIDs) == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "no transaction")
+	}
+	if m.Signature == nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "no signature")
+	}
+	if err := m.Signature.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid signature")
+	}
+
+	return nil
+}
+
+// Verify checks the signature of the proposal.
+// The block hash is the header hash, so it doesn't need the transactions.
+func (m *CompactProposalMessage) Verify(pubKey crypto.PublicKey) error {
+	if !pubKey.Address().EqualsTo(m.Header.ProposerAddress()) {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid proposer")
+	}
+	if !pubKey.Verify(proposal.SignBytes(m.Height, m.Round, m.Header.Hash()), m.Signature) {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid signature")
+	}
+	return nil
+}
+
+// Proposal reconstructs the proposal by the full IDs of the transactions.
+// It returns an error if the IDs don't match the block header.
+func (m *CompactProposalMessage) Proposal(ids []tx.ID) (*proposal.Proposal, error) {
+	if len(ids) != len(m.ShortIDs) {
+		return nil, errors.Errorf(errors.ErrInvalidMessage, "expected %v transactions, got %v", len(m.ShortIDs), len(ids))
+	}
+	txIDs := block.NewTxIDs()
+	for _, id := range ids {
+		txIDs.Append(id)
+	}
+	b := block.NewBlock(*m.Header, m.PrevCertificate, txIDs)
+	if err := b.SanityCheck(); err != nil {
+		return nil, errors.Errorf(errors.ErrInvalidMessage, err.Error())
+	}
+
+	p := proposal.NewProposal(m.Height, m.Round, b)
+	p.SetSignature(m.Signature)
+
+	return p, nil
+}
+
+func (m *CompactProposalMessage) Type() Type {
+	return MessageTypeCompactProposal
+}
+
+func (m *CompactProposalMessage) Fingerprint() string {
+	return fmt.Sprintf("{%v/%v 🗃 {⌘ %v 📨 %d}}", m.Height, m.Round, m.Header.Hash().Fingerprint(), len(m.ShortIDs))
+}
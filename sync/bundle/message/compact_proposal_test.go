@@ -0,0 +1,89 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/consensus/proposal"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+func TestCompactProposalType(t *testing.T) {
+	m := &CompactProposalMessage{}
+	assert.Equal(t, m.Type(), MessageTypeCompactProposal)
+}
+
+func TestCompactProposalMessage(t *testing.T) {
+	t.Run("Invalid round", func(t *testing.T) {
+		p, _ := proposal.GenerateTestProposal(100, -1)
+		m := NewCompactProposalMessage(p)
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("No signature", func(t *testing.T) {
+		p, _ := proposal.GenerateTestProposal(100, 0)
+		m := NewCompactProposalMessage(p)
+		m.Signature = nil
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		p, _ := proposal.GenerateTestProposal(100, 0)
+		m := NewCompactProposalMessage(p)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Contains(t, m.Fingerprint(), "100")
+		assert.Equal(t, len(m.ShortIDs), p.Block().TxIDs().Len())
+		assert.Equal(t, m.ShortIDs[0], p.Block().TxIDs().IDs()[0].ShortID(p.Block().Header().Hash()))
+	})
+}
+
+func TestCompactProposalVerification(t *testing.T) {
+	p, signer := proposal.GenerateTestProposal(100, 0)
+	m := NewCompactProposalMessage(p)
+
+	t.Run("Invalid proposer", func(t *testing.T) {
+		_, signer2 := proposal.GenerateTestProposal(100, 0)
+		assert.Error(t, m.Verify(signer2.PublicKey()))
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		m2 := NewCompactProposalMessage(p)
+		m2.Round = 1
+		assert.Error(t, m2.Verify(signer.PublicKey()))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		assert.NoError(t, m.Verify(signer.PublicKey()))
+	})
+}
+
+func TestCompactProposalReconstruction(t *testing.T) {
+	p, _ := proposal.GenerateTestProposal(100, 0)
+	m := NewCompactProposalMessage(p)
+	ids := p.Block().TxIDs().IDs()
+
+	t.Run("Missing transactions", func(t *testing.T) {
+		_, err := m.Proposal(ids[1:])
+		assert.Error(t, err)
+	})
+
+	t.Run("Transactions don't match the header", func(t *testing.T) {
+		invIDs := make([]tx.ID, len(ids))
+		copy(invIDs, ids)
+		invIDs[0] = hash.GenerateTestHash()
+		_, err := m.Proposal(invIDs)
+		assert.Error(t, err)
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		p2, err := m.Proposal(ids)
+		assert.NoError(t, err)
+		assert.Equal(t, p2.Hash(), p.Hash())
+		assert.Equal(t, p2.Signature(), p.Signature())
+		assert.NoError(t, p2.SanityCheck())
+	})
+}
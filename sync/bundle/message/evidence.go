@@ -0,0 +1,34 @@
+package message
+
+import (
+	"github.com/zarbchain/zarb-go/consensus/evidence"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type EvidenceMessage struct {
+	Evidence *evidence.Evidence `cbor:"1,keyasint"`
+}
+
+func NewEvidenceMessage(ev *evidence.Evidence) *EvidenceMessage {
+	return &EvidenceMessage{
+		Evidence: ev,
+	}
+}
+
+func (m *EvidenceMessage) SanityCheck() error {
+	if m.Evidence == nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "no evidence")
+	}
+	if err := m.Evidence.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, err.Error())
+	}
+	return nil
+}
+
+func (m *EvidenceMessage) Type() Type {
+	return MessageTypeEvidence
+}
+
+func (m *EvidenceMessage) Fingerprint() string {
+	return m.Evidence.Fingerprint()
+}
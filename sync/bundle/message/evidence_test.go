@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
+)
+
+func TestEvidenceType(t *testing.T) {
+	m := &EvidenceMessage{}
+	assert.Equal(t, m.Type(), MessageTypeEvidence)
+}
+
+func TestEvidenceMessage(t *testing.T) {
+	t.Run("Invalid evidence", func(t *testing.T) {
+		ev1, _ := evidence.GenerateTestEvidence(100, 0)
+		ev2, _ := evidence.GenerateTestEvidence(100, 0)
+		m := NewEvidenceMessage(evidence.NewEvidence(ev1.VoteA(), ev2.VoteA()))
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		ev, _ := evidence.GenerateTestEvidence(100, 0)
+		m := NewEvidenceMessage(ev)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Contains(t, m.Fingerprint(), ev.Fingerprint())
+	})
+}
@@ -0,0 +1,39 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type HeadersRequestMessage struct {
+	SessionID int `cbor:"1,keyasint"`
+	From      int `cbor:"2,keyasint"`
+	To        int `cbor:"3,keyasint"`
+}
+
+func NewHeadersRequestMessage(sid int, from, to int) *HeadersRequestMessage {
+	return &HeadersRequestMessage{
+		SessionID: sid,
+		From:      from,
+		To:        to,
+	}
+}
+
+func (m *HeadersRequestMessage) SanityCheck() error {
+	if m.From < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid Height")
+	}
+	if m.From > m.To {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid range")
+	}
+	return nil
+}
+
+func (m *HeadersRequestMessage) Type() Type {
+	return MessageTypeHeadersRequest
+}
+
+func (m *HeadersRequestMessage) Fingerprint() string {
+	return fmt.Sprintf("{⚓ %d %v:%v}", m.SessionID, m.From, m.To)
+}
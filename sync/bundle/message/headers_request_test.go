@@ -0,0 +1,33 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadersRequestType(t *testing.T) {
+	m := &HeadersRequestMessage{}
+	assert.Equal(t, m.Type(), MessageTypeHeadersRequest)
+}
+
+func TestHeadersRequestMessage(t *testing.T) {
+	t.Run("Invalid from", func(t *testing.T) {
+		m := NewHeadersRequestMessage(1, -100, 200)
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		m := NewHeadersRequestMessage(1, 200, 100)
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewHeadersRequestMessage(1, 100, 200)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Contains(t, m.Fingerprint(), "100")
+	})
+}
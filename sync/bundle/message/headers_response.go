@@ -0,0 +1,75 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// HeadersResponseMessage carries the headers of the blocks without their transactions.
+// Each header comes with the certificate of its block, so the chain of headers can be verified.
+type HeadersResponseMessage struct {
+	ResponseCode ResponseCode         `cbor:"1,keyasint"`
+	SessionID    int                  `cbor:"2,keyasint"`
+	From         int                  `cbor:"3,keyasint"`
+	Headers      []*block.Header      `cbor:"4,keyasint"`
+	Certificates []*block.Certificate `cbor:"5,keyasint"`
+}
+
+func NewHeadersResponseMessage(code ResponseCode, sid int, from int,
+	headers []*block.Header, certs []*block.Certificate) *HeadersResponseMessage {
+	return &HeadersResponseMessage{
+		ResponseCode: code,
+		SessionID:    sid,
+		From:         from,
+		Headers:      headers,
+		Certificates: certs,
+	}
+}
+
+func (m *HeadersResponseMessage) SanityCheck() error {
+	if m.From < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid Height")
+	}
+	if len(m.Headers) != len(m.Certificates) {
+		return errors.Errorf(errors.ErrInvalidMessage, "each header should have a certificate")
+	}
+	for i, h := range m.Headers {
+		if err := h.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid header: %v", err)
+		}
+		cert := m.Certificates[i]
+		if err := cert.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid certificate: %v", err)
+		}
+		if !cert.BlockHash().EqualsTo(h.Hash()) {
+			return errors.Errorf(errors.ErrInvalidMessage, "certificate is not for this header")
+		}
+	}
+	return nil
+}
+
+func (m *HeadersResponseMessage) Type() Type {
+	return MessageTypeHeadersResponse
+}
+
+func (m *HeadersResponseMessage) To() int {
+	if len(m.Headers) == 0 {
+		return m.From
+	}
+	return m.From + len(m.Headers) - 1
+}
+
+func (m *HeadersResponseMessage) Fingerprint() string {
+	return fmt.Sprintf("{⚓ %d %s %v-%v}", m.SessionID, m.ResponseCode, m.From, m.To())
+}
+
+func (m *HeadersResponseMessage) IsRequestRejected() bool {
+	if m.ResponseCode == ResponseCodeBusy ||
+		m.ResponseCode == ResponseCodeRejected {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+)
+
+func TestHeadersResponseType(t *testing.T) {
+	m := &HeadersResponseMessage{}
+	assert.Equal(t, m.Type(), MessageTypeHeadersResponse)
+}
+
+func TestHeadersResponseMessage(t *testing.T) {
+	b1, _ := block.GenerateTestBlock(nil, nil)
+	b2, _ := block.GenerateTestBlock(nil, nil)
+	c1 := block.GenerateTestCertificate(b1.Hash())
+	c2 := block.GenerateTestCertificate(b2.Hash())
+
+	t.Run("Invalid from", func(t *testing.T) {
+		m := NewHeadersResponseMessage(ResponseCodeMoreBlocks, 1, -1, []*block.Header{b1.Header()}, []*block.Certificate{c1})
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Header without certificate", func(t *testing.T) {
+		m := NewHeadersResponseMessage(ResponseCodeMoreBlocks, 1, 100, []*block.Header{b1.Header(), b2.Header()}, []*block.Certificate{c1})
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Invalid certificate", func(t *testing.T) {
+		cert := block.GenerateTestCertificate(hash.UndefHash)
+		m := NewHeadersResponseMessage(ResponseCodeMoreBlocks, 1, 100, []*block.Header{b1.Header()}, []*block.Certificate{cert})
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Certificate is not for the header", func(t *testing.T) {
+		m := NewHeadersResponseMessage(ResponseCodeMoreBlocks, 1, 100, []*block.Header{b1.Header()}, []*block.Certificate{c2})
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewHeadersResponseMessage(ResponseCodeMoreBlocks, 1, 100, []*block.Header{b1.Header(), b2.Header()}, []*block.Certificate{c1, c2})
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Equal(t, m.To(), 101)
+		assert.Contains(t, m.Fingerprint(), "100")
+		assert.False(t, m.IsRequestRejected())
+	})
+
+	t.Run("Busy", func(t *testing.T) {
+		m := NewHeadersResponseMessage(ResponseCodeBusy, 1, 0, nil, nil)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Equal(t, m.To(), 0)
+		assert.True(t, m.IsRequestRejected())
+	})
+}
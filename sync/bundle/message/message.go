@@ -50,6 +50,13 @@ const (
 	MessageTypeBlockAnnounce     = Type(9)
 	MessageTypeBlocksRequest     = Type(10)
 	MessageTypeBlocksResponse    = Type(11)
+	MessageTypeSnapshotRequest   = Type(12)
+	MessageTypeSnapshotResponse  = Type(13)
+	MessageTypeEvidence          = Type(14)
+	MessageTypeHeadersRequest    = Type(15)
+	MessageTypeHeadersResponse   = Type(16)
+	MessageTypeCompactProposal   = Type(17)
+	MessageTypeQueryProposalTxs  = Type(18)
 )
 
 func (t Type) TopicID() network.TopicID {
@@ -58,11 +65,14 @@ func (t Type) TopicID() network.TopicID {
 		MessageTypeHeartBeat,
 		MessageTypeQueryTransactions,
 		MessageTypeTransactions,
-		MessageTypeBlockAnnounce:
+		MessageTypeBlockAnnounce,
+		MessageTypeEvidence:
 		return network.TopicIDGeneral
 
 	case MessageTypeQueryProposal,
 		MessageTypeProposal,
+		MessageTypeCompactProposal,
+		MessageTypeQueryProposalTxs,
 		MessageTypeQueryVotes,
 		MessageTypeVote:
 		return network.TopicIDConsensus
@@ -96,6 +106,20 @@ func (t Type) String() string {
 		return "blocks-req"
 	case MessageTypeBlocksResponse:
 		return "blocks-res"
+	case MessageTypeSnapshotRequest:
+		return "snapshot-req"
+	case MessageTypeSnapshotResponse:
+		return "snapshot-res"
+	case MessageTypeEvidence:
+		return "evidence"
+	case MessageTypeHeadersRequest:
+		return "headers-req"
+	case MessageTypeHeadersResponse:
+		return "headers-res"
+	case MessageTypeCompactProposal:
+		return "compact-proposal"
+	case MessageTypeQueryProposalTxs:
+		return "query-proposal-txs"
 	}
 	return fmt.Sprintf("%d", t)
 }
@@ -124,6 +148,20 @@ func MakeMessage(t Type) Message {
 		return &BlocksRequestMessage{}
 	case MessageTypeBlocksResponse:
 		return &BlocksResponseMessage{}
+	case MessageTypeSnapshotRequest:
+		return &SnapshotRequestMessage{}
+	case MessageTypeSnapshotResponse:
+		return &SnapshotResponseMessage{}
+	case MessageTypeEvidence:
+		return &EvidenceMessage{}
+	case MessageTypeHeadersRequest:
+		return &HeadersRequestMessage{}
+	case MessageTypeHeadersResponse:
+		return &HeadersResponseMessage{}
+	case MessageTypeCompactProposal:
+		return &CompactProposalMessage{}
+	case MessageTypeQueryProposalTxs:
+		return &QueryProposalTxsMessage{}
 	}
 
 	//
@@ -0,0 +1,51 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// QueryProposalTxsMessage queries the transactions of a compact proposal
+// that are missing from our transaction pool.
+// Transactions are referred by their index inside the proposed block.
+type QueryProposalTxsMessage struct {
+	Height  int   `cbor:"1,keyasint"`
+	Round   int   `cbor:"2,keyasint"`
+	Indexes []int `cbor:"3,keyasint"`
+}
+
+func NewQueryProposalTxsMessage(h, r int, indexes []int) *QueryProposalTxsMessage {
+	return &QueryProposalTxsMessage{
+		Height:  h,
+		Round:   r,
+		Indexes: indexes,
+	}
+}
+
+func (m *QueryProposalTxsMessage) SanityCheck() error {
+	if m.Height < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid height")
+	}
+	if m.Round < 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid round")
+	}
+	if len(m.Indexes) == 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "empty list")
+	}
+	for _, i := range m.Indexes {
+		if i < 0 {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid index")
+		}
+	}
+
+	return nil
+}
+
+func (m *QueryProposalTxsMessage) Type() Type {
+	return MessageTypeQueryProposalTxs
+}
+
+func (m *QueryProposalTxsMessage) Fingerprint() string {
+	return fmt.Sprintf("%v/%v %v", m.Height, m.Round, m.Indexes)
+}
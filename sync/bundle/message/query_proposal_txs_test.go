@@ -0,0 +1,39 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryProposalTxsType(t *testing.T) {
+	m := &QueryProposalTxsMessage{}
+	assert.Equal(t, m.Type(), MessageTypeQueryProposalTxs)
+}
+
+func TestQueryProposalTxsMessage(t *testing.T) {
+	t.Run("Invalid round", func(t *testing.T) {
+		m := NewQueryProposalTxsMessage(100, -1, []int{0})
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Empty list", func(t *testing.T) {
+		m := NewQueryProposalTxsMessage(100, 0, nil)
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Invalid index", func(t *testing.T) {
+		m := NewQueryProposalTxsMessage(100, 0, []int{1, -1})
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewQueryProposalTxsMessage(100, 0, []int{1, 2})
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Contains(t, m.Fingerprint(), "100")
+	})
+}
@@ -0,0 +1,27 @@
+package message
+
+import (
+	"fmt"
+)
+
+type SnapshotRequestMessage struct {
+	SessionID int `cbor:"1,keyasint"`
+}
+
+func NewSnapshotRequestMessage(sid int) *SnapshotRequestMessage {
+	return &SnapshotRequestMessage{
+		SessionID: sid,
+	}
+}
+
+func (m *SnapshotRequestMessage) SanityCheck() error {
+	return nil
+}
+
+func (m *SnapshotRequestMessage) Type() Type {
+	return MessageTypeSnapshotRequest
+}
+
+func (m *SnapshotRequestMessage) Fingerprint() string {
+	return fmt.Sprintf("{⚓ %d}", m.SessionID)
+}
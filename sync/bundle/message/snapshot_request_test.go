@@ -0,0 +1,21 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotRequestType(t *testing.T) {
+	m := &SnapshotRequestMessage{}
+	assert.Equal(t, m.Type(), MessageTypeSnapshotRequest)
+}
+
+func TestSnapshotRequestMessage(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		m := NewSnapshotRequestMessage(7)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.Contains(t, m.Fingerprint(), "7")
+	})
+}
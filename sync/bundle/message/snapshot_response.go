@@ -0,0 +1,67 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/snapshot"
+)
+
+type SnapshotResponseMessage struct {
+	ResponseCode    ResponseCode       `cbor:"1,keyasint"`
+	SessionID       int                `cbor:"2,keyasint"`
+	Snapshot        *snapshot.Snapshot `cbor:"3,keyasint"`
+	NextBlock       *block.Block       `cbor:"4,keyasint"`
+	NextCertificate *block.Certificate `cbor:"5,keyasint"`
+}
+
+func NewSnapshotResponseMessage(code ResponseCode, sid int,
+	snap *snapshot.Snapshot, nextBlock *block.Block, nextCert *block.Certificate) *SnapshotResponseMessage {
+	return &SnapshotResponseMessage{
+		ResponseCode:    code,
+		SessionID:       sid,
+		Snapshot:        snap,
+		NextBlock:       nextBlock,
+		NextCertificate: nextCert,
+	}
+}
+
+func (m *SnapshotResponseMessage) SanityCheck() error {
+	if m.ResponseCode != ResponseCodeOK {
+		return nil
+	}
+	if m.Snapshot == nil || m.NextBlock == nil || m.NextCertificate == nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "snapshot is incomplete")
+	}
+	if err := m.Snapshot.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid snapshot: %v", err)
+	}
+	if err := m.NextBlock.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid block: %v", err)
+	}
+	if err := m.NextCertificate.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid certificate: %v", err)
+	}
+	return nil
+}
+
+func (m *SnapshotResponseMessage) Type() Type {
+	return MessageTypeSnapshotResponse
+}
+
+func (m *SnapshotResponseMessage) Fingerprint() string {
+	if m.Snapshot == nil {
+		return fmt.Sprintf("{⚓ %d %s}", m.SessionID, m.ResponseCode)
+	}
+	return fmt.Sprintf("{⚓ %d %s %v}", m.SessionID, m.ResponseCode, m.Snapshot.Fingerprint())
+}
+
+func (m *SnapshotResponseMessage) IsRequestRejected() bool {
+	if m.ResponseCode == ResponseCodeBusy ||
+		m.ResponseCode == ResponseCodeRejected {
+		return true
+	}
+
+	return false
+}
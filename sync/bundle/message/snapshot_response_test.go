@@ -0,0 +1,61 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/snapshot"
+)
+
+func TestSnapshotResponseType(t *testing.T) {
+	m := &SnapshotResponseMessage{}
+	assert.Equal(t, m.Type(), MessageTypeSnapshotResponse)
+}
+
+func TestSnapshotResponseMessage(t *testing.T) {
+	t.Run("No snapshot", func(t *testing.T) {
+		b, _ := block.GenerateTestBlock(nil, nil)
+		c := block.GenerateTestCertificate(b.Hash())
+		m := NewSnapshotResponseMessage(ResponseCodeOK, 1, nil, b, c)
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("Invalid certificate", func(t *testing.T) {
+		snap := snapshot.GenerateTestSnapshot(100, nil)
+		b, _ := block.GenerateTestBlock(nil, nil)
+		c := block.GenerateTestCertificate(hash.UndefHash)
+		m := NewSnapshotResponseMessage(ResponseCodeOK, 1, snap, b, c)
+
+		assert.Error(t, m.SanityCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		snap := snapshot.GenerateTestSnapshot(100, nil)
+		b, _ := block.GenerateTestBlock(nil, nil)
+		c := block.GenerateTestCertificate(b.Hash())
+		m := NewSnapshotResponseMessage(ResponseCodeOK, 1, snap, b, c)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.False(t, m.IsRequestRejected())
+		assert.Contains(t, m.Fingerprint(), snap.Fingerprint())
+	})
+}
+
+func TestSnapshotResponseCode(t *testing.T) {
+	t.Run("busy", func(t *testing.T) {
+		m := NewSnapshotResponseMessage(ResponseCodeBusy, 1, nil, nil, nil)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.True(t, m.IsRequestRejected())
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		m := NewSnapshotResponseMessage(ResponseCodeRejected, 1, nil, nil, nil)
+
+		assert.NoError(t, m.SanityCheck())
+		assert.True(t, m.IsRequestRejected())
+	})
+}
@@ -2,10 +2,12 @@ package cache
 
 import (
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/consensus/proposal"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 )
@@ -15,6 +17,9 @@ const (
 	certificatePrefix = 0x02
 	txPrefix          = 0x03
 	proposalPrefix    = 0x04
+	headerPrefix      = 0x05
+	compactPrefix     = 0x06
+	pendingPrefix     = 0x07
 )
 
 type key [32]byte
@@ -45,6 +50,43 @@ func proposalKey(height, round int) key {
 	return k
 }
 
+func headerKey(height int) key {
+	var k key
+	k[0] = headerPrefix
+	copy(k[1:], util.IntToSlice(height))
+	return k
+}
+
+func pendingHeaderKey(height int) key {
+	var k key
+	k[0] = pendingPrefix
+	copy(k[1:], util.IntToSlice(height))
+	return k
+}
+
+func compactProposalKey(height, round int) key {
+	var k key
+	k[0] = compactPrefix
+	copy(k[1:], util.IntToSlice(height))
+	copy(k[16:], util.IntToSlice(round))
+	return k
+}
+
+// blockEntry keeps the block with the peer that has supplied it.
+// The source is empty for the blocks that are loaded from the state.
+type blockEntry struct {
+	block  *block.Block
+	source peer.ID
+}
+
+// pendingHeader keeps the header with its certificate until the committee of its height is known.
+// The source is the peer that has supplied the header.
+type pendingHeader struct {
+	header *block.Header
+	cert   *block.Certificate
+	source peer.ID
+}
+
 type Cache struct {
 	cache *lru.ARCCache // it's thread safe
 	state state.Facade
@@ -69,30 +111,106 @@ func (c *Cache) HasBlockInCache(height int) bool {
 func (c *Cache) GetBlock(height int) *block.Block {
 	i, ok := c.cache.Get(blockKey(height))
 	if ok {
-		return i.(*block.Block)
+		return i.(*blockEntry).block
 	}
 
 	b := c.state.Block(height)
 	if b != nil {
-		c.AddBlock(height, b)
+		c.AddBlock(height, b, "")
 		return b
 	}
 
 	return nil
 }
 
-func (c *Cache) AddBlock(height int, block *block.Block) {
-	c.cache.Add(blockKey(height), block)
+// GetBlockSource returns the peer that has supplied the block at this height.
+func (c *Cache) GetBlockSource(height int) peer.ID {
+	i, ok := c.cache.Get(blockKey(height))
+	if ok {
+		return i.(*blockEntry).source
+	}
+
+	return ""
+}
+
+func (c *Cache) AddBlock(height int, block *block.Block, source peer.ID) {
+	c.cache.Add(blockKey(height), &blockEntry{block: block, source: source})
 	c.AddCertificate(block.PrevCertificate())
 }
 
-func (c *Cache) AddBlocks(height int, blocks []*block.Block) {
+func (c *Cache) AddBlocks(height int, blocks []*block.Block, source peer.ID) {
 	for _, block := range blocks {
-		c.AddBlock(height, block)
+		c.AddBlock(height, block, source)
 		height++
 	}
 }
 
+// RemoveBlock evicts the block at this height and its certificate from the cache.
+func (c *Cache) RemoveBlock(height int) {
+	i, ok := c.cache.Get(blockKey(height))
+	if ok {
+		c.cache.Remove(certificateKey(i.(*blockEntry).block.Hash()))
+		c.cache.Remove(blockKey(height))
+	}
+}
+
+// HasHeaderInCache checks if we have the verified header at this height.
+func (c *Cache) HasHeaderInCache(height int) bool {
+	_, ok := c.cache.Get(headerKey(height))
+	return ok
+}
+
+// GetHeader returns the verified header at this height.
+func (c *Cache) GetHeader(height int) *block.Header {
+	i, ok := c.cache.Get(headerKey(height))
+	if ok {
+		return i.(*block.Header)
+	}
+
+	return nil
+}
+
+// AddHeader keeps the header. Only verified headers should be added to the cache.
+func (c *Cache) AddHeader(height int, header *block.Header) {
+	c.cache.Add(headerKey(height), header)
+}
+
+// HasPendingHeaderInCache checks if we have the pending header at this height.
+func (c *Cache) HasPendingHeaderInCache(height int) bool {
+	_, ok := c.cache.Get(pendingHeaderKey(height))
+	return ok
+}
+
+// GetPendingHeader returns the header at this height and its certificate, which are not verified yet.
+func (c *Cache) GetPendingHeader(height int) (*block.Header, *block.Certificate) {
+	i, ok := c.cache.Get(pendingHeaderKey(height))
+	if ok {
+		p := i.(*pendingHeader)
+		return p.header, p.cert
+	}
+
+	return nil, nil
+}
+
+// GetPendingHeaderSource returns the peer that has supplied the pending header at this height.
+func (c *Cache) GetPendingHeaderSource(height int) peer.ID {
+	i, ok := c.cache.Get(pendingHeaderKey(height))
+	if ok {
+		return i.(*pendingHeader).source
+	}
+
+	return ""
+}
+
+// AddPendingHeader keeps the header and its certificate until they can be verified.
+func (c *Cache) AddPendingHeader(height int, header *block.Header, cert *block.Certificate, source peer.ID) {
+	c.cache.Add(pendingHeaderKey(height), &pendingHeader{header: header, cert: cert, source: source})
+}
+
+func (c *Cache) RemovePendingHeader(height int) {
+	c.cache.Remove(pendingHeaderKey(height))
+}
+
 func (c *Cache) GetCertificate(blockhash hash.Hash) *block.Certificate {
 	i, ok := c.cache.Get(certificateKey(blockhash))
 	if ok {
@@ -150,6 +268,21 @@ func (c *Cache) GetProposal(height, round int) *proposal.Proposal {
 func (c *Cache) AddProposal(p *proposal.Proposal) {
 	c.cache.Add(proposalKey(p.Height(), p.Round()), p)
 }
+
+// GetCompactProposal returns the compact proposal that is waiting for its missing transactions.
+func (c *Cache) GetCompactProposal(height, round int) *message.CompactProposalMessage {
+	i, ok := c.cache.Get(compactProposalKey(height, round))
+	if ok {
+		return i.(*message.CompactProposalMessage)
+	}
+
+	return nil
+}
+
+func (c *Cache) AddCompactProposal(msg *message.CompactProposalMessage) {
+	c.cache.Add(compactProposalKey(msg.Height, msg.Round), msg)
+}
+
 func (c *Cache) Len() int {
 	return c.cache.Len()
 }
@@ -3,12 +3,15 @@ package cache
 import (
 	"testing"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/consensus/proposal"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
 )
 
 var tCache *Cache
@@ -26,7 +29,10 @@ func TestKeys(t *testing.T) {
 	assert.Equal(t, blockKey(1234), key{0x1, 0, 0, 0, 0, 0, 0, 0x4, 0xd2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
 	assert.Equal(t, certificateKey(h), key{0x2, 0x75, 0x23, 0x84, 0x78, 0x39, 0x3b, 0xfe, 0xa9, 0xe4, 0x2a, 0x59, 0xc2, 0xcc, 0x52, 0x87, 0x6d, 0xa6, 0x63, 0xea, 0x9a, 0xcf, 0x38, 0x73, 0xd0, 0xa0, 0x96, 0xfd, 0x57, 0xd6, 0x17, 0x97})
 	assert.Equal(t, txKey(h), key{0x3, 0x75, 0x23, 0x84, 0x78, 0x39, 0x3b, 0xfe, 0xa9, 0xe4, 0x2a, 0x59, 0xc2, 0xcc, 0x52, 0x87, 0x6d, 0xa6, 0x63, 0xea, 0x9a, 0xcf, 0x38, 0x73, 0xd0, 0xa0, 0x96, 0xfd, 0x57, 0xd6, 0x17, 0x97})
+	assert.Equal(t, headerKey(1234), key{0x5, 0, 0, 0, 0, 0, 0, 0x4, 0xd2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
 	assert.Equal(t, proposalKey(1234, 3), key{0x4, 0, 0, 0, 0, 0, 0, 0x4, 0xd2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3, 0, 0, 0, 0, 0, 0, 0, 0})
+	assert.Equal(t, pendingHeaderKey(1234), key{0x7, 0, 0, 0, 0, 0, 0, 0x4, 0xd2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	assert.Equal(t, compactProposalKey(1234, 3), key{0x6, 0, 0, 0, 0, 0, 0, 0x4, 0xd2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3, 0, 0, 0, 0, 0, 0, 0, 0})
 }
 
 func TestCacheBlock(t *testing.T) {
@@ -37,9 +43,9 @@ func TestCacheBlock(t *testing.T) {
 	b3, _ := block.GenerateTestBlock(nil, nil)
 
 	tState.Store.SaveBlock(1, b1)
-	tCache.AddBlock(2, b2)
+	tCache.AddBlock(2, b2, "")
 
-	tCache.AddBlocks(2, []*block.Block{b2, b3})
+	tCache.AddBlocks(2, []*block.Block{b2, b3}, "")
 
 	assert.False(t, tCache.HasBlockInCache(1), "Block 1 is not cached")
 	assert.True(t, tCache.HasBlockInCache(2))
@@ -58,18 +64,82 @@ func TestCacheBlock(t *testing.T) {
 
 }
 
+func TestCacheBlockSource(t *testing.T) {
+	setup(t)
+
+	b1, _ := block.GenerateTestBlock(nil, nil)
+	b2, _ := block.GenerateTestBlock(nil, nil)
+	c2 := block.GenerateTestCertificate(b2.Hash())
+	pid := util.RandomPeerID()
+
+	tState.Store.SaveBlock(1, b1)
+	tCache.AddBlock(2, b2, pid)
+	tCache.AddCertificate(c2)
+
+	assert.NotNil(t, tCache.GetBlock(1))
+	assert.Equal(t, tCache.GetBlockSource(1), peer.ID(""))
+	assert.Equal(t, tCache.GetBlockSource(2), pid)
+	assert.Equal(t, tCache.GetBlockSource(3), peer.ID(""))
+
+	tCache.RemoveBlock(2)
+	assert.Nil(t, tCache.GetBlock(2))
+	assert.Nil(t, tCache.GetCertificate(b2.Hash()))
+	assert.Equal(t, tCache.GetBlockSource(2), peer.ID(""))
+}
+
 func TestCacheBlocks(t *testing.T) {
 	setup(t)
 
 	b1, _ := block.GenerateTestBlock(nil, nil)
 	b2, _ := block.GenerateTestBlock(nil, nil)
 
-	tCache.AddBlocks(1, []*block.Block{b1, b2})
+	tCache.AddBlocks(1, []*block.Block{b1, b2}, "")
 
 	assert.NotNil(t, tCache.GetCertificate(b1.Header().PrevBlockHash()).Hash())
 	assert.NotNil(t, tCache.GetCertificate(b2.Header().PrevBlockHash()).Hash())
 }
 
+func TestCacheHeader(t *testing.T) {
+	setup(t)
+
+	b1, _ := block.GenerateTestBlock(nil, nil)
+	b2, _ := block.GenerateTestBlock(nil, nil)
+
+	tState.Store.SaveBlock(1, b1)
+	tCache.AddHeader(2, b2.Header())
+
+	assert.False(t, tCache.HasHeaderInCache(1), "Committed headers are not cached")
+	assert.True(t, tCache.HasHeaderInCache(2))
+	assert.Nil(t, tCache.GetHeader(1))
+	assert.Equal(t, tCache.GetHeader(2).Hash(), b2.Hash())
+	assert.Nil(t, tCache.GetBlock(2), "Header is not a block")
+}
+
+func TestCachePendingHeader(t *testing.T) {
+	setup(t)
+
+	b, _ := block.GenerateTestBlock(nil, nil)
+	c := block.GenerateTestCertificate(b.Hash())
+
+	pid := util.RandomPeerID()
+	tCache.AddPendingHeader(2, b.Header(), c, pid)
+
+	assert.True(t, tCache.HasPendingHeaderInCache(2))
+	assert.False(t, tCache.HasHeaderInCache(2), "Pending header is not verified")
+	assert.Nil(t, tCache.GetCertificate(b.Hash()), "Pending certificate is not verified")
+	h, cert := tCache.GetPendingHeader(2)
+	assert.Equal(t, h.Hash(), b.Hash())
+	assert.Equal(t, cert.Hash(), c.Hash())
+	assert.Equal(t, tCache.GetPendingHeaderSource(2), pid)
+
+	tCache.RemovePendingHeader(2)
+	assert.False(t, tCache.HasPendingHeaderInCache(2))
+	assert.Equal(t, tCache.GetPendingHeaderSource(2), peer.ID(""))
+	h, cert = tCache.GetPendingHeader(2)
+	assert.Nil(t, h)
+	assert.Nil(t, cert)
+}
+
 func TestGetTransaction(t *testing.T) {
 	setup(t)
 
@@ -104,12 +174,25 @@ func TestCacheProposal(t *testing.T) {
 	assert.Nil(t, tCache.GetProposal(100, 1))
 }
 
+func TestCacheCompactProposal(t *testing.T) {
+	setup(t)
+
+	p, _ := proposal.GenerateTestProposal(100, 0)
+	msg := message.NewCompactProposalMessage(p)
+
+	tCache.AddCompactProposal(msg)
+
+	assert.Equal(t, tCache.GetCompactProposal(100, 0).Header.Hash(), p.Block().Hash())
+	assert.Nil(t, tCache.GetCompactProposal(100, 1))
+	assert.Nil(t, tCache.GetProposal(100, 0))
+}
+
 func TestClearCache(t *testing.T) {
 	setup(t)
 
 	b, trxs := block.GenerateTestBlock(nil, nil)
 
-	tCache.AddBlock(2, b)
+	tCache.AddBlock(2, b, "")
 	tCache.AddTransactions(trxs)
 
 	assert.Equal(t, tCache.Len(), 6) // block + certificate + 4 transactions
@@ -124,11 +207,11 @@ func TestCacheIsFull(t *testing.T) {
 	i := 0
 	for ; i < 10; i++ {
 		b, _ := block.GenerateTestBlock(nil, nil)
-		tCache.AddBlock(i+1, b)
+		tCache.AddBlock(i+1, b, "")
 	}
 
 	newBlock, _ := block.GenerateTestBlock(nil, nil)
-	tCache.AddBlock(i+1, newBlock)
+	tCache.AddBlock(i+1, newBlock, "")
 
 	assert.NotNil(t, tCache.GetBlock(i+1))
 	assert.Nil(t, tCache.GetBlock(1))
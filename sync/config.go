@@ -14,10 +14,12 @@ type Config struct {
 	HeartBeatTimeout    time.Duration    `toml:"" comment:"HeartBeatTimeout timeout for broadcasting heartbeat message to network."`
 	SessionTimeout      time.Duration    `toml:"" comment:"SessionTimeout timeout for session of node."`
 	NodeNetwork         bool             `toml:"" comment:"NodeNetwork means that the node is capable of serving the complete block chain."`
+	FastSync            bool             `toml:"" comment:"FastSync bootstraps a fresh node from a state snapshot of the network instead of downloading all the blocks. The snapshot should match the checkpoint in the state config."`
 	BlockPerMessage     int              `toml:"" comment:"BlockPerMessage the number of blocks per message. Default is 120."`
+	HeaderPerMessage    int              `toml:"" comment:"HeaderPerMessage the number of headers per message. Default is 1000."`
 	MaximumOpenSessions int              `toml:"" comment:"MaximumOpenSessions number of open session. Default is 8"`
 	CacheSize           int              `toml:"" comment:"CacheSize is the total capacity of the cache"`
-	Firewall            *firewall.Config `toml:"" comment:"Settings for firewall"`
+	Firewall            *firewall.Config `toml:"" comment:"Settings for firewall, like the ban scores and the rate limits of the peers. The firewall is opt-in, set Enabled to true to apply them."`
 }
 
 func DefaultConfig() *Config {
@@ -27,6 +29,7 @@ func DefaultConfig() *Config {
 		SessionTimeout:      time.Second * 30,
 		NodeNetwork:         true,
 		BlockPerMessage:     120,
+		HeaderPerMessage:    1000,
 		MaximumOpenSessions: 8,
 		CacheSize:           500000,
 		Firewall:            firewall.DefaultConfig(),
@@ -41,6 +44,7 @@ func TestConfig() *Config {
 		SessionTimeout:      time.Second * 1,
 		NodeNetwork:         true,
 		BlockPerMessage:     10,
+		HeaderPerMessage:    50,
 		MaximumOpenSessions: 4,
 		CacheSize:           1000,
 		Firewall:            firewall.TestConfig(),
@@ -49,5 +53,5 @@ func TestConfig() *Config {
 
 // SanityCheck is a basic checks for config
 func (conf *Config) SanityCheck() error {
-	return nil
+	return conf.Firewall.SanityCheck()
 }
@@ -0,0 +1,65 @@
+package firewall
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type bannedPeer struct {
+	PeerID      peer.ID   `json:"peer_id"`
+	BannedUntil time.Time `json:"banned_until"`
+}
+
+// loadBanList restores the peers that were banned before the restart.
+// Expired bans are ignored.
+func (f *Firewall) loadBanList() error {
+	if f.config.BanListFile == "" || !util.PathExists(f.config.BanListFile) {
+		return nil
+	}
+
+	bs, err := util.ReadFile(f.config.BanListFile)
+	if err != nil {
+		return err
+	}
+	list := []bannedPeer{}
+	if err := json.Unmarshal(bs, &list); err != nil {
+		return err
+	}
+
+	now := util.Now()
+	for _, b := range list {
+		if b.BannedUntil.After(now) {
+			f.peerSet.BanPeer(b.PeerID, b.BannedUntil)
+		}
+	}
+	return nil
+}
+
+func (f *Firewall) saveBanList() {
+	if f.config.BanListFile == "" {
+		return
+	}
+
+	now := util.Now()
+	list := []bannedPeer{}
+	for _, p := range f.peerSet.GetPeerList() {
+		if p.IsBanned() && p.BannedUntil.After(now) {
+			list = append(list, bannedPeer{
+				PeerID:      p.PeerID,
+				BannedUntil: p.BannedUntil,
+			})
+		}
+	}
+
+	bs, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		f.logger.Error("firewall: unable to encode the ban list", "err", err)
+		return
+	}
+	if err := util.WriteFile(f.config.BanListFile, bs); err != nil {
+		f.logger.Error("firewall: unable to save the ban list", "err", err)
+	}
+}
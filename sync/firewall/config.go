@@ -1,22 +1,58 @@
 package firewall
 
+import (
+	"time"
+
+	"github.com/zarbchain/zarb-go/errors"
+)
+
 type Config struct {
-	Enabled bool
+	Enabled       bool          `toml:"" comment:"Enabled turns on the firewall. It is disabled by default and peers are not banned, throttled or rate limited until it is enabled."`
+	ThrottleScore int           `toml:"" comment:"ThrottleScore requests of a peer with this score or lower are ignored."`
+	BanScore      int           `toml:"" comment:"BanScore a peer with this score or lower is banned."`
+	BanDuration   time.Duration `toml:"" comment:"BanDuration is the time that a peer stays banned."`
+	BanListFile   string        `toml:"" comment:"BanListFile keeps the banned peers across restarts. Leave it empty to not persist the ban list."`
+	MessageRate   int           `toml:"" comment:"MessageRate is the number of messages of each type that a peer can send per second. Zero means no limit."`
+	RequestRate   int           `toml:"" comment:"RequestRate is the number of requests of each type, like queries and blocks requests, that a peer can send per second. Zero means no limit."`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		Enabled: false,
+		Enabled:       false,
+		ThrottleScore: -50,
+		BanScore:      -100,
+		BanDuration:   24 * time.Hour,
+		BanListFile:   "ban_list.json",
+		MessageRate:   100,
+		RequestRate:   10,
 	}
 }
 
 func TestConfig() *Config {
 	return &Config{
-		Enabled: false,
+		Enabled:       false,
+		ThrottleScore: -50,
+		BanScore:      -100,
+		BanDuration:   time.Minute,
+		BanListFile:   "",
+		MessageRate:   100,
+		RequestRate:   10,
 	}
 }
 
 // SanityCheck is a basic checks for config
 func (conf *Config) SanityCheck() error {
+	if conf.ThrottleScore > 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "throttle score can't be positive")
+	}
+	if conf.BanScore >= conf.ThrottleScore {
+		return errors.Errorf(errors.ErrInvalidConfig, "ban score should be less than throttle score")
+	}
+	if conf.MessageRate < 0 || conf.RequestRate < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "rate limits can't be negative")
+	}
+	if conf.BanDuration <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "ban duration should be positive")
+	}
 	return nil
 }
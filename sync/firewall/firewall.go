@@ -3,6 +3,8 @@ package firewall
 import (
 	"bytes"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/errors"
@@ -10,27 +12,42 @@ import (
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/sync/peerset"
 	"github.com/zarbchain/zarb-go/util"
 )
 
+// A bucket that is not used for this duration is full again, so it can be dropped.
+const bucketIdleTimeout = 5 * time.Second
+
 // Firewall check packets before passing them to sync module
 type Firewall struct {
-	config  *Config
-	network network.Network
-	peerSet *peerset.PeerSet
-	state   state.Facade
-	logger  *logger.Logger
+	lk sync.Mutex
+
+	config    *Config
+	network   network.Network
+	peerSet   *peerset.PeerSet
+	state     state.Facade
+	buckets   map[peer.ID]map[message.Type]*tokenBucket
+	lastSweep time.Time
+	logger    *logger.Logger
 }
 
 func NewFirewall(conf *Config, net network.Network, peerSet *peerset.PeerSet, state state.Facade, logger *logger.Logger) *Firewall {
-	return &Firewall{
+	f := &Firewall{
 		config:  conf,
 		network: net,
 		peerSet: peerSet,
 		state:   state,
+		buckets: make(map[peer.ID]map[message.Type]*tokenBucket),
 		logger:  logger,
 	}
+
+	if err := f.loadBanList(); err != nil {
+		logger.Warn("firewall: unable to load the ban list", "err", err)
+	}
+
+	return f
 }
 
 func (f *Firewall) OpenGossipBundle(data []byte, source peer.ID, from peer.ID) *bundle.Bundle {
@@ -53,6 +70,10 @@ func (f *Firewall) OpenGossipBundle(data []byte, source peer.ID, from peer.ID) *
 	// TODO: check if gossip flag is set
 	// TODO: check if bundle is a gossip bundle
 
+	if f.isRateLimited(bdl) || f.isRequestThrottled(bdl) {
+		return nil
+	}
+
 	return bdl
 }
 
@@ -67,6 +88,10 @@ func (f *Firewall) OpenStreamBundle(r io.Reader, from peer.ID) *bundle.Bundle {
 	// TODO: check if gossip flag is NOT set
 	// TODO: check if bundle is a stream bundle
 
+	if f.isRateLimited(bdl) || f.isRequestThrottled(bdl) {
+		return nil
+	}
+
 	return bdl
 }
 
@@ -83,11 +108,13 @@ func (f *Firewall) openBundle(r io.Reader, source peer.ID) (*bundle.Bundle, erro
 	bdl, err := f.decodeBundle(r, source)
 	if err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(source)
+		f.ReportBehaviour(source, peerset.BehaviourInvalidBundle)
 		return nil, err
 	}
 
 	if err := f.checkBundle(bdl, source); err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(source)
+		f.ReportBehaviour(source, peerset.BehaviourInvalidBundle)
 		return nil, err
 	}
 
@@ -118,15 +145,148 @@ func (f *Firewall) checkBundle(bdl *bundle.Bundle, pid peer.ID) error {
 	return nil
 }
 
+// ReportBehaviour updates the score of the peer based on the outcome of handling its message.
+// If the score drops to the ban score, the peer is banned.
+func (f *Firewall) ReportBehaviour(pid peer.ID, b peerset.Behaviour) {
+	score := f.peerSet.UpdateScore(pid, b)
+	if b != peerset.BehaviourGood {
+		f.logger.Debug("firewall: peer misbehaved", "pid", util.FingerprintPeerID(pid), "behaviour", b, "score", score)
+	}
+
+	if f.config.Enabled && score <= f.config.BanScore {
+		f.banPeer(pid)
+	}
+}
+
+// IsPeerThrottled checks if the score of the peer has dropped to the throttle score.
+// Requests of a throttled peer are ignored.
+func (f *Firewall) IsPeerThrottled(pid peer.ID) bool {
+	if !f.config.Enabled {
+		return false
+	}
+
+	p := f.peerSet.GetPeer(pid)
+	return p.Score <= f.config.ThrottleScore
+}
+
+func (f *Firewall) isRequestThrottled(bdl *bundle.Bundle) bool {
+	if isRequest(bdl.Message.Type()) && f.IsPeerThrottled(bdl.Initiator) {
+		f.logger.Debug("firewall: peer is throttled, request ignored", "pid", util.FingerprintPeerID(bdl.Initiator), "bundle", bdl)
+		return true
+	}
+	return false
+}
+
+// isRateLimited checks if the initiator has sent more bundles of this type than its budget.
+// Each peer has a separate budget for each message type.
+func (f *Firewall) isRateLimited(bdl *bundle.Bundle) bool {
+	if !f.config.Enabled {
+		return false
+	}
+
+	msgType := bdl.Message.Type()
+	rate := f.config.MessageRate
+	if isRequest(msgType) {
+		rate = f.config.RequestRate
+	}
+	if rate == 0 {
+		return false
+	}
+
+	f.lk.Lock()
+	now := util.Now()
+	if now.Sub(f.lastSweep) >= bucketIdleTimeout {
+		f.sweepIdleBuckets(now)
+	}
+	buckets, ok := f.buckets[bdl.Initiator]
+	if !ok {
+		buckets = make(map[message.Type]*tokenBucket)
+		f.buckets[bdl.Initiator] = buckets
+	}
+	b, ok := buckets[msgType]
+	if !ok {
+		b = newTokenBucket(rate, now)
+		buckets[msgType] = b
+	}
+	allowed := b.take(now)
+	f.lk.Unlock()
+
+	if !allowed {
+		f.logger.Debug("firewall: rate limit exceeded, bundle dropped", "pid", util.FingerprintPeerID(bdl.Initiator), "bundle", bdl)
+		f.peerSet.IncreaseDroppedBundlesCounter(bdl.Initiator)
+		f.ReportBehaviour(bdl.Initiator, peerset.BehaviourFlooding)
+		return true
+	}
+	return false
+}
+
+// sweepIdleBuckets drops the buckets of the peers that have sent nothing for a while,
+// like the disconnected peers. It should be called while the lock is held.
+func (f *Firewall) sweepIdleBuckets(now time.Time) {
+	for pid, buckets := range f.buckets {
+		for msgType, b := range buckets {
+			if now.Sub(b.last) >= bucketIdleTimeout {
+				delete(buckets, msgType)
+			}
+		}
+		if len(buckets) == 0 {
+			delete(f.buckets, pid)
+		}
+	}
+	f.lastSweep = now
+}
+
+func isRequest(t message.Type) bool {
+	switch t {
+	case message.MessageTypeQueryTransactions,
+		message.MessageTypeQueryProposal,
+		message.MessageTypeQueryProposalTxs,
+		message.MessageTypeQueryVotes,
+		message.MessageTypeBlocksRequest,
+		message.MessageTypeHeadersRequest,
+		message.MessageTypeSnapshotRequest:
+		return true
+	}
+	return false
+}
+
 func (f *Firewall) isPeerBanned(pid peer.ID) bool {
 	if !f.config.Enabled {
 		return false
 	}
 
 	p := f.peerSet.GetPeer(pid)
+	if p.IsBanExpired(util.Now()) {
+		f.unbanPeer(pid)
+		return false
+	}
+	if !p.IsBanned() && p.Score <= f.config.BanScore {
+		// The score might be dropped because of timeouts
+		f.banPeer(pid)
+		return true
+	}
+
 	return p.IsBanned()
 }
 
+func (f *Firewall) banPeer(pid peer.ID) {
+	f.logger.Info("firewall: peer banned", "pid", util.FingerprintPeerID(pid), "duration", f.config.BanDuration)
+	f.peerSet.BanPeer(pid, util.Now().Add(f.config.BanDuration))
+	f.closeConnection(pid)
+
+	f.lk.Lock()
+	delete(f.buckets, pid)
+	f.lk.Unlock()
+
+	f.saveBanList()
+}
+
+func (f *Firewall) unbanPeer(pid peer.ID) {
+	f.logger.Info("firewall: ban expired", "pid", util.FingerprintPeerID(pid))
+	f.peerSet.UnbanPeer(pid)
+	f.saveBanList()
+}
+
 func (f *Firewall) closeConnection(pid peer.ID) {
 	if !f.config.Enabled {
 		return
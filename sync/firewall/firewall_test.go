@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
@@ -156,3 +157,160 @@ func TestUpdateLastSeen(t *testing.T) {
 	assert.GreaterOrEqual(t, tFirewall.peerSet.GetPeer(tUnknownPeerID).LastSeen.UnixNano(), now)
 	assert.GreaterOrEqual(t, tFirewall.peerSet.GetPeer(tGoodPeerID).LastSeen.UnixNano(), now)
 }
+
+func TestBanByScore(t *testing.T) {
+	setup(t)
+
+	tFirewall.ReportBehaviour(tUnknownPeerID, peerset.BehaviourInvalidBlock)
+	assert.Equal(t, tFirewall.peerSet.GetPeer(tUnknownPeerID).Status, peerset.StatusCodeUnknown)
+	assert.False(t, tNetwork.IsClosed(tUnknownPeerID))
+
+	tFirewall.ReportBehaviour(tUnknownPeerID, peerset.BehaviourInvalidBlock)
+	p := tFirewall.peerSet.GetPeer(tUnknownPeerID)
+	assert.True(t, p.IsBanned())
+	assert.Equal(t, p.Score, -100)
+	assert.True(t, p.BannedUntil.After(util.Now()))
+	assert.True(t, tNetwork.IsClosed(tUnknownPeerID))
+
+	msg := bundle.NewBundle(tUnknownPeerID, message.NewQueryProposalMessage(100, 1))
+	d, _ := msg.Encode()
+	assert.Nil(t, tFirewall.OpenGossipBundle(d, tUnknownPeerID, tUnknownPeerID))
+}
+
+func TestBanByTimeout(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < 10; i++ {
+		tFirewall.peerSet.UpdateScore(tUnknownPeerID, peerset.BehaviourTimeout)
+	}
+	assert.Equal(t, tFirewall.peerSet.GetPeer(tUnknownPeerID).Status, peerset.StatusCodeUnknown)
+
+	msg := bundle.NewBundle(tUnknownPeerID, message.NewQueryProposalMessage(100, 1))
+	d, _ := msg.Encode()
+	assert.Nil(t, tFirewall.OpenGossipBundle(d, tUnknownPeerID, tUnknownPeerID))
+	assert.Equal(t, tFirewall.peerSet.GetPeer(tUnknownPeerID).Status, peerset.StatusCodeBanned)
+}
+
+func TestBanExpiry(t *testing.T) {
+	setup(t)
+
+	tFirewall.peerSet.UpdateScore(tUnknownPeerID, peerset.BehaviourInvalidBlock)
+	tFirewall.peerSet.BanPeer(tUnknownPeerID, util.Now().Add(-time.Second))
+
+	msg := bundle.NewBundle(tUnknownPeerID, message.NewQueryProposalMessage(100, 1))
+	d, _ := msg.Encode()
+	assert.NotNil(t, tFirewall.OpenGossipBundle(d, tUnknownPeerID, tUnknownPeerID))
+
+	p := tFirewall.peerSet.GetPeer(tUnknownPeerID)
+	assert.False(t, p.IsBanned())
+	assert.Equal(t, p.Score, 0)
+}
+
+func TestThrottle(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < 5; i++ {
+		tFirewall.ReportBehaviour(tUnknownPeerID, peerset.BehaviourInvalidBundle)
+	}
+	assert.True(t, tFirewall.IsPeerThrottled(tUnknownPeerID))
+	assert.False(t, tFirewall.IsPeerThrottled(tGoodPeerID))
+
+	t.Run("Requests of a throttled peer are ignored", func(t *testing.T) {
+		msg := bundle.NewBundle(tUnknownPeerID, message.NewBlocksRequestMessage(util.RandInt(0), 1, 100))
+		d, _ := msg.Encode()
+
+		assert.Nil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d), tUnknownPeerID))
+		assert.False(t, tNetwork.IsClosed(tUnknownPeerID))
+	})
+
+	t.Run("Other messages of a throttled peer are accepted", func(t *testing.T) {
+		msg := bundle.NewBundle(tUnknownPeerID, message.NewHeartBeatMessage(100, 1, hash.GenerateTestHash()))
+		d, _ := msg.Encode()
+
+		assert.NotNil(t, tFirewall.OpenGossipBundle(d, tUnknownPeerID, tUnknownPeerID))
+	})
+
+	t.Run("Good behaviour recovers the score", func(t *testing.T) {
+		tFirewall.ReportBehaviour(tUnknownPeerID, peerset.BehaviourGood)
+		assert.False(t, tFirewall.IsPeerThrottled(tUnknownPeerID))
+	})
+}
+
+func TestMaximumScore(t *testing.T) {
+	setup(t)
+
+	for i := 0; i < peerset.MaximumScore+10; i++ {
+		tFirewall.ReportBehaviour(tGoodPeerID, peerset.BehaviourGood)
+	}
+	assert.Equal(t, tFirewall.peerSet.GetPeer(tGoodPeerID).Score, peerset.MaximumScore)
+}
+
+func TestBanListPersistence(t *testing.T) {
+	setup(t)
+
+	tFirewall.config.BanListFile = util.TempFilePath()
+	tFirewall.ReportBehaviour(tUnknownPeerID, peerset.BehaviourInvalidBlock)
+	tFirewall.ReportBehaviour(tUnknownPeerID, peerset.BehaviourInvalidBlock)
+	until := tFirewall.peerSet.GetPeer(tUnknownPeerID).BannedUntil
+
+	peerSet := peerset.NewPeerSet(3 * time.Second)
+	f := NewFirewall(tFirewall.config, tNetwork, peerSet, tFirewall.state, tFirewall.logger)
+	p := f.peerSet.GetPeer(tUnknownPeerID)
+	assert.True(t, p.IsBanned())
+	assert.True(t, p.BannedUntil.Equal(until))
+	assert.Equal(t, f.peerSet.GetPeer(tBadPeerID).Status, peerset.StatusCodeUnknown)
+}
+
+func TestRateLimit(t *testing.T) {
+	setup(t)
+
+	tFirewall.config.RequestRate = 2
+	msg := bundle.NewBundle(tGoodPeerID, message.NewBlocksRequestMessage(util.RandInt(0), 1, 100))
+	d, _ := msg.Encode()
+
+	assert.NotNil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d), tGoodPeerID))
+	assert.NotNil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d), tGoodPeerID))
+	assert.Nil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d), tGoodPeerID))
+
+	p := tFirewall.peerSet.GetPeer(tGoodPeerID)
+	assert.Equal(t, p.DroppedBundles, 1)
+	assert.Equal(t, p.Score, peerset.BehaviourFlooding.Score())
+	assert.False(t, tNetwork.IsClosed(tGoodPeerID))
+
+	t.Run("Other message types have a separate budget", func(t *testing.T) {
+		msg := bundle.NewBundle(tGoodPeerID, message.NewQueryProposalMessage(100, 1))
+		d, _ := msg.Encode()
+
+		assert.NotNil(t, tFirewall.OpenGossipBundle(d, tGoodPeerID, tGoodPeerID))
+	})
+
+	t.Run("Other peers have a separate budget", func(t *testing.T) {
+		msg := bundle.NewBundle(tUnknownPeerID, message.NewBlocksRequestMessage(util.RandInt(0), 1, 100))
+		d, _ := msg.Encode()
+
+		assert.NotNil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d), tUnknownPeerID))
+	})
+}
+
+func TestSweepIdleBuckets(t *testing.T) {
+	setup(t)
+
+	tFirewall.config.RequestRate = 2
+	msg1 := bundle.NewBundle(tGoodPeerID, message.NewBlocksRequestMessage(util.RandInt(0), 1, 100))
+	d1, _ := msg1.Encode()
+	msg2 := bundle.NewBundle(tUnknownPeerID, message.NewBlocksRequestMessage(util.RandInt(0), 1, 100))
+	d2, _ := msg2.Encode()
+
+	assert.NotNil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d1), tGoodPeerID))
+	assert.Len(t, tFirewall.buckets, 1)
+
+	// The good peer is gone for a while
+	idle := util.Now().Add(-bucketIdleTimeout)
+	tFirewall.buckets[tGoodPeerID][message.MessageTypeBlocksRequest].last = idle
+	tFirewall.lastSweep = idle
+
+	assert.NotNil(t, tFirewall.OpenStreamBundle(bytes.NewReader(d2), tUnknownPeerID))
+	assert.Len(t, tFirewall.buckets, 1)
+	assert.Contains(t, tFirewall.buckets, tUnknownPeerID)
+	assert.NotContains(t, tFirewall.buckets, tGoodPeerID)
+}
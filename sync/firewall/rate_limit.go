@@ -0,0 +1,37 @@
+package firewall
+
+import (
+	"time"
+)
+
+// tokenBucket allows a number of events per second.
+// Unused tokens are saved up to one second worth of events, so short bursts are allowed.
+type tokenBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   now,
+	}
+}
+
+// take removes one token from the bucket.
+// It returns false if the bucket is empty.
+func (b *tokenBucket) take(now time.Time) bool {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -21,7 +21,7 @@ func (handler *blockAnnounceHandler) ParsMessage(m message.Message, initiator pe
 	handler.logger.Trace("parsing BlockAnnounce message", "msg", msg)
 
 	handler.cache.AddCertificate(msg.Certificate)
-	handler.cache.AddBlock(msg.Height, msg.Block)
+	handler.cache.AddBlock(msg.Height, msg.Block, initiator)
 	handler.tryCommitBlocks()
 	handler.synced()
 
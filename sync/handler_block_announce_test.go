@@ -35,6 +35,40 @@ func TestParsingBlockAnnounceMessages(t *testing.T) {
 
 }
 
+func TestAnnouncingInvalidBlock(t *testing.T) {
+	setup(t)
+
+	lastBlockHash := tState.LastBlockHash()
+	lastBlockheight := tState.LastBlockHeight()
+	b1, _ := block.GenerateTestBlock(nil, &lastBlockHash)
+	c1 := block.GenerateTestCertificate(b1.Hash())
+	tState.InvalidBlockHash = b1.Hash()
+
+	pidSource := util.RandomPeerID()
+	pidOther := util.RandomPeerID()
+	pub1, _ := bls.GenerateTestKeyPair()
+	pub2, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub1, pidSource)
+	testAddPeer(t, pub2, pidOther)
+
+	scoreSource := tSync.peerSet.GetPeer(pidSource).Score
+	scoreOther := tSync.peerSet.GetPeer(pidOther).Score
+
+	// Another peer announces a block at the next height,
+	// but the block at this height, supplied by the source peer, is invalid
+	b2, _ := block.GenerateTestBlock(nil, &lastBlockHash)
+	c2 := block.GenerateTestCertificate(b2.Hash())
+	tSync.cache.AddCertificate(c1)
+	tSync.cache.AddBlock(lastBlockheight+1, b1, pidSource)
+	msg := message.NewBlockAnnounceMessage(lastBlockheight+2, b2, c2)
+	assert.NoError(t, testReceiveingNewMessage(tSync, msg, pidOther))
+
+	assert.Equal(t, tState.LastBlockHeight(), lastBlockheight)
+	assert.Less(t, tSync.peerSet.GetPeer(pidSource).Score, scoreSource)
+	assert.Equal(t, tSync.peerSet.GetPeer(pidOther).Score, scoreOther)
+	assert.False(t, tSync.cache.HasBlockInCache(lastBlockheight+1))
+}
+
 func TestBroadcastingBlockAnnounceMessages(t *testing.T) {
 	setup(t)
 
@@ -23,7 +23,7 @@ func TestSessionTimeout(t *testing.T) {
 
 		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
 
-		shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+		shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeadersRequest)
 
 		assert.True(t, tSync.peerSet.HasAnyOpenSession())
 		time.Sleep(2 * tConfig.SessionTimeout)
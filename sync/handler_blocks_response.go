@@ -4,6 +4,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/sync/bundle"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/sync/peerset"
 )
 
 type blocksResponseHandler struct {
@@ -23,8 +24,18 @@ func (handler *blocksResponseHandler) ParsMessage(m message.Message, initiator p
 	if msg.IsRequestRejected() {
 		handler.logger.Warn("blocks request is rejected", "pid", initiator, "response", msg.ResponseCode)
 	} else {
+		if msg.ResponseCode == message.ResponseCodeMoreBlocks && len(msg.Blocks) == 0 {
+			handler.logger.Debug("peer responded with no blocks", "pid", initiator)
+			handler.firewall.ReportBehaviour(initiator, peerset.BehaviourUselessResponse)
+		}
+		if err := handler.checkBlocksWithHeaders(msg.From, msg.Blocks); err != nil {
+			handler.logger.Warn("peer sent blocks that don't match the headers", "pid", initiator, "err", err)
+			handler.updateSession(msg.SessionID, initiator, message.ResponseCodeRejected)
+
+			return err
+		}
 		handler.cache.AddCertificate(msg.LastCertificate)
-		handler.cache.AddBlocks(msg.From, msg.Blocks)
+		handler.cache.AddBlocks(msg.From, msg.Blocks, initiator)
 		handler.cache.AddTransactions(msg.Transactions)
 		handler.tryCommitBlocks()
 	}
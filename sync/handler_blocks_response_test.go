@@ -9,6 +9,7 @@ import (
 	"github.com/zarbchain/zarb-go/committee"
 	"github.com/zarbchain/zarb-go/consensus"
 	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
@@ -44,6 +45,16 @@ func TestOneBlockShorter(t *testing.T) {
 		assert.Nil(t, tSync.peerSet.FindSession(sid))
 	})
 
+	t.Run("Peer responded with no blocks. Peer score should decrease", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		score := tSync.peerSet.GetPeer(pid).Score
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, sid, lastBlockheight+1, nil, nil, nil)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Less(t, tSync.peerSet.GetPeer(pid).Score, score)
+		tSync.peerSet.CloseSession(sid)
+	})
+
 	t.Run("Commit one block", func(t *testing.T) {
 		sid := tSync.peerSet.OpenSession(pid).SessionID()
 		msg := message.NewBlocksResponseMessage(message.ResponseCodeSynced, sid, lastBlockheight+1, []*block.Block{b1}, trxs, c1)
@@ -85,6 +96,7 @@ func TestSyncing(t *testing.T) {
 		consensusAlice,
 		networkAlice,
 		broadcastChAlice,
+		event.NewBus(),
 	)
 	assert.NoError(t, err)
 	syncAlice := sync1.(*synchronizer)
@@ -95,6 +107,7 @@ func TestSyncing(t *testing.T) {
 		consensusBob,
 		networkBob,
 		broadcastChBob,
+		event.NewBus(),
 	)
 	assert.NoError(t, err)
 	syncBob := sync2.(*synchronizer)
@@ -122,6 +135,12 @@ func TestSyncing(t *testing.T) {
 	assert.Len(t, syncAlice.Peers(), 1)
 	assert.Len(t, syncBob.Peers(), 1)
 
+	// Headers first
+	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeHeadersRequest)
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHeadersResponse) // 1-50
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHeadersResponse) // 51-100
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHeadersResponse) // NoMoreBlock
+
 	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeBlocksRequest)
 	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeBlocksResponse) // 1-10
 	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeBlocksResponse) // 11-20
@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+)
+
+type compactProposalHandler struct {
+	*synchronizer
+}
+
+func newCompactProposalHandler(sync *synchronizer) messageHandler {
+	return &compactProposalHandler{
+		sync,
+	}
+}
+
+func (handler *compactProposalHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.CompactProposalMessage)
+	handler.logger.Trace("parsing CompactProposal message", "msg", msg)
+
+	height, _ := handler.consensus.HeightRound()
+	if msg.Height != height {
+		handler.logger.Debug("compact proposal is not for the current height", "msg", msg, "height", height)
+		return nil
+	}
+	// Don't let other peers overwrite the proposal of this round
+	proposer := handler.state.Proposer(msg.Round)
+	if err := msg.Verify(proposer.PublicKey()); err != nil {
+		return err
+	}
+
+	missed := handler.reconstructProposal(msg)
+	if len(missed) > 0 {
+		// Keep it until we receive the missing transactions
+		handler.cache.AddCompactProposal(msg)
+
+		query := message.NewQueryProposalTxsMessage(msg.Height, msg.Round, missed)
+		handler.broadcast(query)
+	}
+
+	return nil
+}
+
+func (handler *compactProposalHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(handler.SelfID(), m)
+}
@@ -0,0 +1,114 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/consensus/proposal"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// generateTestProposalWithTxs generates a proposal that is signed by the proposer of the round
+func generateTestProposalWithTxs(t *testing.T, height, round int) (*proposal.Proposal, []*tx.Tx) {
+	proposer := tState.Proposer(round).Address()
+	var signer crypto.Signer
+	for _, s := range append(tSigners, tSync.signer) {
+		if s.Address().EqualsTo(proposer) {
+			signer = s
+		}
+	}
+	require.NotNil(t, signer)
+
+	b, trxs := block.GenerateTestBlock(&proposer, nil)
+	p := proposal.NewProposal(height, round, b)
+	signer.SignMsg(p)
+	return p, trxs
+}
+
+func TestParsingCompactProposalMessages(t *testing.T) {
+	setup(t)
+	testAddPeerToCommittee(t, tSync.SelfID(), tSync.signer.PublicKey())
+
+	consensusHeight := tState.LastBlockHeight() + 1
+	pid := util.RandomPeerID()
+
+	t.Run("Not the current height. Should be ignored", func(t *testing.T) {
+		prop, _ := generateTestProposalWithTxs(t, consensusHeight+1, 0)
+		msg := message.NewCompactProposalMessage(prop)
+
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+		assert.Nil(t, tSync.cache.GetCompactProposal(consensusHeight+1, 0))
+		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposalTxs)
+	})
+
+	t.Run("Not signed by the proposer. Should be rejected", func(t *testing.T) {
+		proposer := tState.Proposer(0).Address()
+		b, _ := block.GenerateTestBlock(&proposer, nil)
+		prop := proposal.NewProposal(consensusHeight, 0, b)
+		bls.GenerateTestSigner().SignMsg(prop)
+		msg := message.NewCompactProposalMessage(prop)
+
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+		assert.Nil(t, tSync.cache.GetCompactProposal(consensusHeight, 0))
+		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposalTxs)
+	})
+
+	t.Run("All transactions are inside the pool. Proposal should be reconstructed", func(t *testing.T) {
+		prop, trxs := generateTestProposalWithTxs(t, consensusHeight, 0)
+		for _, trx := range trxs {
+			assert.NoError(t, tState.AddPendingTx(trx))
+		}
+		msg := message.NewCompactProposalMessage(prop)
+
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+		assert.Equal(t, tConsensus.RoundProposal(0).Hash(), prop.Hash())
+		assert.Equal(t, tSync.cache.GetProposal(consensusHeight, 0).Hash(), prop.Hash())
+		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposalTxs)
+	})
+
+	t.Run("Some transactions are missing. Should query only the missing transactions", func(t *testing.T) {
+		prop, trxs := generateTestProposalWithTxs(t, consensusHeight, 1)
+		for _, trx := range trxs[1:] {
+			assert.NoError(t, tState.AddPendingTx(trx))
+		}
+		msg := message.NewCompactProposalMessage(prop)
+		tConsensus.Round = 1
+
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+		assert.Nil(t, tConsensus.RoundProposal(1))
+		assert.NotNil(t, tSync.cache.GetCompactProposal(consensusHeight, 1))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposalTxs)
+		assert.Equal(t, bdl.Message.(*message.QueryProposalTxsMessage).Indexes, []int{0})
+
+		// Receiving the missing transaction
+		msg2 := message.NewTransactionsMessage(trxs[:1])
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg2, pid))
+		assert.Equal(t, tConsensus.RoundProposal(1).Hash(), prop.Hash())
+	})
+
+	t.Run("Transactions don't match the block. Should query the full proposal", func(t *testing.T) {
+		prop, _ := generateTestProposalWithTxs(t, consensusHeight, 2)
+		_, trxs := generateTestProposalWithTxs(t, consensusHeight, 2)
+		for _, trx := range trxs {
+			assert.NoError(t, tState.AddPendingTx(trx))
+		}
+		msg := message.NewCompactProposalMessage(prop)
+		for i, trx := range trxs {
+			msg.ShortIDs[i] = trx.ID().ShortID(msg.Header.Hash())
+		}
+		tConsensus.Round = 2
+
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+		assert.Nil(t, tConsensus.RoundProposal(2))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposal)
+		assert.Equal(t, bdl.Message.(*message.QueryProposalMessage).Round, 2)
+	})
+}
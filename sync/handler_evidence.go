@@ -0,0 +1,37 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+)
+
+type evidenceHandler struct {
+	*synchronizer
+}
+
+func newEvidenceHandler(sync *synchronizer) messageHandler {
+	return &evidenceHandler{
+		sync,
+	}
+}
+
+func (handler *evidenceHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.EvidenceMessage)
+	handler.logger.Trace("parsing Evidence message", "msg", msg)
+
+	if err := handler.state.AddEvidence(msg.Evidence); err != nil {
+		// The peer should not relay the evidences that are not valid
+		if errors.Code(err) == errors.ErrInvalidEvidence {
+			return errors.Errorf(errors.ErrInvalidMessage, err.Error())
+		}
+		handler.logger.Debug("unable to add the evidence", "evidence", msg.Evidence, "err", err)
+	}
+
+	return nil
+}
+
+func (handler *evidenceHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(handler.SelfID(), m)
+}
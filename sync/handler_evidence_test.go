@@ -0,0 +1,41 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestParsingEvidenceMessages(t *testing.T) {
+	setup(t)
+
+	t.Run("Parsing evidence message", func(t *testing.T) {
+		ev, _ := evidence.GenerateTestEvidence(1, 0)
+		msg := message.NewEvidenceMessage(ev)
+
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, util.RandomPeerID()))
+		assert.Equal(t, tState.Evidences[0].Hash(), ev.Hash())
+	})
+
+	t.Run("Parsing invalid evidence message", func(t *testing.T) {
+		ev, _ := evidence.GenerateTestEvidence(1, 0)
+		invalidEvidence := evidence.NewEvidence(ev.VoteA(), ev.VoteA())
+		msg := message.NewEvidenceMessage(invalidEvidence)
+
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, util.RandomPeerID()))
+		assert.Equal(t, len(tState.Evidences), 1)
+	})
+}
+
+func TestBroadcastingEvidenceMessages(t *testing.T) {
+	setup(t)
+
+	ev, _ := evidence.GenerateTestEvidence(1, 0)
+	msg := message.NewEvidenceMessage(ev)
+	tSync.broadcast(msg)
+
+	shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeEvidence)
+}
@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+type headersRequestHandler struct {
+	*synchronizer
+}
+
+func newHeadersRequestHandler(sync *synchronizer) messageHandler {
+	return &headersRequestHandler{
+		sync,
+	}
+}
+
+func (handler *headersRequestHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.HeadersRequestMessage)
+	handler.logger.Trace("parsing HeadersRequest message", "msg", msg)
+
+	if handler.peerSet.NumberOfOpenSessions() > handler.config.MaximumOpenSessions {
+		handler.logger.Warn("we are busy", "msg", msg, "pid", initiator)
+		response := message.NewHeadersResponseMessage(message.ResponseCodeBusy, msg.SessionID, 0, nil, nil)
+		handler.sendTo(response, initiator)
+
+		return nil
+	}
+
+	peer := handler.peerSet.GetPeer(initiator)
+	if !peer.IsKnownOrTrusty() {
+		response := message.NewHeadersResponseMessage(message.ResponseCodeRejected, msg.SessionID, 0, nil, nil)
+		handler.sendTo(response, initiator)
+
+		return errors.Errorf(errors.ErrInvalidMessage, "Peer status is %v", peer.Status)
+	}
+
+	if !handler.config.NodeNetwork {
+		ourHeight := handler.state.LastBlockHeight()
+		if msg.From < ourHeight-LatestBlockInterval {
+			response := message.NewHeadersResponseMessage(message.ResponseCodeRejected, msg.SessionID, 0, nil, nil)
+			handler.sendTo(response, initiator)
+
+			return errors.Errorf(errors.ErrInvalidMessage, "the request height is not acceptable: %v", msg.From)
+		}
+	}
+	height := msg.From
+
+	for height <= msg.To {
+		count := util.Min(handler.config.HeaderPerMessage, msg.To-height+1)
+		headers, certs := handler.prepareHeaders(height, count)
+		if len(headers) == 0 {
+			break
+		}
+
+		response := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, msg.SessionID, height, headers, certs)
+		handler.sendTo(response, initiator)
+
+		height += len(headers)
+	}
+
+	response := message.NewHeadersResponseMessage(message.ResponseCodeNoMoreBlocks, msg.SessionID, 0, nil, nil)
+	handler.sendTo(response, initiator)
+
+	return nil
+}
+
+func (handler *headersRequestHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(handler.SelfID(), m)
+}
@@ -0,0 +1,54 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestHeadersRequestMessages(t *testing.T) {
+	tConfig.NodeNetwork = true
+	setup(t)
+
+	sid := util.RandInt(100)
+	pid := util.RandomPeerID()
+
+	t.Run("Reject request from unknown peers", func(t *testing.T) {
+		msg := message.NewHeadersRequestMessage(sid, 1, 10)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeadersResponse)
+		assert.Equal(t, bdl.Message.(*message.HeadersResponseMessage).ResponseCode, message.ResponseCodeRejected)
+	})
+
+	pub, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub, pid)
+
+	t.Run("Send the headers with their certificates", func(t *testing.T) {
+		ourHeight := tState.LastBlockHeight()
+		msg := message.NewHeadersRequestMessage(sid, 1, ourHeight)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeadersResponse)
+		res := bdl.Message.(*message.HeadersResponseMessage)
+		assert.Equal(t, res.ResponseCode, message.ResponseCodeMoreBlocks)
+		assert.Equal(t, res.From, 1)
+		assert.Equal(t, res.To(), ourHeight)
+		assert.Equal(t, res.Headers[0].Hash(), tState.Block(1).Hash())
+		assert.Equal(t, res.Certificates[ourHeight-1].Hash(), tState.LastCertificate().Hash())
+
+		bdl = shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeadersResponse)
+		assert.Equal(t, bdl.Message.(*message.HeadersResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
+	})
+
+	t.Run("Peer doesn't have requested headers", func(t *testing.T) {
+		msg := message.NewHeadersRequestMessage(sid, 100, 105)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeadersResponse)
+		assert.Equal(t, bdl.Message.(*message.HeadersResponseMessage).ResponseCode, message.ResponseCodeNoMoreBlocks)
+	})
+}
@@ -0,0 +1,43 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+)
+
+type headersResponseHandler struct {
+	*synchronizer
+}
+
+func newHeadersResponseHandler(sync *synchronizer) messageHandler {
+	return &headersResponseHandler{
+		sync,
+	}
+}
+
+func (handler *headersResponseHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.HeadersResponseMessage)
+	handler.logger.Trace("parsing HeadersResponse message", "msg", msg)
+
+	if msg.IsRequestRejected() {
+		handler.logger.Warn("headers request is rejected", "pid", initiator, "response", msg.ResponseCode)
+	} else {
+		if err := handler.verifyHeaders(msg.From, msg.Headers, msg.Certificates, initiator); err != nil {
+			handler.logger.Warn("peer sent invalid headers", "pid", initiator, "err", err)
+			handler.updateSession(msg.SessionID, initiator, message.ResponseCodeRejected)
+
+			return err
+		}
+	}
+	handler.updateSession(msg.SessionID, initiator, msg.ResponseCode)
+
+	return nil
+}
+
+func (handler *headersResponseHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	msg := bundle.NewBundle(handler.SelfID(), m)
+	msg.CompressIt()
+
+	return msg
+}
@@ -0,0 +1,189 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// generateTestHeaders generates a chain of blocks on top of our last block,
+// with the transactions, headers and certificates of the blocks.
+func generateTestHeaders(count int) ([]*block.Block, []*tx.Tx, []*block.Header, []*block.Certificate) {
+	lastBlockHash := tState.LastBlockHash()
+	blocks := make([]*block.Block, 0, count)
+	trxs := make([]*tx.Tx, 0)
+	for i := 0; i < count; i++ {
+		b, bTrxs := block.GenerateTestBlock(nil, &lastBlockHash)
+		blocks = append(blocks, b)
+		trxs = append(trxs, bTrxs...)
+		lastBlockHash = b.Hash()
+	}
+
+	headers := make([]*block.Header, 0, count)
+	certs := make([]*block.Certificate, 0, count)
+	for i, b := range blocks {
+		headers = append(headers, b.Header())
+		if i+1 < count {
+			certs = append(certs, blocks[i+1].PrevCertificate())
+		} else {
+			certs = append(certs, block.GenerateTestCertificate(b.Hash()))
+		}
+	}
+	return blocks, trxs, headers, certs
+}
+
+func TestHeadersResponseMessages(t *testing.T) {
+	setup(t)
+
+	pid := util.RandomPeerID()
+	pub, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub, pid)
+
+	ourHeight := tState.LastBlockHeight()
+	blocks, trxs, headers, certs := generateTestHeaders(5)
+
+	t.Run("Headers are not chained to our blocks. Session should be closed", func(t *testing.T) {
+		_, _, invHeaders, invCerts := generateTestHeaders(2)
+		invBlock, _ := block.GenerateTestBlock(nil, nil)
+		invHeaders[0] = invBlock.Header()
+		invCerts[0] = block.GenerateTestCertificate(invBlock.Hash())
+
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, invHeaders, invCerts)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+		assert.False(t, tSync.cache.HasHeaderInCache(ourHeight+1))
+	})
+
+	t.Run("Headers are not continuous. Session should be closed", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+2, headers[1:], certs[1:])
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+	})
+
+	t.Run("Certificate is not for the header. Session should be closed", func(t *testing.T) {
+		invCerts := append([]*block.Certificate{}, certs...)
+		invCerts[0] = certs[1]
+
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, headers, invCerts)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+		assert.False(t, tSync.cache.HasHeaderInCache(ourHeight+1))
+	})
+
+	t.Run("Valid headers should be kept in the cache", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, headers, certs)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.NotNil(t, tSync.peerSet.FindSession(sid))
+		// Only the committee of the next height is known
+		assert.Equal(t, tSync.cache.GetHeader(ourHeight+1).Hash(), headers[0].Hash())
+		assert.NotNil(t, tSync.cache.GetCertificate(headers[0].Hash()))
+		for i, h := range headers[1:] {
+			assert.False(t, tSync.cache.HasHeaderInCache(ourHeight+2+i))
+			assert.True(t, tSync.cache.HasPendingHeaderInCache(ourHeight+2+i))
+			assert.Nil(t, tSync.cache.GetCertificate(h.Hash()))
+		}
+		assert.Equal(t, tSync.verifiedHeight(), ourHeight+1)
+		assert.Equal(t, tSync.headersHeight(), ourHeight+5)
+		tSync.peerSet.CloseSession(sid)
+	})
+
+	t.Run("Blocks that don't match the verified headers should be rejected", func(t *testing.T) {
+		invBlock, invTrxs := block.GenerateTestBlock(nil, nil)
+
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, []*block.Block{invBlock}, invTrxs, nil)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+		assert.False(t, tSync.cache.HasBlockInCache(ourHeight+1))
+	})
+
+	t.Run("Blocks that match the verified headers should be committed", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, blocks, trxs, nil)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Equal(t, tState.LastBlockHeight(), ourHeight+5)
+		// Pending headers are verified when the blocks are committed
+		for i := range headers {
+			assert.False(t, tSync.cache.HasPendingHeaderInCache(ourHeight+1+i))
+		}
+	})
+}
+
+func TestInvalidPendingHeader(t *testing.T) {
+	setup(t)
+
+	pid := util.RandomPeerID()
+	pub, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub, pid)
+
+	pid2 := util.RandomPeerID()
+	pub2, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub2, pid2)
+
+	ourHeight := tState.LastBlockHeight()
+	blocks, trxs, headers, certs := generateTestHeaders(3)
+	tState.InvalidBlockHash = headers[1].Hash()
+
+	sid := tSync.peerSet.OpenSession(pid).SessionID()
+	msg1 := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, headers, certs)
+	assert.NoError(t, testReceiveingNewMessage(tSync, msg1, pid))
+	assert.Equal(t, tSync.headersHeight(), ourHeight+3)
+	assert.Equal(t, tSync.cache.GetPendingHeaderSource(ourHeight+2), pid)
+
+	score := tSync.peerSet.GetPeer(pid).Score
+	sid2 := tSync.peerSet.OpenSession(pid2).SessionID()
+	msg2 := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, sid2, ourHeight+1, blocks[:1], trxs, nil)
+	assert.NoError(t, testReceiveingNewMessage(tSync, msg2, pid2))
+
+	// The pending headers are dropped and should be requested again.
+	// The peer that has sent them is penalized.
+	assert.Less(t, tSync.peerSet.GetPeer(pid).Score, score)
+	assert.Equal(t, tState.LastBlockHeight(), ourHeight+1)
+	assert.False(t, tSync.cache.HasHeaderInCache(ourHeight+2))
+	assert.False(t, tSync.cache.HasPendingHeaderInCache(ourHeight+2))
+	assert.False(t, tSync.cache.HasPendingHeaderInCache(ourHeight+3))
+	assert.Equal(t, tSync.headersHeight(), ourHeight+1)
+}
+
+func TestBlockNotMatchingPendingHeader(t *testing.T) {
+	setup(t)
+
+	pid := util.RandomPeerID()
+	pub, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub, pid)
+
+	ourHeight := tState.LastBlockHeight()
+	blocks, trxs, headers, certs := generateTestHeaders(2)
+
+	sid := tSync.peerSet.OpenSession(pid).SessionID()
+	msg1 := message.NewHeadersResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1, headers, certs)
+	assert.NoError(t, testReceiveingNewMessage(tSync, msg1, pid))
+
+	// The second block is chained to the first one, but it doesn't match the pending header
+	prevHash := blocks[0].Hash()
+	invBlock, invTrxs := block.GenerateTestBlock(nil, &prevHash)
+	score := tSync.peerSet.GetPeer(pid).Score
+	msg2 := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks, sid, ourHeight+1,
+		[]*block.Block{blocks[0], invBlock}, append(trxs, invTrxs...), block.GenerateTestCertificate(invBlock.Hash()))
+	assert.NoError(t, testReceiveingNewMessage(tSync, msg2, pid))
+
+	assert.Equal(t, tState.LastBlockHeight(), ourHeight+1)
+	assert.True(t, tSync.cache.HasHeaderInCache(ourHeight+2))
+	assert.False(t, tSync.cache.HasBlockInCache(ourHeight+2))
+	assert.Less(t, tSync.peerSet.GetPeer(pid).Score, score)
+}
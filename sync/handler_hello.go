@@ -3,6 +3,7 @@ package sync
 import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/sync/bundle"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/sync/peerset"
@@ -35,6 +36,8 @@ func (handler *helloHandler) ParsMessage(m message.Message, initiator peer.ID) e
 			handler.state.GenesisHash(), msg.GenesisHash)
 	}
 
+	p := handler.peerSet.GetPeer(initiator)
+	known := p.IsKnownOrTrusty()
 	handler.peerSet.UpdatePeerInfo(initiator,
 		peerset.StatusCodeKnown,
 		msg.Moniker,
@@ -43,6 +46,14 @@ func (handler *helloHandler) ParsMessage(m message.Message, initiator peer.ID) e
 		util.IsFlagSet(msg.Flags, message.FlagNodeNetwork))
 	handler.peerSet.UpdateHeight(initiator, msg.Height)
 
+	if !known {
+		handler.eventBus.Publish(&event.PeerConnectedEvent{
+			PeerID:  initiator,
+			Moniker: msg.Moniker,
+			Height:  msg.Height,
+		})
+	}
+
 	if util.IsFlagSet(msg.Flags, message.FlagNeedResponse) {
 		// TODO: Sends response only if there is a direct connection between two peers.
 		// TODO: check if we have handshaked before. Ignore responding again
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/sync/bundle"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/sync/peerset"
@@ -48,8 +49,22 @@ func TestParsingHelloMessages(t *testing.T) {
 		msg := message.NewHelloMessage(pid, "kitty", height, message.FlagNeedResponse|message.FlagNodeNetwork, tState.GenHash)
 		signer.SignMsg(msg)
 
+		sub := tEventBus.Subscribe(event.TypePeerConnected)
+		defer sub.Unsubscribe()
+
 		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
 
+		e := <-sub.Events()
+		assert.Equal(t, e.(*event.PeerConnectedEvent).PeerID, pid)
+		assert.Equal(t, e.(*event.PeerConnectedEvent).Moniker, "kitty")
+		assert.Equal(t, e.(*event.PeerConnectedEvent).Height, height)
+
+		// Receiving Hello-ack from the same peer, should not publish any event
+		ack := message.NewHelloMessage(pid, "kitty", height, message.FlagNodeNetwork, tState.GenHash)
+		signer.SignMsg(ack)
+		assert.NoError(t, testReceiveingNewMessage(tSync, ack, pid))
+		assert.Empty(t, sub.Events())
+
 		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeHello)
 		assert.False(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Flags, message.FlagNeedResponse))
 
@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/tx"
+)
+
+type queryProposalTxsHandler struct {
+	*synchronizer
+}
+
+func newQueryProposalTxsHandler(sync *synchronizer) messageHandler {
+	return &queryProposalTxsHandler{
+		sync,
+	}
+}
+
+func (handler *queryProposalTxsHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.QueryProposalTxsMessage)
+	handler.logger.Trace("parsing QueryProposalTxs message", "msg", msg)
+
+	if !handler.peerIsInTheCommittee(initiator) {
+		return errors.Errorf(errors.ErrInvalidMessage, "peers is not in the commmittee")
+	}
+
+	p := handler.cache.GetProposal(msg.Height, msg.Round)
+	if p == nil {
+		height, _ := handler.consensus.HeightRound()
+		if msg.Height == height {
+			p = handler.consensus.RoundProposal(msg.Round)
+		}
+	}
+	if p == nil {
+		handler.logger.Debug("we don't have the proposal", "msg", msg)
+		return nil
+	}
+
+	ids := p.Block().TxIDs().IDs()
+	queried := make([]tx.ID, 0, len(msg.Indexes))
+	for _, i := range msg.Indexes {
+		if i >= len(ids) {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid index: %v", i)
+		}
+		queried = append(queried, ids[i])
+	}
+
+	trxs := handler.prepareTransactions(queried)
+	if len(trxs) > 0 {
+		response := message.NewTransactionsMessage(trxs)
+		handler.broadcast(response)
+	}
+
+	return nil
+}
+
+func (handler *queryProposalTxsHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	if !handler.weAreInTheCommittee() {
+		handler.logger.Debug("sending QueryProposalTxs ignored. We are not in the committee")
+		return nil
+	}
+
+	return bundle.NewBundle(handler.SelfID(), m)
+}
@@ -0,0 +1,70 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestParsingQueryProposalTxsMessages(t *testing.T) {
+	setup(t)
+
+	consensusHeight := tState.LastBlockHeight() + 1
+	prop, trxs := generateTestProposalWithTxs(t, consensusHeight, 0)
+	tSync.cache.AddProposal(prop)
+	tSync.cache.AddTransactions(trxs)
+	pid := util.RandomPeerID()
+	msg := message.NewQueryProposalTxsMessage(consensusHeight, 0, []int{0, 2})
+
+	t.Run("Not in the committee, should not respond to the query message", func(t *testing.T) {
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+	})
+
+	testAddPeerToCommittee(t, pid, nil)
+
+	t.Run("In the committee, should respond with the queried transactions", func(t *testing.T) {
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeTransactions)
+		res := bdl.Message.(*message.TransactionsMessage)
+		assert.Len(t, res.Transactions, 2)
+		assert.Equal(t, res.Transactions[0].ID(), trxs[0].ID())
+		assert.Equal(t, res.Transactions[1].ID(), trxs[2].ID())
+	})
+
+	t.Run("Invalid index", func(t *testing.T) {
+		msg := message.NewQueryProposalTxsMessage(consensusHeight, 0, []int{len(trxs)})
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeTransactions)
+	})
+
+	t.Run("We don't have the proposal", func(t *testing.T) {
+		msg := message.NewQueryProposalTxsMessage(consensusHeight, 1, []int{0})
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeTransactions)
+	})
+}
+
+func TestBroadcastingQueryProposalTxsMessages(t *testing.T) {
+	setup(t)
+
+	msg := message.NewQueryProposalTxsMessage(tState.LastBlockHeight()+1, 0, []int{0})
+
+	t.Run("Not in the committee, should not send the query message", func(t *testing.T) {
+		tSync.broadcast(msg)
+
+		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposalTxs)
+	})
+
+	testAddPeerToCommittee(t, tSync.SelfID(), tSync.signer.PublicKey())
+
+	t.Run("In the committee, should send the query message", func(t *testing.T) {
+		tSync.broadcast(msg)
+
+		shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeQueryProposalTxs)
+	})
+}
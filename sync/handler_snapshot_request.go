@@ -0,0 +1,84 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+)
+
+type snapshotRequestHandler struct {
+	*synchronizer
+}
+
+func newSnapshotRequestHandler(sync *synchronizer) messageHandler {
+	return &snapshotRequestHandler{
+		sync,
+	}
+}
+
+func (handler *snapshotRequestHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.SnapshotRequestMessage)
+	handler.logger.Trace("parsing SnapshotRequest message", "msg", msg)
+
+	if handler.peerSet.NumberOfOpenSessions() > handler.config.MaximumOpenSessions {
+		handler.logger.Warn("we are busy", "msg", msg, "pid", initiator)
+		response := message.NewSnapshotResponseMessage(message.ResponseCodeBusy, msg.SessionID, nil, nil, nil)
+		handler.sendTo(response, initiator)
+
+		return nil
+	}
+
+	peer := handler.peerSet.GetPeer(initiator)
+	if !peer.IsKnownOrTrusty() {
+		response := message.NewSnapshotResponseMessage(message.ResponseCodeRejected, msg.SessionID, nil, nil, nil)
+		handler.sendTo(response, initiator)
+
+		return errors.Errorf(errors.ErrInvalidMessage, "Peer status is %v", peer.Status)
+	}
+
+	snap := handler.state.LastSnapshot()
+	if snap == nil {
+		handler.logger.Debug("we have no snapshot", "pid", initiator)
+		response := message.NewSnapshotResponseMessage(message.ResponseCodeRejected, msg.SessionID, nil, nil, nil)
+		handler.sendTo(response, initiator)
+
+		return nil
+	}
+
+	// The next block and its certificate are needed to verify the snapshot
+	nextBlock, nextCert := handler.prepareNextBlock(snap.BlockHeight())
+	if nextBlock == nil || nextCert == nil {
+		handler.logger.Debug("we have no block after the snapshot", "snapshot", snap)
+		response := message.NewSnapshotResponseMessage(message.ResponseCodeRejected, msg.SessionID, nil, nil, nil)
+		handler.sendTo(response, initiator)
+
+		return nil
+	}
+
+	response := message.NewSnapshotResponseMessage(message.ResponseCodeOK, msg.SessionID, snap, nextBlock, nextCert)
+	handler.sendTo(response, initiator)
+
+	return nil
+}
+
+// prepareNextBlock returns the block after the given height and its certificate.
+func (handler *snapshotRequestHandler) prepareNextBlock(height int) (*block.Block, *block.Certificate) {
+	nextBlock := handler.state.Block(height + 1)
+	if nextBlock == nil {
+		return nil, nil
+	}
+	if height+1 == handler.state.LastBlockHeight() {
+		return nextBlock, handler.state.LastCertificate()
+	}
+	b := handler.state.Block(height + 2)
+	if b == nil {
+		return nil, nil
+	}
+	return nextBlock, b.PrevCertificate()
+}
+
+func (handler *snapshotRequestHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(handler.SelfID(), m)
+}
@@ -0,0 +1,77 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestSnapshotRequestMessages(t *testing.T) {
+	setup(t)
+
+	sid := util.RandInt(100)
+	pid := util.RandomPeerID()
+
+	t.Run("Reject request from unknown peers", func(t *testing.T) {
+		msg := message.NewSnapshotRequestMessage(sid)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeSnapshotResponse)
+		assert.Equal(t, bdl.Message.(*message.SnapshotResponseMessage).ResponseCode, message.ResponseCodeRejected)
+	})
+
+	pub, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub, pid)
+
+	t.Run("We don't have any snapshot", func(t *testing.T) {
+		msg := message.NewSnapshotRequestMessage(sid)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeSnapshotResponse)
+		assert.Equal(t, bdl.Message.(*message.SnapshotResponseMessage).ResponseCode, message.ResponseCodeRejected)
+	})
+
+	t.Run("There is no block after the snapshot", func(t *testing.T) {
+		tState.Snapshot = snapshot.GenerateTestSnapshot(tState.LastBlockHeight(), tState.Block(tState.LastBlockHeight()).Header())
+
+		msg := message.NewSnapshotRequestMessage(sid)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeSnapshotResponse)
+		assert.Equal(t, bdl.Message.(*message.SnapshotResponseMessage).ResponseCode, message.ResponseCodeRejected)
+	})
+
+	t.Run("Send the snapshot with the next block", func(t *testing.T) {
+		blockHash := tState.Block(10).Hash()
+		tState.Snapshot = snapshot.GenerateTestSnapshot(10, tState.Block(10).Header())
+
+		msg := message.NewSnapshotRequestMessage(sid)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeSnapshotResponse)
+		res := bdl.Message.(*message.SnapshotResponseMessage)
+		assert.Equal(t, res.ResponseCode, message.ResponseCodeOK)
+		assert.Equal(t, res.SessionID, sid)
+		assert.Equal(t, res.Snapshot.BlockHash(), blockHash)
+		assert.Equal(t, res.NextBlock.Hash(), tState.Block(11).Hash())
+		assert.Equal(t, res.NextCertificate.Hash(), tState.Block(12).PrevCertificate().Hash())
+	})
+
+	t.Run("Send the snapshot with the last block", func(t *testing.T) {
+		height := tState.LastBlockHeight() - 1
+		tState.Snapshot = snapshot.GenerateTestSnapshot(height, tState.Block(height).Header())
+
+		msg := message.NewSnapshotRequestMessage(sid)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeSnapshotResponse)
+		res := bdl.Message.(*message.SnapshotResponseMessage)
+		assert.Equal(t, res.ResponseCode, message.ResponseCodeOK)
+		assert.Equal(t, res.NextBlock.Hash(), tState.LastBlockHash())
+		assert.Equal(t, res.NextCertificate.Hash(), tState.LastCertificate().Hash())
+	})
+}
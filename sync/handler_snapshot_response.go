@@ -0,0 +1,49 @@
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/sync/bundle"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+)
+
+type snapshotResponseHandler struct {
+	*synchronizer
+}
+
+func newSnapshotResponseHandler(sync *synchronizer) messageHandler {
+	return &snapshotResponseHandler{
+		sync,
+	}
+}
+
+func (handler *snapshotResponseHandler) ParsMessage(m message.Message, initiator peer.ID) error {
+	msg := m.(*message.SnapshotResponseMessage)
+	handler.logger.Trace("parsing SnapshotResponse message", "msg", msg)
+
+	if msg.IsRequestRejected() {
+		handler.logger.Warn("snapshot request is rejected", "pid", initiator, "response", msg.ResponseCode)
+	} else {
+		// Only the snapshot that we have requested from this peer is imported
+		s := handler.peerSet.FindSession(msg.SessionID)
+		if s == nil || s.PeerID() != initiator || !handler.snapshotRequested || !handler.config.FastSync {
+			return errors.Errorf(errors.ErrInvalidMessage, "we didn't request this snapshot")
+		}
+		err := handler.state.ImportSnapshot(msg.Snapshot, msg.NextBlock, msg.NextCertificate)
+		if err != nil {
+			handler.updateSession(msg.SessionID, initiator, message.ResponseCodeRejected)
+			return err
+		}
+		handler.logger.Info("snapshot imported", "snapshot", msg.Snapshot)
+	}
+	handler.updateSession(msg.SessionID, initiator, msg.ResponseCode)
+
+	return nil
+}
+
+func (handler *snapshotResponseHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	msg := bundle.NewBundle(handler.SelfID(), m)
+	msg.CompressIt()
+
+	return msg
+}
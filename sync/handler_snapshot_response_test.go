@@ -0,0 +1,158 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/consensus"
+	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/event"
+	"github.com/zarbchain/zarb-go/network"
+	"github.com/zarbchain/zarb-go/snapshot"
+	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/sync/bundle/message"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestSnapshotResponseMessages(t *testing.T) {
+	setup(t)
+
+	pid := util.RandomPeerID()
+	pub, _ := bls.GenerateTestKeyPair()
+	testAddPeer(t, pub, pid)
+
+	snap := snapshot.GenerateTestSnapshot(100, nil)
+	snapBlockHash := snap.BlockHash()
+	b, _ := block.GenerateTestBlock(nil, &snapBlockHash)
+	c := block.GenerateTestCertificate(b.Hash())
+
+	t.Run("Snapshot is not requested, should not be imported", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewSnapshotResponseMessage(message.ResponseCodeOK, sid, snap, b, c)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.NotNil(t, tSync.peerSet.FindSession(sid))
+		assert.Nil(t, tState.Snapshot)
+	})
+
+	tSync.config.FastSync = true
+	tSync.snapshotRequested = true
+
+	t.Run("Session belongs to another peer, should not be imported", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(util.RandomPeerID()).SessionID()
+		msg := message.NewSnapshotResponseMessage(message.ResponseCodeOK, sid, snap, b, c)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.NotNil(t, tSync.peerSet.FindSession(sid))
+		assert.Nil(t, tState.Snapshot)
+	})
+
+	t.Run("Unknown session, should not be imported", func(t *testing.T) {
+		msg := message.NewSnapshotResponseMessage(message.ResponseCodeOK, -1, snap, b, c)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tState.Snapshot)
+	})
+
+	t.Run("Request is rejected. Session should be closed", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewSnapshotResponseMessage(message.ResponseCodeRejected, sid, nil, nil, nil)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+		assert.Nil(t, tState.Snapshot)
+	})
+
+	t.Run("Invalid next block. Session should be closed", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		invBlock, _ := block.GenerateTestBlock(nil, nil)
+		msg := message.NewSnapshotResponseMessage(message.ResponseCodeOK, sid, snap, invBlock, c)
+		assert.Error(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+		assert.Nil(t, tState.Snapshot)
+	})
+
+	t.Run("Import snapshot", func(t *testing.T) {
+		sid := tSync.peerSet.OpenSession(pid).SessionID()
+		msg := message.NewSnapshotResponseMessage(message.ResponseCodeOK, sid, snap, b, c)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, pid))
+
+		assert.Nil(t, tSync.peerSet.FindSession(sid))
+		assert.Equal(t, tState.Snapshot, snap)
+	})
+}
+
+// TestFastSyncing tests the fast syncing process between a fresh node (Alice) and a node that has a snapshot (Bob).
+func TestFastSyncing(t *testing.T) {
+	configAlice := TestConfig()
+	configBob := TestConfig()
+	signerAlice := bls.GenerateTestSigner()
+	signerBob := bls.GenerateTestSigner()
+	committeeAlice, _ := committee.GenerateTestCommittee()
+	committeeBob, _ := committee.GenerateTestCommittee()
+	stateAlice := state.MockingState(committeeAlice)
+	stateBob := state.MockingState(committeeBob)
+	consensusAlice := consensus.MockingConsensus(stateAlice)
+	consensusBob := consensus.MockingConsensus(stateBob)
+	broadcastChAlice := make(chan message.Message, 1000)
+	broadcastChBob := make(chan message.Message, 1000)
+	networkAlice := network.MockingNetwork(util.RandomPeerID())
+	networkBob := network.MockingNetwork(util.RandomPeerID())
+
+	LatestBlockInterval = 30
+	configAlice.FastSync = true
+	configBob.NodeNetwork = true
+	networkAlice.AddAnotherNetwork(networkBob)
+	networkBob.AddAnotherNetwork(networkAlice)
+	stateBob.GenHash = stateAlice.GenHash
+	testAddBlocks(t, stateBob, 100)
+	snapBlockHash := stateBob.Block(60).Hash()
+	stateBob.Snapshot = snapshot.GenerateTestSnapshot(60, stateBob.Block(60).Header())
+
+	sync1, err := NewSynchronizer(configAlice,
+		signerAlice,
+		stateAlice,
+		consensusAlice,
+		networkAlice,
+		broadcastChAlice,
+		event.NewBus(),
+	)
+	assert.NoError(t, err)
+	syncAlice := sync1.(*synchronizer)
+
+	sync2, err := NewSynchronizer(configBob,
+		signerBob,
+		stateBob,
+		consensusBob,
+		networkBob,
+		broadcastChBob,
+		event.NewBus(),
+	)
+	assert.NoError(t, err)
+	syncBob := sync2.(*synchronizer)
+
+	assert.NoError(t, syncAlice.Start())
+	assert.NoError(t, syncBob.Start())
+
+	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeHello)
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHello)
+
+	// Hello-ack
+	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeHello)
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHello)
+
+	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeSnapshotRequest)
+	bdl := shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeSnapshotResponse)
+	assert.Equal(t, bdl.Message.(*message.SnapshotResponseMessage).ResponseCode, message.ResponseCodeOK)
+
+	// After importing the snapshot, Alice continues downloading the headers and blocks
+	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeHeadersRequest)
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHeadersResponse) // 1-50
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHeadersResponse) // 51-100
+	shouldPublishMessageWithThisType(t, networkBob, message.MessageTypeHeadersResponse) // NoMoreBlock
+	shouldPublishMessageWithThisType(t, networkAlice, message.MessageTypeBlocksRequest)
+	assert.Equal(t, stateAlice.LastSnapshot().BlockHash(), snapBlockHash)
+}
@@ -30,6 +30,13 @@ func (handler *transactionsHandler) ParsMessage(m message.Message, initiator pee
 		}
 	}
 
+	// Try again to reconstruct the compact proposal, if it was waiting for these transactions
+	height, round := handler.consensus.HeightRound()
+	compact := handler.cache.GetCompactProposal(height, round)
+	if compact != nil && handler.consensus.RoundProposal(round) == nil {
+		handler.reconstructProposal(compact)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,54 @@
+package peerset
+
+// MaximumScore is the highest score a peer can earn by behaving well
+const MaximumScore = 100
+
+// Behaviour is the outcome of handling a message from a peer.
+// Each behaviour changes the score of the peer.
+type Behaviour int
+
+const (
+	BehaviourGood            = Behaviour(0)
+	BehaviourInvalidBundle   = Behaviour(1)
+	BehaviourInvalidBlock    = Behaviour(2)
+	BehaviourUselessResponse = Behaviour(3)
+	BehaviourTimeout         = Behaviour(4)
+	BehaviourFlooding        = Behaviour(5)
+)
+
+// Score returns how much this behaviour changes the score of a peer
+func (b Behaviour) Score() int {
+	switch b {
+	case BehaviourGood:
+		return 1
+	case BehaviourInvalidBundle:
+		return -10
+	case BehaviourInvalidBlock:
+		return -50
+	case BehaviourUselessResponse:
+		return -5
+	case BehaviourTimeout:
+		return -10
+	case BehaviourFlooding:
+		return -2
+	}
+	return 0
+}
+
+func (b Behaviour) String() string {
+	switch b {
+	case BehaviourGood:
+		return "good"
+	case BehaviourInvalidBundle:
+		return "invalid-bundle"
+	case BehaviourInvalidBlock:
+		return "invalid-block"
+	case BehaviourUselessResponse:
+		return "useless-response"
+	case BehaviourTimeout:
+		return "timeout"
+	case BehaviourFlooding:
+		return "flooding"
+	}
+	return "invalid"
+}
@@ -28,6 +28,9 @@ type Peer struct {
 	ReceivedBundles int
 	InvalidBundles  int
 	ReceivedBytes   int
+	DroppedBundles  int
+	Score           int
+	BannedUntil     time.Time
 }
 
 func NewPeer(peerID peer.ID) *Peer {
@@ -51,6 +54,11 @@ func (p *Peer) IsBanned() bool {
 	return p.Status == StatusCodeBanned
 }
 
+// IsBanExpired checks if the peer was banned for a limited time and the time is over
+func (p *Peer) IsBanExpired(now time.Time) bool {
+	return p.IsBanned() && !p.BannedUntil.IsZero() && now.After(p.BannedUntil)
+}
+
 func (p *Peer) Address() crypto.Address {
 	return p.PublicKey.Address()
 }
@@ -66,3 +74,7 @@ func (p *Peer) SetNodeNetworkFlag(nodeNetwork bool) {
 func (p *Peer) IsNodeNetwork() bool {
 	return util.IsFlagSet(p.Flags, PeerFlagNodeNetwork)
 }
+
+func (p *Peer) updateScore(b Behaviour) {
+	p.Score = util.Min(p.Score+b.Score(), MaximumScore)
+}
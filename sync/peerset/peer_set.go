@@ -10,7 +10,6 @@ import (
 )
 
 // TODO:
-// - Add tests for peerset
 // - Implementing garbage collection for peerset
 
 type PeerSet struct {
@@ -89,6 +88,9 @@ func (ps *PeerSet) removeExpiredSessions() {
 	// First remove old sessions
 	for id, s := range ps.sessions {
 		if ps.sessionTimeout < util.Now().Sub(s.LastActivityAt()) {
+			if p := ps.getPeer(s.PeerID()); p != nil {
+				p.updateScore(BehaviourTimeout)
+			}
 			delete(ps.sessions, id)
 		}
 	}
@@ -239,6 +241,14 @@ func (ps *PeerSet) IncreaseInvalidBundlesCounter(pid peer.ID) {
 	p.InvalidBundles++
 }
 
+func (ps *PeerSet) IncreaseDroppedBundlesCounter(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.DroppedBundles++
+}
+
 func (ps *PeerSet) IncreaseReceivedBytesCounter(pid peer.ID, c int) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -246,3 +256,34 @@ func (ps *PeerSet) IncreaseReceivedBytesCounter(pid peer.ID, c int) {
 	p := ps.mustGetPeer(pid)
 	p.ReceivedBytes += c
 }
+
+// UpdateScore changes the score of the peer based on its behaviour and returns the new score
+func (ps *PeerSet) UpdateScore(pid peer.ID, b Behaviour) int {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.updateScore(b)
+	return p.Score
+}
+
+// BanPeer bans the peer until the given time
+func (ps *PeerSet) BanPeer(pid peer.ID, until time.Time) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Status = StatusCodeBanned
+	p.BannedUntil = until
+}
+
+// UnbanPeer lifts the ban of the peer and resets its score
+func (ps *PeerSet) UnbanPeer(pid peer.ID) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.mustGetPeer(pid)
+	p.Status = StatusCodeUnknown
+	p.BannedUntil = time.Time{}
+	p.Score = 0
+}
@@ -0,0 +1,80 @@
+package peerset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestUpdateScore(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	pid := util.RandomPeerID()
+
+	assert.Equal(t, ps.UpdateScore(pid, BehaviourInvalidBundle), -10)
+	assert.Equal(t, ps.UpdateScore(pid, BehaviourTimeout), -20)
+	assert.Equal(t, ps.UpdateScore(pid, BehaviourInvalidBlock), -70)
+	assert.Equal(t, ps.UpdateScore(pid, BehaviourFlooding), -72)
+	assert.Equal(t, ps.UpdateScore(pid, BehaviourUselessResponse), -77)
+	assert.Equal(t, ps.GetPeer(pid).Score, -77)
+
+	t.Run("Good behaviour should slowly recover the score", func(t *testing.T) {
+		for i := 0; i < 77; i++ {
+			ps.UpdateScore(pid, BehaviourGood)
+		}
+		assert.Equal(t, ps.GetPeer(pid).Score, 0)
+	})
+
+	t.Run("Score should not exceed the maximum score", func(t *testing.T) {
+		for i := 0; i < 2*MaximumScore; i++ {
+			ps.UpdateScore(pid, BehaviourGood)
+		}
+		assert.Equal(t, ps.GetPeer(pid).Score, MaximumScore)
+		assert.Equal(t, ps.UpdateScore(pid, BehaviourInvalidBlock), MaximumScore-50)
+	})
+}
+
+func TestBanExpiry(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	pid := util.RandomPeerID()
+	now := util.Now()
+
+	ps.UpdateScore(pid, BehaviourInvalidBlock)
+	ps.BanPeer(pid, now.Add(time.Hour))
+	p := ps.GetPeer(pid)
+	assert.True(t, p.IsBanned())
+	assert.False(t, p.IsBanExpired(now))
+	assert.False(t, p.IsBanExpired(now.Add(time.Hour)))
+	assert.True(t, p.IsBanExpired(now.Add(time.Hour+time.Second)))
+
+	ps.UnbanPeer(pid)
+	p = ps.GetPeer(pid)
+	assert.False(t, p.IsBanned())
+	assert.False(t, p.IsBanExpired(now.Add(time.Hour+time.Second)))
+	assert.Zero(t, p.Score)
+	assert.True(t, p.BannedUntil.IsZero())
+
+	t.Run("Ban without end time should not expire", func(t *testing.T) {
+		ps.BanPeer(pid, time.Time{})
+		p := ps.GetPeer(pid)
+		assert.True(t, p.IsBanned())
+		assert.False(t, p.IsBanExpired(now.Add(24*time.Hour)))
+	})
+}
+
+func TestDroppedBundlesCounter(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+	pid1 := util.RandomPeerID()
+	pid2 := util.RandomPeerID()
+
+	ps.IncreaseDroppedBundlesCounter(pid1)
+	ps.IncreaseDroppedBundlesCounter(pid1)
+	ps.IncreaseDroppedBundlesCounter(pid2)
+	ps.IncreaseReceivedBundlesCounter(pid1)
+
+	assert.Equal(t, ps.GetPeer(pid1).DroppedBundles, 2)
+	assert.Equal(t, ps.GetPeer(pid1).ReceivedBundles, 1)
+	assert.Equal(t, ps.GetPeer(pid2).DroppedBundles, 1)
+	assert.Zero(t, ps.GetPeer(pid2).InvalidBundles)
+}
@@ -0,0 +1,265 @@
+package scheduler
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// Chunk is a range of blocks that is downloaded from one peer in one session.
+type Chunk struct {
+	From       int
+	To         int
+	SessionID  int
+	PeerID     peer.ID
+	FailedPeer peer.ID
+	Assigned   bool
+	Finished   bool
+	Headers    bool
+}
+
+// Scheduler splits the missing blocks into chunks and keeps track of them
+// while they are downloading from different peers.
+// Chunks are kept in order, so the blocks can be committed in order.
+// It also keeps track of the headers, which are downloaded ahead of the blocks.
+type Scheduler struct {
+	lk sync.Mutex
+
+	chunkSize  int
+	maxChunks  int
+	chunks     []*Chunk
+	headers    *Chunk
+	nextHeight int
+}
+
+// NewScheduler creates a new scheduler.
+// No more than maxChunks chunks are scheduled at the same time,
+// so the downloaded blocks can't grow unlimited in the cache.
+func NewScheduler(chunkSize, maxChunks int) *Scheduler {
+	return &Scheduler{
+		chunkSize: chunkSize,
+		maxChunks: maxChunks,
+		chunks:    make([]*Chunk, 0),
+	}
+}
+
+// Window returns the number of blocks that can be scheduled at the same time.
+func (s *Scheduler) Window() int {
+	return s.chunkSize * s.maxChunks
+}
+
+// Schedule adds new chunks to download the blocks from `from` up to `to`.
+func (s *Scheduler) Schedule(from, to int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.nextHeight < from {
+		s.nextHeight = from
+	}
+
+	for s.nextHeight <= to && len(s.chunks) < s.maxChunks {
+		c := &Chunk{
+			From: s.nextHeight,
+			To:   s.nextHeight + s.chunkSize - 1,
+		}
+		if c.To > to {
+			c.To = to
+		}
+		s.chunks = append(s.chunks, c)
+		s.nextHeight = c.To + 1
+	}
+}
+
+// Prune removes the chunks that are committed up to this height.
+func (s *Scheduler) Prune(height int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	i := 0
+	for ; i < len(s.chunks); i++ {
+		if s.chunks[i].To > height {
+			break
+		}
+	}
+	s.chunks = s.chunks[i:]
+}
+
+// PendingChunks returns the chunks that are not assigned to any peer, in order.
+func (s *Scheduler) PendingChunks() []Chunk {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	l := make([]Chunk, 0)
+	for _, c := range s.chunks {
+		if !c.Assigned && !c.Finished {
+			l = append(l, *c)
+		}
+	}
+	return l
+}
+
+// AssignedChunks returns the chunks that are downloading right now.
+func (s *Scheduler) AssignedChunks() []Chunk {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	l := make([]Chunk, 0)
+	for _, c := range s.chunks {
+		if c.Assigned {
+			l = append(l, *c)
+		}
+	}
+	return l
+}
+
+// Assign assigns the chunk that starts from this height to the peer.
+// It returns false if the chunk is not pending anymore.
+func (s *Scheduler) Assign(from int, sessionID int, pid peer.ID) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	for _, c := range s.chunks {
+		if c.From == from {
+			if c.Assigned || c.Finished {
+				return false
+			}
+			c.Assigned = true
+			c.SessionID = sessionID
+			c.PeerID = pid
+			return true
+		}
+	}
+	return false
+}
+
+// AssignHeaders keeps track of the headers that are requested from the peer.
+// Only one range of headers is downloading at the same time.
+func (s *Scheduler) AssignHeaders(from, to int, sessionID int, pid peer.ID) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.headers = &Chunk{
+		From:      from,
+		To:        to,
+		SessionID: sessionID,
+		PeerID:    pid,
+		Assigned:  true,
+		Headers:   true,
+	}
+}
+
+// HeadersChunk returns a copy of the last headers chunk, or nil if no headers are requested.
+func (s *Scheduler) HeadersChunk() *Chunk {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.headers == nil {
+		return nil
+	}
+	cloned := *s.headers
+	return &cloned
+}
+
+// GetChunk returns a copy of the chunk that is downloading in this session.
+func (s *Scheduler) GetChunk(sessionID int) *Chunk {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	c := s.findChunk(sessionID)
+	if c == nil {
+		return nil
+	}
+	cloned := *c
+	return &cloned
+}
+
+// Finish marks the chunk of this session as downloaded.
+func (s *Scheduler) Finish(sessionID int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	c := s.findChunk(sessionID)
+	if c != nil {
+		c.Assigned = false
+		c.Finished = true
+	}
+}
+
+// Fail puts the chunk of this session back to the pending chunks,
+// so it can be requested again from a different peer.
+func (s *Scheduler) Fail(sessionID int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	c := s.findChunk(sessionID)
+	if c != nil {
+		c.Assigned = false
+		c.FailedPeer = c.PeerID
+		c.PeerID = ""
+	}
+}
+
+// Reschedule puts the finished chunk that contains this height back to the pending chunks.
+// It is useful when the peer claimed that the chunk is sent, but a block is missing.
+func (s *Scheduler) Reschedule(height int) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	for _, c := range s.chunks {
+		if c.Finished && c.From <= height && c.To >= height {
+			c.Finished = false
+			c.FailedPeer = c.PeerID
+			c.PeerID = ""
+		}
+	}
+}
+
+// IsPeerBusy checks if a chunk is downloading from this peer.
+func (s *Scheduler) IsPeerBusy(pid peer.ID) bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.headers != nil && s.headers.Assigned && s.headers.PeerID == pid {
+		return true
+	}
+	for _, c := range s.chunks {
+		if c.Assigned && c.PeerID == pid {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all the chunks.
+func (s *Scheduler) Clear() {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.chunks = make([]*Chunk, 0)
+	s.headers = nil
+	s.nextHeight = 0
+}
+
+// IsDownloading checks if there is any chunk that is not committed yet,
+// or some headers are downloading.
+func (s *Scheduler) IsDownloading() bool {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if s.headers != nil && s.headers.Assigned {
+		return true
+	}
+	return len(s.chunks) != 0
+}
+
+func (s *Scheduler) findChunk(sessionID int) *Chunk {
+	if s.headers != nil && s.headers.Assigned && s.headers.SessionID == sessionID {
+		return s.headers
+	}
+	for _, c := range s.chunks {
+		if c.Assigned && c.SessionID == sessionID {
+			return c
+		}
+	}
+	return nil
+}
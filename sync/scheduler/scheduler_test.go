@@ -0,0 +1,133 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+func TestSchedule(t *testing.T) {
+	s := NewScheduler(10, 3)
+
+	s.Schedule(1, 25)
+	chunks := s.PendingChunks()
+	assert.Len(t, chunks, 3)
+	assert.Equal(t, chunks[0].From, 1)
+	assert.Equal(t, chunks[0].To, 10)
+	assert.Equal(t, chunks[2].From, 21)
+	assert.Equal(t, chunks[2].To, 25)
+
+	t.Run("Maximum number of chunks", func(t *testing.T) {
+		s.Schedule(1, 100)
+		assert.Len(t, s.PendingChunks(), 3)
+	})
+
+	t.Run("Prune committed chunks", func(t *testing.T) {
+		s.Prune(15)
+		chunks := s.PendingChunks()
+		assert.Equal(t, chunks[0].From, 11)
+
+		s.Schedule(16, 100)
+		chunks = s.PendingChunks()
+		assert.Len(t, chunks, 3)
+		assert.Equal(t, chunks[2].From, 26)
+		assert.Equal(t, chunks[2].To, 35)
+	})
+
+	t.Run("Prune all chunks", func(t *testing.T) {
+		s.Prune(35)
+		assert.False(t, s.IsDownloading())
+	})
+}
+
+func TestAssignChunks(t *testing.T) {
+	s := NewScheduler(10, 3)
+	pid1 := util.RandomPeerID()
+	pid2 := util.RandomPeerID()
+
+	s.Schedule(1, 30)
+	assert.True(t, s.Assign(1, 1, pid1))
+	assert.False(t, s.Assign(1, 2, pid2))
+	assert.True(t, s.Assign(11, 2, pid2))
+	assert.False(t, s.Assign(41, 3, pid2))
+
+	assert.True(t, s.IsPeerBusy(pid1))
+	assert.Len(t, s.AssignedChunks(), 2)
+	assert.Len(t, s.PendingChunks(), 1)
+	assert.Equal(t, s.GetChunk(2).PeerID, pid2)
+	assert.Nil(t, s.GetChunk(3))
+
+	t.Run("Finish a chunk", func(t *testing.T) {
+		s.Finish(1)
+		assert.False(t, s.IsPeerBusy(pid1))
+		assert.Nil(t, s.GetChunk(1))
+		assert.Len(t, s.PendingChunks(), 1)
+	})
+
+	t.Run("Fail a chunk", func(t *testing.T) {
+		s.Fail(2)
+		assert.False(t, s.IsPeerBusy(pid2))
+		chunks := s.PendingChunks()
+		assert.Len(t, chunks, 2)
+		assert.Equal(t, chunks[0].From, 11)
+		assert.Equal(t, chunks[0].FailedPeer, pid2)
+	})
+
+	t.Run("Reschedule a finished chunk", func(t *testing.T) {
+		s.Reschedule(5)
+		chunks := s.PendingChunks()
+		assert.Len(t, chunks, 3)
+		assert.Equal(t, chunks[0].From, 1)
+		assert.Equal(t, chunks[0].FailedPeer, pid1)
+	})
+}
+
+func TestClear(t *testing.T) {
+	s := NewScheduler(10, 3)
+
+	s.Schedule(1, 100)
+	assert.True(t, s.IsDownloading())
+
+	s.Clear()
+	assert.False(t, s.IsDownloading())
+
+	s.Schedule(51, 100)
+	assert.Equal(t, s.PendingChunks()[0].From, 51)
+}
+
+func TestHeaders(t *testing.T) {
+	s := NewScheduler(10, 3)
+	pid1 := util.RandomPeerID()
+	pid2 := util.RandomPeerID()
+
+	assert.Equal(t, s.Window(), 30)
+	assert.Nil(t, s.HeadersChunk())
+
+	s.AssignHeaders(1, 30, 1, pid1)
+	assert.True(t, s.IsDownloading())
+	assert.True(t, s.IsPeerBusy(pid1))
+	assert.True(t, s.GetChunk(1).Headers)
+	assert.Empty(t, s.PendingChunks())
+
+	t.Run("Fail the headers", func(t *testing.T) {
+		s.Fail(1)
+		h := s.HeadersChunk()
+		assert.False(t, h.Assigned)
+		assert.Equal(t, h.FailedPeer, pid1)
+		assert.False(t, s.IsDownloading())
+	})
+
+	t.Run("Finish the headers", func(t *testing.T) {
+		s.AssignHeaders(1, 30, 2, pid2)
+		s.Finish(2)
+		h := s.HeadersChunk()
+		assert.True(t, h.Finished)
+		assert.False(t, s.IsPeerBusy(pid2))
+	})
+
+	t.Run("Clear the headers", func(t *testing.T) {
+		s.Clear()
+		assert.Nil(t, s.HeadersChunk())
+	})
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/consensus"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/metrics"
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/sync/bundle"
@@ -18,6 +21,7 @@ import (
 	"github.com/zarbchain/zarb-go/sync/cache"
 	"github.com/zarbchain/zarb-go/sync/firewall"
 	"github.com/zarbchain/zarb-go/sync/peerset"
+	"github.com/zarbchain/zarb-go/sync/scheduler"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 )
@@ -40,12 +44,16 @@ type synchronizer struct {
 	peerSet         *peerset.PeerSet
 	firewall        *firewall.Firewall
 	cache           *cache.Cache
+	scheduler       *scheduler.Scheduler
 	handlers        map[message.Type]messageHandler
 	broadcastCh     <-chan message.Message
 	networkCh       <-chan network.Event
+	eventBus        *event.Bus
 	network         network.Network
 	heartBeatTicker *time.Ticker
 	logger          *logger.Logger
+
+	snapshotRequested bool
 }
 
 func NewSynchronizer(
@@ -54,7 +62,8 @@ func NewSynchronizer(
 	state state.Facade,
 	consensus consensus.Consensus,
 	net network.Network,
-	broadcastCh <-chan message.Message) (Synchronizer, error) {
+	broadcastCh <-chan message.Message,
+	eventBus *event.Bus) (Synchronizer, error) {
 	sync := &synchronizer{
 		ctx:         context.Background(), // TODO, set proper context
 		config:      conf,
@@ -64,6 +73,7 @@ func NewSynchronizer(
 		network:     net,
 		broadcastCh: broadcastCh,
 		networkCh:   net.EventChannel(),
+		eventBus:    eventBus,
 	}
 
 	peerSet := peerset.NewPeerSet(conf.SessionTimeout)
@@ -78,6 +88,7 @@ func NewSynchronizer(
 	sync.cache = cache
 	sync.peerSet = peerSet
 	sync.firewall = firewall
+	sync.scheduler = scheduler.NewScheduler(LatestBlockInterval, 2*conf.MaximumOpenSessions)
 
 	handlers := make(map[message.Type]messageHandler)
 
@@ -93,6 +104,13 @@ func NewSynchronizer(
 	handlers[message.MessageTypeBlockAnnounce] = newBlockAnnounceHandler(sync)
 	handlers[message.MessageTypeBlocksRequest] = newBlocksRequestHandler(sync)
 	handlers[message.MessageTypeBlocksResponse] = newBlocksResponseHandler(sync)
+	handlers[message.MessageTypeSnapshotRequest] = newSnapshotRequestHandler(sync)
+	handlers[message.MessageTypeSnapshotResponse] = newSnapshotResponseHandler(sync)
+	handlers[message.MessageTypeEvidence] = newEvidenceHandler(sync)
+	handlers[message.MessageTypeHeadersRequest] = newHeadersRequestHandler(sync)
+	handlers[message.MessageTypeHeadersResponse] = newHeadersResponseHandler(sync)
+	handlers[message.MessageTypeCompactProposal] = newCompactProposalHandler(sync)
+	handlers[message.MessageTypeQueryProposalTxs] = newQueryProposalTxsHandler(sync)
 
 	sync.handlers = handlers
 
@@ -150,6 +168,12 @@ func (sync *synchronizer) heartBeatTickerLoop() {
 			return
 		case <-sync.heartBeatTicker.C:
 			sync.broadcastHeartBeat()
+			sync.updatePeersMetrics()
+
+			// Reschedule the timed out chunks
+			if sync.scheduler.IsDownloading() {
+				sync.updateBlokchain()
+			}
 		}
 	}
 }
@@ -169,6 +193,21 @@ func (sync *synchronizer) broadcastHeartBeat() {
 	sync.broadcast(msg)
 }
 
+func (sync *synchronizer) updatePeersMetrics() {
+	counts := map[peerset.StatusCode]int{
+		peerset.StatusCodeBanned:  0,
+		peerset.StatusCodeUnknown: 0,
+		peerset.StatusCodeKnown:   0,
+		peerset.StatusCodeTrusty:  0,
+	}
+	for _, p := range sync.peerSet.GetPeerList() {
+		counts[p.Status]++
+	}
+	for status, count := range counts {
+		metrics.SetPeers(status.String(), count)
+	}
+}
+
 func (sync *synchronizer) sayHello(needResponse bool) {
 	flags := 0
 	if sync.config.NodeNetwork {
@@ -220,6 +259,14 @@ func (sync *synchronizer) receiveLoop() {
 			if err != nil {
 				sync.logger.Warn("error on parsing a message", "initiator", util.FingerprintPeerID(bdl.Initiator), "message", bdl, "err", err)
 				sync.peerSet.IncreaseInvalidBundlesCounter(bdl.Initiator)
+				metrics.BundleInvalid(bdl.Message.Type().String())
+				if errors.Code(err) == errors.ErrInvalidBlock {
+					sync.firewall.ReportBehaviour(bdl.Initiator, peerset.BehaviourInvalidBlock)
+				} else {
+					sync.firewall.ReportBehaviour(bdl.Initiator, peerset.BehaviourInvalidBundle)
+				}
+			} else if bdl != nil {
+				sync.firewall.ReportBehaviour(bdl.Initiator, peerset.BehaviourGood)
 			}
 		}
 	}
@@ -231,6 +278,7 @@ func (sync *synchronizer) processIncomingBundle(bdl *bundle.Bundle) error {
 	}
 
 	sync.logger.Debug("received a message", "initiator", util.FingerprintPeerID(bdl.Initiator), "bundle", bdl)
+	metrics.BundleReceived(bdl.Message.Type().String())
 	h := sync.handlers[bdl.Message.Type()]
 	if h == nil {
 		return errors.Errorf(errors.ErrInvalidMessage, "Invalid message type: %v", bdl.Message.Type())
@@ -251,31 +299,55 @@ func (sync *synchronizer) Fingerprint() string {
 // If the node height is shorter than network more than two hours (720 blocks),
 // it should start downloading the blocks from node networks,
 // otherwise the node can request the latest blocks from the network.
+// A fresh node with fast sync enabled requests a state snapshot first.
 func (sync *synchronizer) updateBlokchain() {
+	ourHeight := sync.state.LastBlockHeight()
+	claimedHeight := sync.peerSet.MaxClaimedHeight()
+	from := sync.downloadedHeight()
+
+	if claimedHeight > ourHeight+LatestBlockInterval {
+		// Keep downloading the blocks from other peers while some sessions are open
+		if sync.scheduler.IsDownloading() {
+			sync.downloadBlocks(from, claimedHeight)
+			return
+		}
+	} else {
+		// We are close to the network, the latest blocks are queried from one peer
+		sync.scheduler.Clear()
+	}
+
 	// TODO: write test for me
 	if sync.peerSet.HasAnyOpenSession() {
 		sync.logger.Debug("we have open session")
 		return
 	}
 
-	ourHeight := sync.state.LastBlockHeight()
-	claimedHeight := sync.peerSet.MaxClaimedHeight()
 	if claimedHeight > ourHeight {
-		from := ourHeight
-		// Make sure we have committed the latest blocks inside the cache
-		for sync.cache.HasBlockInCache(from + 1) {
-			from++
-		}
-
 		sync.logger.Info("start syncing with the network")
 		if claimedHeight > ourHeight+LatestBlockInterval {
-			sync.downloadBlocks(from)
+			if ourHeight == 0 && sync.config.FastSync && !sync.snapshotRequested {
+				if sync.requestSnapshot() {
+					return
+				}
+			}
+			sync.downloadBlocks(from, claimedHeight)
 		} else {
 			sync.queryLatestBlocks(from)
 		}
 	}
 }
 
+// downloadedHeight returns the height of the last block that we have,
+// either committed or inside the cache.
+func (sync *synchronizer) downloadedHeight() int {
+	height := sync.state.LastBlockHeight()
+	// Make sure we have committed the latest blocks inside the cache
+	for sync.cache.HasBlockInCache(height + 1) {
+		height++
+	}
+	return height
+}
+
 func (sync *synchronizer) prepareBundle(msg message.Message) *bundle.Bundle {
 	h := sync.handlers[msg.Type()]
 	if h == nil {
@@ -332,43 +404,293 @@ func (sync *synchronizer) Peers() []peerset.Peer {
 	return sync.peerSet.GetPeerList()
 }
 
-// TODO:
-// maximum nodes to query block should be 8
-//
-func (sync *synchronizer) downloadBlocks(from int) {
-	l := sync.peerSet.GetPeerList()
-	for _, peer := range l {
-		// TODO: write test for me
-		if sync.peerSet.NumberOfOpenSessions() > sync.config.MaximumOpenSessions {
+// verifiedHeight returns the height of the last header that is verified.
+func (sync *synchronizer) verifiedHeight() int {
+	height := sync.downloadedHeight()
+	for sync.cache.HasHeaderInCache(height + 1) {
+		height++
+	}
+	return height
+}
+
+// headersHeight returns the height of the last header that is downloaded, verified or pending.
+// Blocks are downloaded only up to this height.
+func (sync *synchronizer) headersHeight() int {
+	height := sync.verifiedHeight()
+	for sync.cache.HasPendingHeaderInCache(height + 1) {
+		height++
+	}
+	return height
+}
+
+// downloadBlocks splits the missing blocks into chunks and requests them
+// from several node network peers at the same time.
+// The headers are downloaded ahead of the blocks,
+// so the blocks are requested only when their headers are downloaded.
+// Chunks that are timed out or rejected are requested again from a different peer.
+// The blocks are committed in order, once they are received.
+func (sync *synchronizer) downloadBlocks(from, to int) {
+	sync.downloadHeaders(to)
+	if headersHeight := sync.headersHeight(); to > headersHeight {
+		to = headersHeight
+	}
+
+	sync.scheduler.Prune(from)
+	// The next block should be downloaded, even if its chunk is finished
+	sync.scheduler.Reschedule(from + 1)
+	for _, c := range sync.scheduler.AssignedChunks() {
+		if sync.peerSet.FindSession(c.SessionID) == nil {
+			sync.logger.Debug("download session is closed or timed out", "from", c.From, "to", c.To, "pid", util.FingerprintPeerID(c.PeerID))
+			sync.scheduler.Fail(c.SessionID)
+		}
+	}
+	sync.scheduler.Schedule(from+1, to)
+
+	for _, c := range sync.scheduler.PendingChunks() {
+		if sync.peerSet.NumberOfOpenSessions() >= sync.config.MaximumOpenSessions {
 			sync.logger.Debug("we reached maximum number of open sessions")
 			break
 		}
 
-		// TODO: write test for me
-		if !peer.IsNodeNetwork() {
+		peer := sync.pickDownloadPeer(c)
+		if peer == nil {
+			sync.logger.Debug("no peer to download the blocks", "from", c.From, "to", c.To)
+			break
+		}
+
+		session := sync.peerSet.OpenSession(peer.PeerID)
+		if !sync.scheduler.Assign(c.From, session.SessionID(), peer.PeerID) {
+			sync.peerSet.CloseSession(session.SessionID())
 			continue
 		}
+		sync.logger.Debug("sending download request", "from", c.From, "to", c.To, "pid", util.FingerprintPeerID(peer.PeerID))
+		msg := message.NewBlocksRequestMessage(session.SessionID(), c.From, c.To)
+		sync.sendTo(msg, peer.PeerID)
+	}
+}
+
+// downloadHeaders requests the next headers from a node network peer,
+// unless some headers are downloading right now.
+func (sync *synchronizer) downloadHeaders(to int) {
+	ourHeight := sync.state.LastBlockHeight()
+	failedPeer := peer.ID("")
 
-		// TODO: write test for me
+	if h := sync.scheduler.HeadersChunk(); h != nil {
+		if h.Assigned {
+			if sync.peerSet.FindSession(h.SessionID) != nil {
+				return
+			}
+			sync.logger.Debug("headers session is closed or timed out", "from", h.From, "to", h.To, "pid", util.FingerprintPeerID(h.PeerID))
+			sync.scheduler.Fail(h.SessionID)
+			failedPeer = h.PeerID
+		} else {
+			failedPeer = h.FailedPeer
+		}
+	}
+
+	from := sync.headersHeight() + 1
+	if to > ourHeight+sync.scheduler.Window() {
+		to = ourHeight + sync.scheduler.Window()
+	}
+	if from > to {
+		return
+	}
+
+	peer := sync.pickDownloadPeer(scheduler.Chunk{From: from, To: to, FailedPeer: failedPeer})
+	if peer == nil {
+		sync.logger.Debug("no peer to download the headers", "from", from, "to", to)
+		return
+	}
+
+	sync.logger.Debug("sending headers request", "from", from, "to", to, "pid", util.FingerprintPeerID(peer.PeerID))
+	session := sync.peerSet.OpenSession(peer.PeerID)
+	sync.scheduler.AssignHeaders(from, to, session.SessionID(), peer.PeerID)
+	msg := message.NewHeadersRequestMessage(session.SessionID(), from, to)
+	sync.sendTo(msg, peer.PeerID)
+}
+
+// verifyHeaders checks that the headers are chained to our blocks and to each other.
+// Only the certificate of the next height is verified here. The committee of the later heights
+// depends on the blocks that are not committed yet, so their headers are only checked to be chained
+// and they are kept as pending, with the peer that has sent them.
+// The pending headers are verified one by one, when the blocks are committed.
+// The verified headers and their certificates are kept inside the cache.
+func (sync *synchronizer) verifyHeaders(from int, headers []*block.Header, certs []*block.Certificate, source peer.ID) error {
+	headersHeight := sync.headersHeight()
+	if from > headersHeight+1 {
+		return errors.Errorf(errors.ErrInvalidMessage, "headers are not continuous. Expected %v, got %v", headersHeight+1, from)
+	}
+
+	for i, h := range headers {
+		height := from + i
+		if height <= headersHeight {
+			continue
+		}
+
+		prevHash := sync.headerHash(height - 1)
+		if !h.PrevBlockHash().EqualsTo(prevHash) {
+			return errors.Errorf(errors.ErrInvalidBlock,
+				"header %v is not chained to the previous block. Expected %v, got %v", height, prevHash, h.PrevBlockHash())
+		}
+		if i > 0 && !h.PrevCertificateHash().EqualsTo(certs[i-1].Hash()) {
+			return errors.Errorf(errors.ErrInvalidBlock,
+				"header %v is not chained to the previous certificate", height)
+		}
+		if !certs[i].BlockHash().EqualsTo(h.Hash()) {
+			return errors.Errorf(errors.ErrInvalidBlock,
+				"certificate %v is not for the header. Expected %v, got %v", height, h.Hash(), certs[i].BlockHash())
+		}
+
+		err := sync.state.VerifyCertificate(height, certs[i])
+		if errors.Code(err) == errors.ErrUnknownCommittee {
+			sync.logger.Debug("unable to verify the header yet", "height", height, "err", err)
+			sync.cache.AddPendingHeader(height, h, certs[i], source)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		sync.cache.AddHeader(height, h)
+		sync.cache.AddCertificate(certs[i])
+	}
+
+	return nil
+}
+
+// verifyPendingHeader verifies the pending header of the next height, once its committee is known.
+// If it is not valid, the pending headers are dropped, so they are requested again,
+// and the peers that have sent them are penalized.
+func (sync *synchronizer) verifyPendingHeader() {
+	height := sync.state.LastBlockHeight() + 1
+	h, cert := sync.cache.GetPendingHeader(height)
+	if h == nil {
+		return
+	}
+
+	if !h.PrevBlockHash().EqualsTo(sync.state.LastBlockHash()) {
+		sync.logger.Warn("pending header is not chained to our last block", "height", height)
+		sync.dropPendingHeaders(height)
+		return
+	}
+	if err := sync.state.VerifyCertificate(height, cert); err != nil {
+		sync.logger.Warn("pending header is not valid", "height", height, "err", err)
+		sync.dropPendingHeaders(height)
+		return
+	}
+
+	sync.cache.RemovePendingHeader(height)
+	sync.cache.AddHeader(height, h)
+	sync.cache.AddCertificate(cert)
+}
+
+// dropPendingHeaders removes the pending headers from this height.
+// The later headers are chained to the invalid one, so they are invalid too.
+// Every peer that has sent them is penalized once.
+func (sync *synchronizer) dropPendingHeaders(height int) {
+	reported := make(map[peer.ID]bool)
+	for sync.cache.HasPendingHeaderInCache(height) {
+		source := sync.cache.GetPendingHeaderSource(height)
+		if source != "" && !reported[source] {
+			reported[source] = true
+			sync.firewall.ReportBehaviour(source, peerset.BehaviourInvalidBlock)
+		}
+		sync.cache.RemovePendingHeader(height)
+		height++
+	}
+}
+
+// headerHash returns the hash of the block at this height, using the verified and pending headers.
+func (sync *synchronizer) headerHash(height int) hash.Hash {
+	if height == sync.state.LastBlockHeight() {
+		return sync.state.LastBlockHash()
+	}
+	if h := sync.cache.GetHeader(height); h != nil {
+		return h.Hash()
+	}
+	if h, _ := sync.cache.GetPendingHeader(height); h != nil {
+		return h.Hash()
+	}
+	if b := sync.cache.GetBlock(height); b != nil {
+		return b.Hash()
+	}
+	return hash.UndefHash
+}
+
+// checkBlocksWithHeaders checks the received blocks against the verified headers.
+func (sync *synchronizer) checkBlocksWithHeaders(from int, blocks []*block.Block) error {
+	for i, b := range blocks {
+		h := sync.cache.GetHeader(from + i)
+		if h != nil && !h.Hash().EqualsTo(b.Hash()) {
+			return errors.Errorf(errors.ErrInvalidBlock,
+				"block %v doesn't match the verified header", from+i)
+		}
+	}
+	return nil
+}
+
+// pickDownloadPeer returns a node network peer that can serve the chunk.
+// The peer that failed to serve the chunk before is picked only if there is no other peer.
+func (sync *synchronizer) pickDownloadPeer(c scheduler.Chunk) *peerset.Peer {
+	var failedPeer *peerset.Peer
+	for _, peer := range sync.peerSet.GetPeerList() {
+		if !peer.IsNodeNetwork() {
+			continue
+		}
 		if peer.Status != peerset.StatusCodeKnown {
 			continue
 		}
+		if peer.Height < c.To {
+			continue
+		}
+		if sync.scheduler.IsPeerBusy(peer.PeerID) {
+			continue
+		}
+		if peer.PeerID == c.FailedPeer {
+			p := peer
+			failedPeer = &p
+			continue
+		}
+		return &peer
+	}
+	return failedPeer
+}
+
+// finishDownload marks the chunk of this session as downloaded,
+// or requests it again if the peer didn't send all the blocks.
+func (sync *synchronizer) finishDownload(sessionID int) {
+	c := sync.scheduler.GetChunk(sessionID)
+	if c == nil {
+		return
+	}
+	if c.Headers || c.To <= sync.downloadedHeight() || sync.cache.HasBlockInCache(c.To) {
+		sync.scheduler.Finish(sessionID)
+	} else {
+		sync.logger.Debug("peer didn't send all the blocks", "from", c.From, "to", c.To, "pid", util.FingerprintPeerID(c.PeerID))
+		sync.scheduler.Fail(sessionID)
+	}
+}
 
-		// TODO: write test for me
-		if peer.Height < from+1 {
+// requestSnapshot asks one of the node network peers for their latest state snapshot.
+// It returns false if there is no peer to ask.
+func (sync *synchronizer) requestSnapshot() bool {
+	for _, peer := range sync.peerSet.GetPeerList() {
+		if !peer.IsNodeNetwork() {
 			continue
 		}
-		to := from + LatestBlockInterval
-		if to > peer.Height {
-			to = peer.Height
+		if peer.Status != peerset.StatusCodeKnown {
+			continue
 		}
 
-		sync.logger.Debug("sending download request", "from", from+1, "to", to, "pid", util.FingerprintPeerID(peer.PeerID))
+		sync.logger.Debug("sending snapshot request", "pid", util.FingerprintPeerID(peer.PeerID))
 		session := sync.peerSet.OpenSession(peer.PeerID)
-		msg := message.NewBlocksRequestMessage(session.SessionID(), from+1, to)
+		msg := message.NewSnapshotRequestMessage(session.SessionID())
 		sync.sendTo(msg, peer.PeerID)
-		from = to
+		sync.snapshotRequested = true
+
+		return true
 	}
+	return false
 }
 
 func (sync *synchronizer) queryLatestBlocks(from int) {
@@ -406,16 +728,24 @@ func (sync *synchronizer) weAreInTheCommittee() bool {
 	return sync.state.IsInCommittee(sync.signer.PublicKey().Address())
 }
 
+// tryCommitBlocks commits the blocks inside the cache in order.
+// If committing a block fails, the block is evicted from the cache and
+// only the peer that has supplied it is penalized.
 func (sync *synchronizer) tryCommitBlocks() {
 	for {
+		sync.verifyPendingHeader()
 		ourHeight := sync.state.LastBlockHeight()
 		b := sync.cache.GetBlock(ourHeight + 1)
 		if b == nil {
-			break
+			return
+		}
+		if h := sync.cache.GetHeader(ourHeight + 1); h != nil && !h.Hash().EqualsTo(b.Hash()) {
+			sync.rejectBlock(ourHeight+1, b, errors.Errorf(errors.ErrInvalidBlock, "block doesn't match the verified header"))
+			return
 		}
 		c := sync.cache.GetCertificate(b.Hash())
 		if c == nil {
-			break
+			return
 		}
 		for _, id := range b.TxIDs().IDs() {
 			if tx := sync.cache.GetTransaction(id); tx != nil {
@@ -426,13 +756,23 @@ func (sync *synchronizer) tryCommitBlocks() {
 		}
 		sync.logger.Trace("committing block", "height", ourHeight+1, "block", b)
 		if err := sync.state.CommitBlock(ourHeight+1, b, c); err != nil {
-			sync.logger.Warn("committing block failed", "block", b, "err", err, "height", ourHeight+1)
-			// We will ask network to re-send this block again ...
-			break
+			sync.rejectBlock(ourHeight+1, b, err)
+			return
 		}
 	}
 }
 
+// rejectBlock evicts the block from the cache and penalizes the peer that has supplied it.
+func (sync *synchronizer) rejectBlock(height int, b *block.Block, err error) {
+	source := sync.cache.GetBlockSource(height)
+	sync.logger.Warn("committing block failed", "block", b, "err", err, "height", height, "source", util.FingerprintPeerID(source))
+	// We will ask network to re-send this block again ...
+	sync.cache.RemoveBlock(height)
+	if source != "" {
+		sync.firewall.ReportBehaviour(source, peerset.BehaviourInvalidBlock)
+	}
+}
+
 func (sync *synchronizer) prepareBlocksAndTransactions(from, count int) ([]*block.Block, []*tx.Tx) {
 	ourHeight := sync.state.LastBlockHeight()
 
@@ -470,6 +810,47 @@ func (sync *synchronizer) prepareBlocksAndTransactions(from, count int) ([]*bloc
 	return blocks, trxs
 }
 
+// prepareHeaders returns the headers of the blocks with their certificates.
+// The certificate of a block is inside the next block, or it is the last certificate.
+func (sync *synchronizer) prepareHeaders(from, count int) ([]*block.Header, []*block.Certificate) {
+	ourHeight := sync.state.LastBlockHeight()
+
+	if from > ourHeight {
+		sync.logger.Debug("we don't have block at this height", "height", from)
+		return nil, nil
+	}
+
+	if from+count > ourHeight {
+		count = ourHeight - from + 1
+	}
+
+	headers := make([]*block.Header, 0, count)
+	certs := make([]*block.Certificate, 0, count)
+
+	for h := from; h < from+count; h++ {
+		b := sync.cache.GetBlock(h)
+		if b == nil {
+			sync.logger.Warn("unable to find a block", "height", h)
+			break
+		}
+		var cert *block.Certificate
+		if h == ourHeight {
+			cert = sync.state.LastCertificate()
+		} else if next := sync.cache.GetBlock(h + 1); next != nil {
+			cert = next.PrevCertificate()
+		}
+		if cert == nil || !cert.BlockHash().EqualsTo(b.Hash()) {
+			sync.logger.Debug("unable to find the certificate", "height", h)
+			break
+		}
+
+		headers = append(headers, b.Header())
+		certs = append(certs, cert)
+	}
+
+	return headers, certs
+}
+
 func (sync *synchronizer) prepareTransactions(ids []tx.ID) []*tx.Tx {
 	trxs := make([]*tx.Tx, 0, len(ids))
 
@@ -484,6 +865,44 @@ func (sync *synchronizer) prepareTransactions(ids []tx.ID) []*tx.Tx {
 	return trxs
 }
 
+// reconstructProposal reconstructs the compact proposal by the transactions inside our pool
+// and passes it to the consensus.
+// It returns the index of the transactions that are missing from our pool.
+func (sync *synchronizer) reconstructProposal(msg *message.CompactProposalMessage) []int {
+	trxs, err := sync.state.PendingTxsByShortID(msg.Header.Hash(), msg.ShortIDs)
+	if err != nil {
+		// Short IDs collide inside our pool, let's query the full proposal
+		sync.logger.Debug("unable to reconstruct the proposal", "msg", msg, "err", err)
+		sync.broadcast(message.NewQueryProposalMessage(msg.Height, msg.Round))
+		return nil
+	}
+	ids := make([]tx.ID, 0, len(trxs))
+	missed := make([]int, 0)
+	for i, trx := range trxs {
+		if trx == nil {
+			missed = append(missed, i)
+			continue
+		}
+		ids = append(ids, trx.ID())
+	}
+	if len(missed) > 0 {
+		sync.logger.Debug("some transactions of the proposal are missing", "msg", msg, "missed", len(missed))
+		return missed
+	}
+
+	p, err := msg.Proposal(ids)
+	if err != nil {
+		// The reconstructed block doesn't match the header, let's query the full proposal
+		sync.logger.Debug("unable to reconstruct the proposal", "msg", msg, "err", err)
+		sync.broadcast(message.NewQueryProposalMessage(msg.Height, msg.Round))
+		return nil
+	}
+
+	sync.cache.AddProposal(p)
+	sync.consensus.SetProposal(p)
+	return nil
+}
+
 func (sync *synchronizer) updateSession(sessionID int, pid peer.ID, code message.ResponseCode) {
 	s := sync.peerSet.FindSession(sessionID)
 	if s == nil {
@@ -493,20 +912,29 @@ func (sync *synchronizer) updateSession(sessionID int, pid peer.ID, code message
 
 	if s.PeerID() != pid {
 		sync.logger.Warn("peer ID is not known", "session-id", sessionID, "pid", pid)
+		sync.firewall.ReportBehaviour(pid, peerset.BehaviourUselessResponse)
 		return
 	}
 
 	s.SetLastResponseCode(code)
 
 	switch code {
+	case message.ResponseCodeOK:
+		sync.logger.Debug("peer responded our request. close session", "session-id", sessionID)
+		sync.peerSet.CloseSession(sessionID)
+		sync.finishDownload(sessionID)
+		sync.updateBlokchain()
+
 	case message.ResponseCodeRejected:
 		sync.logger.Debug("session rejected, close session", "session-id", sessionID)
 		sync.peerSet.CloseSession(sessionID)
+		sync.scheduler.Fail(sessionID)
 		sync.updateBlokchain()
 
 	case message.ResponseCodeBusy:
 		sync.logger.Debug("peer is busy. close session", "session-id", sessionID)
 		sync.peerSet.CloseSession(sessionID)
+		sync.scheduler.Fail(sessionID)
 		sync.updateBlokchain()
 
 	case message.ResponseCodeMoreBlocks:
@@ -515,11 +943,19 @@ func (sync *synchronizer) updateSession(sessionID int, pid peer.ID, code message
 	case message.ResponseCodeNoMoreBlocks:
 		sync.logger.Debug("peer has no more block. close session", "session-id", sessionID)
 		sync.peerSet.CloseSession(sessionID)
+		sync.finishDownload(sessionID)
 		sync.updateBlokchain()
 
 	case message.ResponseCodeSynced:
 		sync.logger.Debug("peer infomed us we are synced. close session", "session-id", sessionID)
 		sync.peerSet.CloseSession(sessionID)
-		sync.synced()
+		sync.finishDownload(sessionID)
+		sync.scheduler.Prune(sync.downloadedHeight())
+		if sync.scheduler.IsDownloading() {
+			sync.logger.Debug("other blocks are still downloading")
+			sync.updateBlokchain()
+		} else {
+			sync.synced()
+		}
 	}
 }
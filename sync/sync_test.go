@@ -15,6 +15,7 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/network"
 	"github.com/zarbchain/zarb-go/state"
@@ -28,11 +29,13 @@ import (
 
 var (
 	tConfig      *Config
+	tSigners     []crypto.Signer
 	tState       *state.MockState
 	tConsensus   *consensus.MockConsensus
 	tNetwork     *network.MockNetwork
 	tSync        *synchronizer
 	tBroadcastCh chan message.Message
+	tEventBus    *event.Bus
 )
 
 type OverrideFingerprint struct {
@@ -53,10 +56,12 @@ func init() {
 
 func setup(t *testing.T) {
 	signer := bls.GenerateTestSigner()
-	committee, _ := committee.GenerateTestCommittee()
+	committee, signers := committee.GenerateTestCommittee()
+	tSigners = signers
 	tState = state.MockingState(committee)
 	tConsensus = consensus.MockingConsensus(tState)
 	tBroadcastCh = make(chan message.Message, 1000)
+	tEventBus = event.NewBus()
 	tNetwork = network.MockingNetwork(util.RandomPeerID())
 
 	testAddBlocks(t, tState, 21)
@@ -67,6 +72,7 @@ func setup(t *testing.T) {
 		tConsensus,
 		tNetwork,
 		tBroadcastCh,
+		tEventBus,
 	)
 	assert.NoError(t, err)
 	tSync = sync1.(*synchronizer)
@@ -185,3 +191,70 @@ func TestBroadcastInvalidMessage(t *testing.T) {
 		shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeartBeat)
 	})
 }
+
+func TestDownloadBlocks(t *testing.T) {
+	setup(t)
+
+	addNodeNetworkPeer := func(height int) peer.ID {
+		pid := util.RandomPeerID()
+		pub, _ := bls.GenerateTestKeyPair()
+		tSync.peerSet.UpdatePeerInfo(pid, peerset.StatusCodeKnown, t.Name(), version.Agent(), pub, true)
+		tSync.peerSet.UpdateHeight(pid, height)
+		return pid
+	}
+	pid1 := addNodeNetworkPeer(100)
+	pid2 := addNodeNetworkPeer(100)
+	addNodeNetworkPeer(30) // Doesn't have the blocks
+
+	ourHeight := tState.LastBlockHeight()
+	// Headers are verified already
+	for h := ourHeight + 1; h <= 100; h++ {
+		b, _ := block.GenerateTestBlock(nil, nil)
+		tSync.cache.AddHeader(h, b.Header())
+	}
+	tSync.downloadBlocks(ourHeight, 100)
+	shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeHeadersRequest)
+
+	bdl1 := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+	bdl2 := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+	shouldNotPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+
+	msg1 := bdl1.Message.(*message.BlocksRequestMessage)
+	msg2 := bdl2.Message.(*message.BlocksRequestMessage)
+	assert.Equal(t, msg1.From, ourHeight+1)
+	assert.Equal(t, msg1.To, ourHeight+LatestBlockInterval)
+	assert.Equal(t, msg2.From, ourHeight+LatestBlockInterval+1)
+	assert.Equal(t, msg2.To, ourHeight+2*LatestBlockInterval)
+
+	c1 := tSync.scheduler.GetChunk(msg1.SessionID)
+	c2 := tSync.scheduler.GetChunk(msg2.SessionID)
+	assert.Contains(t, []peer.ID{pid1, pid2}, c1.PeerID)
+	assert.Contains(t, []peer.ID{pid1, pid2}, c2.PeerID)
+	assert.NotEqual(t, c1.PeerID, c2.PeerID)
+
+	t.Run("Peer is busy. The chunk should be requested from another peer", func(t *testing.T) {
+		pid3 := addNodeNetworkPeer(100)
+
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeBusy, msg1.SessionID, 0, nil, nil, nil)
+		assert.NoError(t, testReceiveingNewMessage(tSync, msg, c1.PeerID))
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+		msg3 := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, msg3.From, msg1.From)
+		assert.Equal(t, tSync.scheduler.GetChunk(msg3.SessionID).PeerID, pid3)
+
+		// The busy peer is free now and can download the next chunk
+		bdl = shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+		assert.Equal(t, bdl.Message.(*message.BlocksRequestMessage).From, msg2.To+1)
+	})
+
+	t.Run("Session is timed out. The chunk should be requested again", func(t *testing.T) {
+		tSync.peerSet.CloseSession(msg2.SessionID)
+		tSync.updateBlokchain()
+
+		bdl := shouldPublishMessageWithThisType(t, tNetwork, message.MessageTypeBlocksRequest)
+		msg4 := bdl.Message.(*message.BlocksRequestMessage)
+		assert.Equal(t, msg4.From, msg2.From)
+		assert.Nil(t, tSync.scheduler.GetChunk(msg2.SessionID))
+	})
+}
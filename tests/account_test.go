@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/state"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/www/capnp"
 )
 
@@ -32,3 +34,24 @@ func TestGetAccount(t *testing.T) {
 	require.NotNil(t, acc)
 	assert.Equal(t, acc.Number(), 0)
 }
+
+func TestGetAccountTransactions(t *testing.T) {
+	res := tCapnpServer.GetAccountTransactions(tCtx, func(p capnp.ZarbServer_getAccountTransactions_Params) error {
+		p.SetPage(0)
+		return p.SetAddress([]byte(crypto.TreasuryAddress.String()))
+	}).Result()
+
+	st, err := res.Struct()
+	require.NoError(t, err)
+
+	// All the subsidy transactions are sent from the treasury address
+	list, _ := st.Transactions()
+	require.NotZero(t, list.Len())
+	assert.LessOrEqual(t, list.Len(), state.TransactionsPerPage)
+	for i := 0; i < list.Len(); i++ {
+		d, _ := list.At(i).Data()
+		trx := new(tx.Tx)
+		require.NoError(t, trx.Decode(d))
+		assert.True(t, trx.IsMintbaseTx())
+	}
+}
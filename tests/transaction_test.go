@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
 	"github.com/zarbchain/zarb-go/www/capnp"
@@ -125,3 +127,28 @@ func TestSendingTransactions(t *testing.T) {
 	assert.Equal(t, accCarol.Balance(), int64(10))
 	assert.Equal(t, accDave.Balance(), int64(1))
 }
+
+func TestGetTransactionProof(t *testing.T) {
+	b := lastBlock()
+	id := b.TxIDs().IDs()[0]
+
+	res := tCapnpServer.GetTransactionProof(tCtx, func(p capnp.ZarbServer_getTransactionProof_Params) error {
+		return p.SetId([]byte(id.String()))
+	}).Result()
+	st, err := res.Struct()
+	require.NoError(t, err)
+
+	blockHash, _ := st.BlockHash()
+	assert.Equal(t, blockHash, b.Hash().RawBytes())
+
+	p, _ := st.Proof()
+	siblingList, _ := p.Siblings()
+	proof := &simplemerkle.Proof{Index: int(p.Index())}
+	for i := 0; i < siblingList.Len(); i++ {
+		d, _ := siblingList.At(i)
+		h, err := hash.FromRawBytes(d)
+		require.NoError(t, err)
+		proof.Siblings = append(proof.Siblings, h)
+	}
+	assert.True(t, proof.Verify(id, b.Header().TxIDsHash()))
+}
@@ -1,9 +1,11 @@
 package tx
 
 import (
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/tx/payload"
 )
@@ -40,6 +42,39 @@ func NewSendTx(stamp hash.Stamp,
 	}
 }
 
+// NewVestingSendTx creates a send transaction that locks the amount in the receiver account
+// based on the vesting schedule
+func NewVestingSendTx(stamp hash.Stamp,
+	seq int,
+	sender, receiver crypto.Address,
+	amount, fee int64, memo string,
+	vesting payload.VestingSchedule) *Tx {
+	trx := NewSendTx(stamp, seq, sender, receiver, amount, fee, memo)
+	trx.data.Payload.(*payload.SendPayload).Vesting = &vesting
+	return trx
+}
+
+func NewBatchSendTx(stamp hash.Stamp,
+	seq int,
+	sender crypto.Address,
+	receivers []payload.BatchReceiver,
+	fee int64, memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeBatchSend,
+			Payload: &payload.BatchSendPayload{
+				Sender:    sender,
+				Receivers: receivers,
+			},
+			Fee:  fee,
+			Memo: memo,
+		},
+	}
+}
+
 func NewBondTx(stamp hash.Stamp,
 	seq int,
 	bonder crypto.Address,
@@ -104,6 +139,69 @@ func NewWithdrawTx(stamp hash.Stamp,
 	}
 }
 
+func NewUnjailTx(stamp hash.Stamp,
+	seq int,
+	val crypto.Address,
+	memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeUnjail,
+			Payload: &payload.UnjailPayload{
+				Validator: val,
+			},
+			Fee:  0,
+			Memo: memo,
+		},
+	}
+}
+
+func NewProposalTx(stamp hash.Stamp,
+	seq int,
+	proposer crypto.Address,
+	changes param.Changes,
+	activationHeight int,
+	fee int64, memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeProposal,
+			Payload: &payload.ProposalPayload{
+				Proposer:         proposer,
+				Changes:          changes,
+				ActivationHeight: activationHeight,
+			},
+			Fee:  fee,
+			Memo: memo,
+		},
+	}
+}
+
+func NewProposalVoteTx(stamp hash.Stamp,
+	seq int,
+	voter crypto.Address,
+	proposalID hash.Hash,
+	memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeProposalVote,
+			Payload: &payload.ProposalVotePayload{
+				Voter:      voter,
+				ProposalID: proposalID,
+			},
+			Fee:  0,
+			Memo: memo,
+		},
+	}
+}
+
 func NewSortitionTx(stamp hash.Stamp,
 	seq int,
 	addr crypto.Address,
@@ -122,3 +220,84 @@ func NewSortitionTx(stamp hash.Stamp,
 		},
 	}
 }
+
+func NewSlashTx(stamp hash.Stamp,
+	seq int,
+	reporter crypto.Address,
+	ev *evidence.Evidence) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeSlash,
+			Payload: &payload.SlashPayload{
+				Reporter: reporter,
+				Evidence: ev,
+			},
+			Fee: 0,
+		},
+	}
+}
+
+func NewDelegateTx(stamp hash.Stamp,
+	seq int,
+	delegator, val crypto.Address,
+	stake, fee int64, memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeDelegate,
+			Payload: &payload.DelegatePayload{
+				Delegator: delegator,
+				Validator: val,
+				Stake:     stake,
+			},
+			Fee:  fee,
+			Memo: memo,
+		},
+	}
+}
+
+func NewUndelegateTx(stamp hash.Stamp,
+	seq int,
+	delegator, val crypto.Address,
+	fee int64, memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeUndelegate,
+			Payload: &payload.UndelegatePayload{
+				Delegator: delegator,
+				Validator: val,
+			},
+			Fee:  fee,
+			Memo: memo,
+		},
+	}
+}
+
+func NewWithdrawDelegationTx(stamp hash.Stamp,
+	seq int,
+	delegator, val crypto.Address,
+	amount, fee int64, memo string) *Tx {
+	return &Tx{
+		data: txData{
+			Stamp:    stamp,
+			Sequence: seq,
+			Version:  1,
+			Type:     payload.PayloadTypeWithdrawDelegation,
+			Payload: &payload.WithdrawDelegationPayload{
+				Delegator: delegator,
+				Validator: val,
+				Amount:    amount,
+			},
+			Fee:  fee,
+			Memo: memo,
+		},
+	}
+}
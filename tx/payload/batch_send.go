@@ -0,0 +1,70 @@
+package payload
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// MaximumBatchReceivers is the maximum number of receivers in one batch send payload
+const MaximumBatchReceivers = 1000
+
+type BatchReceiver struct {
+	Address crypto.Address `cbor:"1,keyasint"`
+	Amount  int64          `cbor:"2,keyasint"`
+}
+
+type BatchSendPayload struct {
+	Sender    crypto.Address  `cbor:"1,keyasint"`
+	Receivers []BatchReceiver `cbor:"2,keyasint"`
+}
+
+func (p *BatchSendPayload) Type() Type {
+	return PayloadTypeBatchSend
+}
+
+func (p *BatchSendPayload) Signer() crypto.Address {
+	return p.Sender
+}
+
+// Value returns the total amount that is sent to the receivers
+func (p *BatchSendPayload) Value() int64 {
+	total := int64(0)
+	for _, r := range p.Receivers {
+		total += r.Amount
+	}
+	return total
+}
+
+func (p *BatchSendPayload) SanityCheck() error {
+	if len(p.Receivers) == 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "no receiver")
+	}
+	if len(p.Receivers) > MaximumBatchReceivers {
+		return errors.Errorf(errors.ErrInvalidTx, "too many receivers")
+	}
+	total := int64(0)
+	for i, r := range p.Receivers {
+		if r.Amount < 0 {
+			return errors.Errorf(errors.ErrInvalidTx, "invalid amount for receiver %d", i)
+		}
+		if r.Amount > math.MaxInt64-total {
+			return errors.Errorf(errors.ErrInvalidTx, "total amount overflow")
+		}
+		total += r.Amount
+		if err := r.Address.SanityCheck(); err != nil {
+			return errors.Errorf(errors.ErrInvalidTx, "invalid receiver address %d", i)
+		}
+	}
+
+	return nil
+}
+
+func (p *BatchSendPayload) Fingerprint() string {
+	return fmt.Sprintf("{BatchSend 💰 %v->[%d] %v",
+		p.Sender.Fingerprint(),
+		len(p.Receivers),
+		p.Value())
+}
@@ -6,12 +6,16 @@ import (
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/validator"
 )
 
 type BondPayload struct {
 	Bonder    crypto.Address `cbor:"1,keyasint"`
 	PublicKey *bls.PublicKey `cbor:"2,keyasint"`
 	Stake     int64          `cbor:"3,keyasint"`
+	// Commission is the part of the delegators' reward that the validator takes, in basis points.
+	// It can only be set when the validator is created by its first bond.
+	Commission int `cbor:"4,keyasint,omitempty"`
 }
 
 func (p *BondPayload) Type() Type {
@@ -36,6 +40,9 @@ func (p *BondPayload) SanityCheck() error {
 	if err := p.PublicKey.SanityCheck(); err != nil {
 		return errors.Errorf(errors.ErrInvalidTx, "invalid receiver address")
 	}
+	if p.Commission < 0 || p.Commission > validator.MaxCommission {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid commission")
+	}
 
 	return nil
 }
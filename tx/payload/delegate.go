@@ -0,0 +1,47 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type DelegatePayload struct {
+	Delegator crypto.Address `cbor:"1,keyasint"`
+	Validator crypto.Address `cbor:"2,keyasint"`
+	Stake     int64          `cbor:"3,keyasint"`
+}
+
+func (p *DelegatePayload) Type() Type {
+	return PayloadTypeDelegate
+}
+
+func (p *DelegatePayload) Signer() crypto.Address {
+	return p.Delegator
+}
+
+func (p *DelegatePayload) Value() int64 {
+	return p.Stake
+}
+
+func (p *DelegatePayload) SanityCheck() error {
+	if p.Stake <= 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid amount")
+	}
+	if err := p.Delegator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid delegator address")
+	}
+	if err := p.Validator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid validator address")
+	}
+
+	return nil
+}
+
+func (p *DelegatePayload) Fingerprint() string {
+	return fmt.Sprintf("{Delegate 🤝 %v->%v %v",
+		p.Delegator.Fingerprint(),
+		p.Validator.Fingerprint(),
+		p.Stake)
+}
@@ -9,11 +9,19 @@ import (
 type Type int
 
 const (
-	PayloadTypeSend      = Type(1)
-	PayloadTypeBond      = Type(2)
-	PayloadTypeSortition = Type(3)
-	PayloadTypeUnbond    = Type(4)
-	PayloadTypeWithdraw  = Type(5)
+	PayloadTypeSend               = Type(1)
+	PayloadTypeBond               = Type(2)
+	PayloadTypeSortition          = Type(3)
+	PayloadTypeUnbond             = Type(4)
+	PayloadTypeWithdraw           = Type(5)
+	PayloadTypeBatchSend          = Type(6)
+	PayloadTypeDelegate           = Type(7)
+	PayloadTypeUndelegate         = Type(8)
+	PayloadTypeWithdrawDelegation = Type(9)
+	PayloadTypeSlash              = Type(10)
+	PayloadTypeUnjail             = Type(11)
+	PayloadTypeProposal           = Type(12)
+	PayloadTypeProposalVote       = Type(13)
 )
 
 func (t Type) String() string {
@@ -28,6 +36,22 @@ func (t Type) String() string {
 		return "withdraw"
 	case PayloadTypeSortition:
 		return "sortition"
+	case PayloadTypeBatchSend:
+		return "batch-send"
+	case PayloadTypeDelegate:
+		return "delegate"
+	case PayloadTypeUndelegate:
+		return "undelegate"
+	case PayloadTypeWithdrawDelegation:
+		return "withdraw-delegation"
+	case PayloadTypeSlash:
+		return "slash"
+	case PayloadTypeUnjail:
+		return "unjail"
+	case PayloadTypeProposal:
+		return "proposal"
+	case PayloadTypeProposalVote:
+		return "proposal-vote"
 	}
 	return fmt.Sprintf("%d", t)
 }
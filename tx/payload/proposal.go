@@ -0,0 +1,48 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
+)
+
+type ProposalPayload struct {
+	Proposer         crypto.Address `cbor:"1,keyasint"`
+	Changes          param.Changes  `cbor:"2,keyasint"`
+	ActivationHeight int            `cbor:"3,keyasint"`
+}
+
+func (p *ProposalPayload) Type() Type {
+	return PayloadTypeProposal
+}
+
+func (p *ProposalPayload) Signer() crypto.Address {
+	return p.Proposer
+}
+
+func (p *ProposalPayload) Value() int64 {
+	return 0
+}
+
+func (p *ProposalPayload) SanityCheck() error {
+	if err := p.Proposer.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid proposer address")
+	}
+	if err := p.Changes.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+	if p.ActivationHeight <= 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid activation height")
+	}
+
+	return nil
+}
+
+func (p *ProposalPayload) Fingerprint() string {
+	return fmt.Sprintf("{Proposal 📜 %v ⏰ %v}",
+		p.Proposer.Fingerprint(),
+		p.ActivationHeight,
+	)
+}
@@ -0,0 +1,44 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type ProposalVotePayload struct {
+	Voter      crypto.Address `cbor:"1,keyasint"`
+	ProposalID hash.Hash      `cbor:"2,keyasint"`
+}
+
+func (p *ProposalVotePayload) Type() Type {
+	return PayloadTypeProposalVote
+}
+
+func (p *ProposalVotePayload) Signer() crypto.Address {
+	return p.Voter
+}
+
+func (p *ProposalVotePayload) Value() int64 {
+	return 0
+}
+
+func (p *ProposalVotePayload) SanityCheck() error {
+	if err := p.Voter.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid voter address")
+	}
+	if err := p.ProposalID.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid proposal ID")
+	}
+
+	return nil
+}
+
+func (p *ProposalVotePayload) Fingerprint() string {
+	return fmt.Sprintf("{Vote 🗳 %v->%v}",
+		p.Voter.Fingerprint(),
+		p.ProposalID.Fingerprint(),
+	)
+}
@@ -7,10 +7,20 @@ import (
 	"github.com/zarbchain/zarb-go/errors"
 )
 
+// VestingSchedule locks the sent amount in the receiver account.
+// Nothing is unlocked before the cliff height. After the cliff, the amount is unlocked
+// linearly from the start height until it is fully unlocked at the end height.
+type VestingSchedule struct {
+	StartHeight int `cbor:"1,keyasint"`
+	CliffHeight int `cbor:"2,keyasint"`
+	EndHeight   int `cbor:"3,keyasint"`
+}
+
 type SendPayload struct {
-	Sender   crypto.Address `cbor:"1,keyasint"`
-	Receiver crypto.Address `cbor:"2,keyasint"`
-	Amount   int64          `cbor:"3,keyasint"`
+	Sender   crypto.Address   `cbor:"1,keyasint"`
+	Receiver crypto.Address   `cbor:"2,keyasint"`
+	Amount   int64            `cbor:"3,keyasint"`
+	Vesting  *VestingSchedule `cbor:"4,keyasint,omitempty"`
 }
 
 func (p *SendPayload) Type() Type {
@@ -32,11 +42,30 @@ func (p *SendPayload) SanityCheck() error {
 	if err := p.Receiver.SanityCheck(); err != nil {
 		return errors.Errorf(errors.ErrInvalidTx, "invalid receiver address")
 	}
+	if p.Vesting != nil {
+		if p.Amount == 0 {
+			return errors.Errorf(errors.ErrInvalidTx, "no amount to lock")
+		}
+		if p.Vesting.StartHeight < 0 ||
+			p.Vesting.StartHeight > p.Vesting.CliffHeight ||
+			p.Vesting.CliffHeight > p.Vesting.EndHeight {
+			return errors.Errorf(errors.ErrInvalidTx, "invalid vesting schedule")
+		}
+	}
 
 	return nil
 }
 
 func (p *SendPayload) Fingerprint() string {
+	if p.Vesting != nil {
+		return fmt.Sprintf("{Send 🔒 %v->%v %v [%d-%d-%d]",
+			p.Sender.Fingerprint(),
+			p.Receiver.Fingerprint(),
+			p.Amount,
+			p.Vesting.StartHeight,
+			p.Vesting.CliffHeight,
+			p.Vesting.EndHeight)
+	}
 	return fmt.Sprintf("{Send 💸 %v->%v %v",
 		p.Sender.Fingerprint(),
 		p.Receiver.Fingerprint(),
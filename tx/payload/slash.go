@@ -0,0 +1,53 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/consensus/evidence"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// SlashPayload reports a double-signing validator.
+// The reporter is a validator that signs the transaction.
+type SlashPayload struct {
+	Reporter crypto.Address     `cbor:"1,keyasint"`
+	Evidence *evidence.Evidence `cbor:"2,keyasint"`
+}
+
+func (p *SlashPayload) Type() Type {
+	return PayloadTypeSlash
+}
+
+func (p *SlashPayload) Signer() crypto.Address {
+	return p.Reporter
+}
+
+func (p *SlashPayload) Value() int64 {
+	return 0
+}
+
+func (p *SlashPayload) SanityCheck() error {
+	if err := p.Reporter.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid reporter address")
+	}
+	if p.Evidence == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "no evidence")
+	}
+	if err := p.Evidence.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, err.Error())
+	}
+
+	return nil
+}
+
+func (p *SlashPayload) Fingerprint() string {
+	// Invalid transactions are logged too, so the evidence might be incomplete
+	offender := ""
+	if p.Evidence != nil && p.Evidence.VoteA() != nil {
+		offender = p.Evidence.Offender().Fingerprint()
+	}
+	return fmt.Sprintf("{Slash 🔪 %v->%v",
+		p.Reporter.Fingerprint(),
+		offender)
+}
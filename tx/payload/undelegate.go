@@ -0,0 +1,42 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type UndelegatePayload struct {
+	Delegator crypto.Address `cbor:"1,keyasint"`
+	Validator crypto.Address `cbor:"2,keyasint"`
+}
+
+func (p *UndelegatePayload) Type() Type {
+	return PayloadTypeUndelegate
+}
+
+func (p *UndelegatePayload) Signer() crypto.Address {
+	return p.Delegator
+}
+
+func (p *UndelegatePayload) Value() int64 {
+	return 0
+}
+
+func (p *UndelegatePayload) SanityCheck() error {
+	if err := p.Delegator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid delegator address")
+	}
+	if err := p.Validator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid validator address")
+	}
+
+	return nil
+}
+
+func (p *UndelegatePayload) Fingerprint() string {
+	return fmt.Sprintf("{Undelegate 👋 %v->%v",
+		p.Delegator.Fingerprint(),
+		p.Validator.Fingerprint())
+}
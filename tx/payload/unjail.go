@@ -0,0 +1,38 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+type UnjailPayload struct {
+	Validator crypto.Address `cbor:"1,keyasint"`
+}
+
+func (p *UnjailPayload) Type() Type {
+	return PayloadTypeUnjail
+}
+
+func (p *UnjailPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (p *UnjailPayload) Value() int64 {
+	return 0
+}
+
+func (p *UnjailPayload) SanityCheck() error {
+	if err := p.Validator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "Invalid validator address")
+	}
+
+	return nil
+}
+
+func (p *UnjailPayload) Fingerprint() string {
+	return fmt.Sprintf("{Unjail 🗝 %v",
+		p.Validator.Fingerprint(),
+	)
+}
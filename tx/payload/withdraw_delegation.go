@@ -0,0 +1,49 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/errors"
+)
+
+// WithdrawDelegationPayload withdraws the rewards and the unbonded stake of a delegation.
+// The amount is always deposited to the delegator account.
+type WithdrawDelegationPayload struct {
+	Delegator crypto.Address `cbor:"1,keyasint"`
+	Validator crypto.Address `cbor:"2,keyasint"`
+	Amount    int64          `cbor:"3,keyasint"`
+}
+
+func (p *WithdrawDelegationPayload) Type() Type {
+	return PayloadTypeWithdrawDelegation
+}
+
+func (p *WithdrawDelegationPayload) Signer() crypto.Address {
+	return p.Delegator
+}
+
+func (p *WithdrawDelegationPayload) Value() int64 {
+	return p.Amount
+}
+
+func (p *WithdrawDelegationPayload) SanityCheck() error {
+	if p.Amount < 0 {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid amount")
+	}
+	if err := p.Delegator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid delegator address")
+	}
+	if err := p.Validator.SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid validator address")
+	}
+
+	return nil
+}
+
+func (p *WithdrawDelegationPayload) Fingerprint() string {
+	return fmt.Sprintf("{WithdrawDelegation 🧾 %v->%v %v",
+		p.Validator.Fingerprint(),
+		p.Delegator.Fingerprint(),
+		p.Amount)
+}
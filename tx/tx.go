@@ -5,19 +5,25 @@ import (
 	"fmt"
 
 	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/tx/payload"
 )
 
 type ID = hash.Hash
 
+// MaxVersion is the latest transaction version that this node supports.
+const MaxVersion = 1
+
 type Tx struct {
 	// TODO: Memorizing ID is thread safe?
 	memorizedID   *ID
+	memorizedSize int
 	sanityChecked bool
 
 	data txData
@@ -33,6 +39,8 @@ type txData struct {
 	Memo      string           `cbor:"7,keyasint,omitempty"`
 	PublicKey crypto.PublicKey `cbor:"20,keyasint,omitempty"`
 	Signature crypto.Signature `cbor:"21,keyasint,omitempty"`
+	Multisig  *bls.Multisig    `cbor:"22,keyasint,omitempty"`
+	Signers   []byte           `cbor:"23,keyasint,omitempty"`
 }
 
 func (tx *Tx) Version() int                { return tx.data.Version }
@@ -44,22 +52,37 @@ func (tx *Tx) Fee() int64                  { return tx.data.Fee }
 func (tx *Tx) Memo() string                { return tx.data.Memo }
 func (tx *Tx) PublicKey() crypto.PublicKey { return tx.data.PublicKey }
 func (tx *Tx) Signature() crypto.Signature { return tx.data.Signature }
+func (tx *Tx) Multisig() *bls.Multisig     { return tx.data.Multisig }
+func (tx *Tx) Signers() []byte             { return tx.data.Signers }
 
 func (tx *Tx) SetSignature(sig crypto.Signature) {
 	tx.sanityChecked = false
+	tx.memorizedSize = 0
 	tx.data.Signature = sig
 }
 
 func (tx *Tx) SetPublicKey(pub crypto.PublicKey) {
 	tx.sanityChecked = false
+	tx.memorizedSize = 0
 	tx.data.PublicKey = pub
 }
 
+// SetMultisig sets the aggregated signature of a multi-signature account.
+// Signers is a bitmap that shows which owners have signed the transaction.
+func (tx *Tx) SetMultisig(ms *bls.Multisig, signers []byte, sig crypto.Signature) {
+	tx.sanityChecked = false
+	tx.memorizedSize = 0
+	tx.data.PublicKey = nil
+	tx.data.Multisig = ms
+	tx.data.Signers = signers
+	tx.data.Signature = sig
+}
+
 func (tx *Tx) SanityCheck() error {
 	if tx.sanityChecked {
 		return nil
 	}
-	if tx.Version() != 1 {
+	if tx.Version() < 1 || tx.Version() > MaxVersion {
 		return errors.Errorf(errors.ErrInvalidTx, "invalid version")
 	}
 	if tx.Sequence() < 0 {
@@ -105,6 +128,11 @@ func (tx *Tx) checkSignature() error {
 		if tx.Signature() != nil {
 			return errors.Errorf(errors.ErrInvalidTx, "subsidy transaction should not have signature")
 		}
+		if tx.Multisig() != nil {
+			return errors.Errorf(errors.ErrInvalidTx, "subsidy transaction should not have multisig")
+		}
+	} else if tx.Multisig() != nil {
+		return tx.checkMultisig()
 	} else {
 		if tx.PublicKey() == nil {
 			return errors.Errorf(errors.ErrInvalidTx, "no public key")
@@ -129,16 +157,41 @@ func (tx *Tx) checkSignature() error {
 	return nil
 }
 
+func (tx *Tx) checkMultisig() error {
+	if tx.PublicKey() != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "multisig transaction should not have public key")
+	}
+	if tx.Signature() == nil {
+		return errors.Errorf(errors.ErrInvalidTx, "no signature")
+	}
+	if err := tx.Multisig().SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid multisig: %v", err)
+	}
+	if err := tx.Signature().SanityCheck(); err != nil {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid signature")
+	}
+	if !tx.Payload().Signer().EqualsTo(tx.Multisig().Address()) {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid multisig")
+	}
+	bs := tx.SignBytes()
+	if !tx.Multisig().Verify(bs, tx.Signers(), tx.Signature()) {
+		return errors.Errorf(errors.ErrInvalidTx, "invalid signature")
+	}
+	return nil
+}
+
 type _txData struct {
-	Version   int          `cbor:"1,keyasint"`
-	Stamp     hash.Stamp   `cbor:"2,keyasint"`
-	Sequence  int          `cbor:"3,keyasint"`
-	Fee       int64        `cbor:"4,keyasint"`
-	Type      payload.Type `cbor:"5,keyasint"`
-	Payload   []byte       `cbor:"6,keyasint"`
-	Memo      string       `cbor:"7,keyasint,omitempty"`
-	PublicKey []byte       `cbor:"20,keyasint,omitempty"`
-	Signature []byte       `cbor:"21,keyasint,omitempty"`
+	Version   int           `cbor:"1,keyasint"`
+	Stamp     hash.Stamp    `cbor:"2,keyasint"`
+	Sequence  int           `cbor:"3,keyasint"`
+	Fee       int64         `cbor:"4,keyasint"`
+	Type      payload.Type  `cbor:"5,keyasint"`
+	Payload   []byte        `cbor:"6,keyasint"`
+	Memo      string        `cbor:"7,keyasint,omitempty"`
+	PublicKey []byte        `cbor:"20,keyasint,omitempty"`
+	Signature []byte        `cbor:"21,keyasint,omitempty"`
+	Multisig  *bls.Multisig `cbor:"22,keyasint,omitempty"`
+	Signers   []byte        `cbor:"23,keyasint,omitempty"`
 }
 
 func (tx *Tx) MarshalCBOR() ([]byte, error) {
@@ -149,6 +202,8 @@ func (tx *Tx) MarshalCBOR() ([]byte, error) {
 		Type:     tx.data.Type,
 		Fee:      tx.data.Fee,
 		Memo:     tx.data.Memo,
+		Multisig: tx.data.Multisig,
+		Signers:  tx.data.Signers,
 	}
 	payloadData, err := cbor.Marshal(tx.data.Payload)
 	if err != nil {
@@ -189,6 +244,22 @@ func (tx *Tx) UnmarshalCBOR(bs []byte) error {
 		p = &payload.WithdrawPayload{}
 	case payload.PayloadTypeSortition:
 		p = &payload.SortitionPayload{}
+	case payload.PayloadTypeBatchSend:
+		p = &payload.BatchSendPayload{}
+	case payload.PayloadTypeDelegate:
+		p = &payload.DelegatePayload{}
+	case payload.PayloadTypeUndelegate:
+		p = &payload.UndelegatePayload{}
+	case payload.PayloadTypeWithdrawDelegation:
+		p = &payload.WithdrawDelegationPayload{}
+	case payload.PayloadTypeSlash:
+		p = &payload.SlashPayload{}
+	case payload.PayloadTypeUnjail:
+		p = &payload.UnjailPayload{}
+	case payload.PayloadTypeProposal:
+		p = &payload.ProposalPayload{}
+	case payload.PayloadTypeProposalVote:
+		p = &payload.ProposalVotePayload{}
 
 	default:
 		return errors.Errorf(errors.ErrInvalidTx, "invalid payload")
@@ -201,6 +272,8 @@ func (tx *Tx) UnmarshalCBOR(bs []byte) error {
 	tx.data.Payload = p
 	tx.data.Fee = _data.Fee
 	tx.data.Memo = _data.Memo
+	tx.data.Multisig = _data.Multisig
+	tx.data.Signers = _data.Signers
 
 	if _data.PublicKey != nil {
 		publicKey, err := bls.PublicKeyFromRawBytes(_data.PublicKey)
@@ -243,6 +316,8 @@ func (tx *Tx) Fingerprint() string {
 func (tx Tx) SignBytes() []byte {
 	tx.data.PublicKey = nil
 	tx.data.Signature = nil
+	tx.data.Multisig = nil
+	tx.data.Signers = nil
 
 	bz, _ := tx.MarshalCBOR()
 	return bz
@@ -257,6 +332,16 @@ func (tx *Tx) ID() ID {
 	return *tx.memorizedID
 }
 
+// SerializeSize returns the size of the encoded transaction in bytes.
+func (tx *Tx) SerializeSize() int {
+	if tx.memorizedSize == 0 {
+		bs, _ := tx.Encode()
+		tx.memorizedSize = len(bs)
+	}
+
+	return tx.memorizedSize
+}
+
 func (tx *Tx) IsBondTx() bool {
 	return tx.data.Type == payload.PayloadTypeBond
 }
@@ -278,9 +363,107 @@ func (tx *Tx) IsWithdrawTx() bool {
 	return tx.data.Type == payload.PayloadTypeWithdraw
 }
 
+func (tx *Tx) IsBatchSendTx() bool {
+	return tx.data.Type == payload.PayloadTypeBatchSend
+}
+
+func (tx *Tx) IsDelegateTx() bool {
+	return tx.data.Type == payload.PayloadTypeDelegate
+}
+
+func (tx *Tx) IsUndelegateTx() bool {
+	return tx.data.Type == payload.PayloadTypeUndelegate
+}
+
+func (tx *Tx) IsWithdrawDelegationTx() bool {
+	return tx.data.Type == payload.PayloadTypeWithdrawDelegation
+}
+
+func (tx *Tx) IsSlashTx() bool {
+	return tx.data.Type == payload.PayloadTypeSlash
+}
+
+func (tx *Tx) IsUnjailTx() bool {
+	return tx.data.Type == payload.PayloadTypeUnjail
+}
+
+func (tx *Tx) IsProposalTx() bool {
+	return tx.data.Type == payload.PayloadTypeProposal
+}
+
+func (tx *Tx) IsProposalVoteTx() bool {
+	return tx.data.Type == payload.PayloadTypeProposalVote
+}
+
+// Addresses returns all the addresses that are involved in this transaction,
+// like sender, receiver and validator addresses.
+func (tx *Tx) Addresses() []crypto.Address {
+	addrs := []crypto.Address{}
+	switch pld := tx.data.Payload.(type) {
+	case *payload.SendPayload:
+		addrs = append(addrs, pld.Sender, pld.Receiver)
+	case *payload.BondPayload:
+		addrs = append(addrs, pld.Bonder, pld.PublicKey.Address())
+	case *payload.SortitionPayload:
+		addrs = append(addrs, pld.Address)
+	case *payload.UnbondPayload:
+		addrs = append(addrs, pld.Validator)
+	case *payload.WithdrawPayload:
+		addrs = append(addrs, pld.From, pld.To)
+	case *payload.BatchSendPayload:
+		addrs = append(addrs, pld.Sender)
+		for _, r := range pld.Receivers {
+			addrs = append(addrs, r.Address)
+		}
+	case *payload.DelegatePayload:
+		addrs = append(addrs, pld.Delegator, pld.Validator)
+	case *payload.UndelegatePayload:
+		addrs = append(addrs, pld.Delegator, pld.Validator)
+	case *payload.WithdrawDelegationPayload:
+		addrs = append(addrs, pld.Delegator, pld.Validator)
+	case *payload.SlashPayload:
+		addrs = append(addrs, pld.Reporter, pld.Evidence.Offender())
+	case *payload.UnjailPayload:
+		addrs = append(addrs, pld.Validator)
+	case *payload.ProposalPayload:
+		addrs = append(addrs, pld.Proposer)
+	case *payload.ProposalVotePayload:
+		addrs = append(addrs, pld.Voter)
+	}
+
+	// Remove duplicated addresses, like sending to self
+	unique := make([]crypto.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		found := false
+		for _, u := range unique {
+			if u.EqualsTo(addr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unique = append(unique, addr)
+		}
+	}
+	return unique
+}
+
 //IsFreeTx will return if trx's fee is 0
 func (tx *Tx) IsFreeTx() bool {
-	return tx.IsMintbaseTx() || tx.IsSortitionTx() || tx.IsUnbondTx()
+	return tx.IsMintbaseTx() || IsFreePayload(tx.data.Type)
+}
+
+// IsFreePayload returns true if the transactions of this payload type don't pay any fee
+func IsFreePayload(t payload.Type) bool {
+	switch t {
+	case payload.PayloadTypeSortition,
+		payload.PayloadTypeUnbond,
+		payload.PayloadTypeSlash,
+		payload.PayloadTypeUnjail,
+		payload.PayloadTypeProposalVote:
+		return true
+	}
+	return false
 }
 
 // ---------
@@ -303,6 +486,53 @@ func GenerateTestBondTx() (*Tx, crypto.Signer) {
 	return tx, s
 }
 
+func GenerateTestBatchSendTx() (*Tx, crypto.Signer) {
+	stamp := hash.GenerateTestStamp()
+	s := bls.GenerateTestSigner()
+	receivers := []payload.BatchReceiver{
+		{Address: crypto.GenerateTestAddress(), Amount: 1000},
+		{Address: crypto.GenerateTestAddress(), Amount: 2000},
+	}
+	tx := NewBatchSendTx(stamp, 110, s.Address(), receivers, 1000, "test batch-send-tx")
+	s.SignMsg(tx)
+	return tx, s
+}
+
+func GenerateTestDelegateTx() (*Tx, crypto.Signer) {
+	stamp := hash.GenerateTestStamp()
+	s := bls.GenerateTestSigner()
+	tx := NewDelegateTx(stamp, 110, s.Address(), crypto.GenerateTestAddress(), 1000, 1000, "test delegate-tx")
+	s.SignMsg(tx)
+	return tx, s
+}
+
+func GenerateTestSlashTx() (*Tx, crypto.Signer) {
+	stamp := hash.GenerateTestStamp()
+	s := bls.GenerateTestSigner()
+	ev, _ := evidence.GenerateTestEvidence(100, 0)
+	tx := NewSlashTx(stamp, 110, s.Address(), ev)
+	s.SignMsg(tx)
+	return tx, s
+}
+
+func GenerateTestUnjailTx() (*Tx, crypto.Signer) {
+	stamp := hash.GenerateTestStamp()
+	s := bls.GenerateTestSigner()
+	tx := NewUnjailTx(stamp, 110, s.Address(), "test unjail-tx")
+	s.SignMsg(tx)
+	return tx, s
+}
+
+func GenerateTestProposalTx() (*Tx, crypto.Signer) {
+	stamp := hash.GenerateTestStamp()
+	s := bls.GenerateTestSigner()
+	minFee := int64(2000)
+	changes := param.Changes{MinimumFee: &minFee}
+	tx := NewProposalTx(stamp, 110, s.Address(), changes, 100000, 1000, "test proposal-tx")
+	s.SignMsg(tx)
+	return tx, s
+}
+
 func GenerateTestSortitionTx() (*Tx, crypto.Signer) {
 	stamp := hash.GenerateTestStamp()
 	s := bls.GenerateTestSigner()
@@ -3,15 +3,19 @@ package tx
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/param"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/tx/payload"
+	"github.com/zarbchain/zarb-go/validator"
 )
 
 func TestJSONMarshaling(t *testing.T) {
@@ -38,6 +42,40 @@ func TestBondEncodingTx(t *testing.T) {
 	require.Equal(t, tx.ID(), tx2.ID())
 }
 
+func TestBatchSendEncodingTx(t *testing.T) {
+	tx, _ := GenerateTestBatchSendTx()
+	bz, err := tx.Encode()
+	require.NoError(t, err)
+	var tx2 Tx
+	require.NoError(t, tx2.Decode(bz))
+	require.Equal(t, tx.ID(), tx2.ID())
+	require.Equal(t, tx.Payload(), tx2.Payload())
+	require.True(t, tx2.IsBatchSendTx())
+}
+
+func TestDelegationEncodingTx(t *testing.T) {
+	tx1, _ := GenerateTestDelegateTx()
+	stamp := hash.GenerateTestStamp()
+	s := bls.GenerateTestSigner()
+	tx2 := NewUndelegateTx(stamp, 111, s.Address(), crypto.GenerateTestAddress(), 1000, "test undelegate-tx")
+	s.SignMsg(tx2)
+	tx3 := NewWithdrawDelegationTx(stamp, 112, s.Address(), crypto.GenerateTestAddress(), 2000, 1000, "test withdraw-delegation-tx")
+	s.SignMsg(tx3)
+
+	for _, tx := range []*Tx{tx1, tx2, tx3} {
+		bz, err := tx.Encode()
+		require.NoError(t, err)
+		var tx4 Tx
+		require.NoError(t, tx4.Decode(bz))
+		require.Equal(t, tx.ID(), tx4.ID())
+		require.Equal(t, tx.Payload(), tx4.Payload())
+		require.NoError(t, tx4.SanityCheck())
+	}
+	assert.True(t, tx1.IsDelegateTx())
+	assert.True(t, tx2.IsUndelegateTx())
+	assert.True(t, tx3.IsWithdrawDelegationTx())
+}
+
 func TestEncodingTxNoSig(t *testing.T) {
 	tx, _ := GenerateTestSendTx()
 	bz, _ := tx.MarshalCBOR()
@@ -147,6 +185,69 @@ func TestInvalidSignature(t *testing.T) {
 	})
 }
 
+func TestMultisigTx(t *testing.T) {
+	ms, signers := bls.GenerateTestMultisig(2, 3)
+	stamp := hash.GenerateTestStamp()
+	trx := NewSendTx(stamp, 1, ms.Address(), crypto.GenerateTestAddress(), 1000, 1000, "multisig")
+
+	pubs := []*bls.PublicKey{}
+	sigs := []*bls.Signature{}
+	for _, s := range signers[:2] {
+		pubs = append(pubs, s.PublicKey().(*bls.PublicKey))
+		sigs = append(sigs, s.SignData(trx.SignBytes()).(*bls.Signature))
+	}
+	bitmap, aggSig, err := ms.Aggregate(pubs, sigs)
+	require.NoError(t, err)
+
+	t.Run("Good", func(t *testing.T) {
+		trx.SetMultisig(ms, bitmap, aggSig)
+		assert.NoError(t, trx.SanityCheck())
+
+		bs, err := trx.Encode()
+		require.NoError(t, err)
+		trx2 := new(Tx)
+		require.NoError(t, trx2.Decode(bs))
+		assert.NoError(t, trx2.SanityCheck())
+		assert.Equal(t, trx.ID(), trx2.ID())
+		assert.Equal(t, trx2.Signers(), bitmap)
+		assert.Equal(t, trx2.Multisig().Address(), ms.Address())
+	})
+
+	t.Run("Signing by one owner", func(t *testing.T) {
+		trx := cloneTx(t, trx)
+		signers[0].SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Not enough signers", func(t *testing.T) {
+		trx := cloneTx(t, trx)
+		trx.SetMultisig(ms, []byte{0x1}, sigs[0])
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid multisig", func(t *testing.T) {
+		ms2, _ := bls.GenerateTestMultisig(2, 3)
+		trx := cloneTx(t, trx)
+		trx.SetMultisig(ms2, bitmap, aggSig)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Having public key", func(t *testing.T) {
+		trx := cloneTx(t, trx)
+		trx.SetMultisig(ms, bitmap, aggSig)
+		trx.data.PublicKey = pubs[0]
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func cloneTx(t *testing.T, trx *Tx) *Tx {
+	bs, err := trx.Encode()
+	require.NoError(t, err)
+	cloned := new(Tx)
+	require.NoError(t, cloned.Decode(bs))
+	return cloned
+}
+
 func TestSendSanityCheck(t *testing.T) {
 	invAddr := crypto.GenerateTestAddress()
 	t.Run("Ok", func(t *testing.T) {
@@ -184,6 +285,73 @@ func TestSendSanityCheck(t *testing.T) {
 		signer.SignMsg(trx)
 		assert.Error(t, trx.SanityCheck())
 	})
+
+	t.Run("Invalid vesting schedule", func(t *testing.T) {
+		trx, signer := GenerateTestSendTx()
+		pld := trx.data.Payload.(*payload.SendPayload)
+		pld.Vesting = &payload.VestingSchedule{StartHeight: 100, CliffHeight: 300, EndHeight: 200}
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Ok, vesting schedule", func(t *testing.T) {
+		trx, signer := GenerateTestSendTx()
+		pld := trx.data.Payload.(*payload.SendPayload)
+		pld.Vesting = &payload.VestingSchedule{StartHeight: 100, CliffHeight: 200, EndHeight: 200}
+		signer.SignMsg(trx)
+		assert.NoError(t, trx.SanityCheck())
+	})
+}
+
+func TestBatchSendSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestBatchSendTx()
+		assert.NoError(t, trx.SanityCheck())
+		assert.Equal(t, trx.Payload().Value(), int64(3000))
+	})
+
+	t.Run("No receiver", func(t *testing.T) {
+		trx, signer := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers = nil
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Too many receivers", func(t *testing.T) {
+		trx, signer := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers = make([]payload.BatchReceiver, payload.MaximumBatchReceivers+1)
+		for i := range pld.Receivers {
+			pld.Receivers[i] = payload.BatchReceiver{Address: crypto.GenerateTestAddress(), Amount: 1}
+		}
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid amount", func(t *testing.T) {
+		trx, signer := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[1].Amount = -1
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Total amount overflow", func(t *testing.T) {
+		trx, signer := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[1].Amount = math.MaxInt64
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid receiver", func(t *testing.T) {
+		trx, signer := GenerateTestBatchSendTx()
+		pld := trx.data.Payload.(*payload.BatchSendPayload)
+		pld.Receivers[0].Address = crypto.TreasuryAddress
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
 }
 
 func TestBondSanityCheck(t *testing.T) {
@@ -208,6 +376,123 @@ func TestBondSanityCheck(t *testing.T) {
 		signer.SignMsg(trx)
 		assert.Error(t, trx.SanityCheck())
 	})
+
+	t.Run("Invalid commission", func(t *testing.T) {
+		trx, signer := GenerateTestBondTx()
+		pld := trx.data.Payload.(*payload.BondPayload)
+		pld.Commission = validator.MaxCommission + 1
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestDelegateSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestDelegateTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid stake", func(t *testing.T) {
+		trx, signer := GenerateTestDelegateTx()
+		pld := trx.data.Payload.(*payload.DelegatePayload)
+		pld.Stake = 0
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid validator", func(t *testing.T) {
+		trx, signer := GenerateTestDelegateTx()
+		pld := trx.data.Payload.(*payload.DelegatePayload)
+		pld.Validator = crypto.Address{}
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestSlashSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestSlashTx()
+		assert.NoError(t, trx.SanityCheck())
+	})
+
+	t.Run("No evidence", func(t *testing.T) {
+		trx, signer := GenerateTestSlashTx()
+		pld := trx.data.Payload.(*payload.SlashPayload)
+		pld.Evidence = nil
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+		assert.NotEmpty(t, trx.Fingerprint())
+	})
+
+	t.Run("Empty evidence", func(t *testing.T) {
+		trx, signer := GenerateTestSlashTx()
+		pld := trx.data.Payload.(*payload.SlashPayload)
+		pld.Evidence = new(evidence.Evidence)
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+		assert.NotEmpty(t, trx.Fingerprint())
+	})
+}
+
+func TestProposalSanityCheck(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		trx, _ := GenerateTestProposalTx()
+		assert.NoError(t, trx.SanityCheck())
+		assert.True(t, trx.IsProposalTx())
+		assert.False(t, trx.IsFreeTx())
+
+		bs, err := trx.Encode()
+		assert.NoError(t, err)
+		trx2 := new(Tx)
+		assert.NoError(t, trx2.Decode(bs))
+		assert.Equal(t, trx.ID(), trx2.ID())
+		assert.Equal(t, *trx2.Payload().(*payload.ProposalPayload).Changes.MinimumFee, int64(2000))
+	})
+
+	t.Run("Invalid params", func(t *testing.T) {
+		trx, signer := GenerateTestProposalTx()
+		pld := trx.data.Payload.(*payload.ProposalPayload)
+		committeeSize := 0
+		pld.Changes.CommitteeSize = &committeeSize
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("No changes", func(t *testing.T) {
+		trx, signer := GenerateTestProposalTx()
+		pld := trx.data.Payload.(*payload.ProposalPayload)
+		pld.Changes = param.Changes{}
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+
+	t.Run("Invalid activation height", func(t *testing.T) {
+		trx, signer := GenerateTestProposalTx()
+		pld := trx.data.Payload.(*payload.ProposalPayload)
+		pld.ActivationHeight = 0
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
+}
+
+func TestProposalVoteSanityCheck(t *testing.T) {
+	stamp := hash.GenerateTestStamp()
+	signer := bls.GenerateTestSigner()
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := NewProposalVoteTx(stamp, 1, signer.Address(), hash.GenerateTestHash(), "")
+		signer.SignMsg(trx)
+		assert.NoError(t, trx.SanityCheck())
+		assert.True(t, trx.IsProposalVoteTx())
+		assert.True(t, trx.IsFreeTx())
+		assert.Equal(t, trx.Addresses(), []crypto.Address{signer.Address()})
+	})
+
+	t.Run("Invalid proposal ID", func(t *testing.T) {
+		trx := NewProposalVoteTx(stamp, 1, signer.Address(), hash.UndefHash, "")
+		signer.SignMsg(trx)
+		assert.Error(t, trx.SanityCheck())
+	})
 }
 
 func TestSortitionSanityCheck(t *testing.T) {
@@ -339,6 +624,30 @@ func TestWithdrawSignBytes(t *testing.T) {
 
 }
 
+func TestUnjailSignBytes(t *testing.T) {
+	stamp := hash.GenerateTestStamp()
+	signer := bls.GenerateTestSigner()
+
+	trx1 := NewUnjailTx(stamp, 1, signer.Address(), "test unjail-tx")
+	signer.SignMsg(trx1)
+
+	trx2 := NewUnjailTx(stamp, 1, signer.Address(), "test unjail-tx")
+	trx3 := NewUnjailTx(stamp, 2, signer.Address(), "test unjail-tx")
+
+	assert.Equal(t, trx1.SignBytes(), trx2.SignBytes())
+	assert.NotEqual(t, trx1.SignBytes(), trx3.SignBytes())
+	assert.True(t, trx1.IsUnjailTx())
+	assert.True(t, trx1.IsFreeTx())
+	assert.Equal(t, trx1.Addresses(), []crypto.Address{signer.Address()})
+
+	bs, err := trx1.Encode()
+	assert.NoError(t, err)
+	trx4 := new(Tx)
+	assert.NoError(t, trx4.Decode(bs))
+	assert.Equal(t, trx1.ID(), trx4.ID())
+	assert.NoError(t, trx4.SanityCheck())
+}
+
 func TestSortitionSignBytes(t *testing.T) {
 	stamp := hash.GenerateTestStamp()
 	signer := bls.GenerateTestSigner()
@@ -354,3 +663,50 @@ func TestSortitionSignBytes(t *testing.T) {
 	assert.NotEqual(t, trx1.SignBytes(), trx3.SignBytes())
 	assert.True(t, trx1.IsSortitionTx())
 }
+
+func TestSerializeSize(t *testing.T) {
+	trx, signer := GenerateTestSendTx()
+	bs, err := trx.Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, trx.SerializeSize(), len(bs))
+
+	// Signing again should update the size
+	trx.SetSignature(nil)
+	trx.SetPublicKey(nil)
+	assert.Less(t, trx.SerializeSize(), len(bs))
+	signer.SignMsg(trx)
+	assert.Equal(t, trx.SerializeSize(), len(bs))
+}
+
+func TestAddresses(t *testing.T) {
+	stamp := hash.GenerateTestStamp()
+	addr1 := crypto.GenerateTestAddress()
+	addr2 := crypto.GenerateTestAddress()
+	pub, _ := bls.GenerateTestKeyPair()
+
+	trx1 := NewSendTx(stamp, 1, addr1, addr2, 100, 1000, "")
+	assert.Equal(t, trx1.Addresses(), []crypto.Address{addr1, addr2})
+
+	trx2 := NewSendTx(stamp, 1, addr1, addr1, 100, 1000, "")
+	assert.Equal(t, trx2.Addresses(), []crypto.Address{addr1})
+
+	trx3 := NewBondTx(stamp, 1, addr1, pub, 100, 1000, "")
+	assert.Equal(t, trx3.Addresses(), []crypto.Address{addr1, pub.Address()})
+
+	trx4 := NewSortitionTx(stamp, 1, addr1, sortition.GenerateRandomProof())
+	assert.Equal(t, trx4.Addresses(), []crypto.Address{addr1})
+
+	trx5 := NewUnbondTx(stamp, 1, addr1, "")
+	assert.Equal(t, trx5.Addresses(), []crypto.Address{addr1})
+
+	trx6 := NewWithdrawTx(stamp, 1, addr1, addr2, 100, 1000, "")
+	assert.Equal(t, trx6.Addresses(), []crypto.Address{addr1, addr2})
+
+	receivers := []payload.BatchReceiver{
+		{Address: addr2, Amount: 100},
+		{Address: addr1, Amount: 100},
+		{Address: addr2, Amount: 100},
+	}
+	trx7 := NewBatchSendTx(stamp, 1, addr1, receivers, 1000, "")
+	assert.Equal(t, trx7.Addresses(), []crypto.Address{addr1, addr2})
+}
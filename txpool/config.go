@@ -7,7 +7,7 @@ import (
 )
 
 type Config struct {
-	MaxSize int `toml:"" comment:"Maximum number of unconfirmed transaction inside pool. Default is 2000"`
+	MaxSize int `toml:"" comment:"Maximum number of unconfirmed send, bond, unbond, withdraw and sortition transactions inside pool. Other transactions have their own allotment on top of it. Default is 2000"`
 }
 
 func DefaultConfig() *Config {
@@ -50,6 +50,38 @@ func (conf *Config) sendPoolSize() int {
 	return int(float32(conf.MaxSize) * 0.8)
 }
 
+func (conf *Config) batchSendPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) delegatePoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) undelegatePoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) withdrawDelegationPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) slashPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) unjailPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) proposalPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) proposalVotePoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
 func (conf *Config) queryTimeout() time.Duration {
 	return time.Second * 2
 }
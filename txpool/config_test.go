@@ -17,6 +17,17 @@ func TestDefaultConfigCheck(t *testing.T) {
 			c.withdrawPoolSize()+
 			c.sortitionPoolSize(), c.MaxSize)
 
+	// Other pools have their own allotment, so the send pool is not reduced
+	assert.Equal(t,
+		c.batchSendPoolSize()+
+			c.delegatePoolSize()+
+			c.undelegatePoolSize()+
+			c.withdrawDelegationPoolSize()+
+			c.slashPoolSize()+
+			c.unjailPoolSize()+
+			c.proposalPoolSize()+
+			c.proposalVotePoolSize(), c.MaxSize*8/20)
+
 	c.MaxSize = 0
 	assert.Error(t, c.SanityCheck())
 }
@@ -1,16 +1,20 @@
 package txpool
 
 import (
+	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 )
 
 type Reader interface {
 	PrepareBlockTransactions() []*tx.Tx
 	PendingTx(id tx.ID) *tx.Tx
+	PendingTxsByShortID(key hash.Hash, shortIDs []uint64) ([]*tx.Tx, error)
 	QueryTx(id tx.ID) *tx.Tx
 	HasTx(id tx.ID) bool
 	Size() int
+	Occupancy(t payload.Type) float64
 	Fingerprint() string
 }
 
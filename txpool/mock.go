@@ -2,8 +2,10 @@ package txpool
 
 import (
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 )
 
 var _ TxPool = &MockTxPool{}
@@ -28,6 +30,21 @@ func (m *MockTxPool) PendingTx(id tx.ID) *tx.Tx {
 	return nil
 }
 
+func (m *MockTxPool) PendingTxsByShortID(key hash.Hash, shortIDs []uint64) ([]*tx.Tx, error) {
+	trxs := make([]*tx.Tx, len(shortIDs))
+	for i, shortID := range shortIDs {
+		for _, t := range m.Txs {
+			if t.ID().ShortID(key) == shortID {
+				if trxs[i] != nil && !trxs[i].ID().EqualsTo(t.ID()) {
+					return nil, errors.Errorf(errors.ErrGeneric, "short ID collision: %v", shortID)
+				}
+				trxs[i] = t
+			}
+		}
+	}
+	return trxs, nil
+}
+
 func (m *MockTxPool) QueryTx(id tx.ID) *tx.Tx {
 	return m.PendingTx(id)
 }
@@ -45,6 +62,10 @@ func (m *MockTxPool) Size() int {
 	return len(m.Txs)
 }
 
+func (m *MockTxPool) Occupancy(t payload.Type) float64 {
+	return 0
+}
+
 func (m *MockTxPool) Fingerprint() string {
 	return ""
 }
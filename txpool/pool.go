@@ -6,9 +6,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/execution"
 	"github.com/zarbchain/zarb-go/libs/linkedmap"
 	"github.com/zarbchain/zarb-go/logger"
+	"github.com/zarbchain/zarb-go/metrics"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/tx"
@@ -23,12 +27,14 @@ type txPool struct {
 	sandbox     sandbox.Sandbox
 	pools       map[payload.Type]*linkedmap.LinkedMap
 	broadcastCh chan message.Message
+	eventBus    *event.Bus
 	logger      *logger.Logger
 }
 
 func NewTxPool(
 	conf *Config,
-	broadcastCh chan message.Message) (TxPool, error) {
+	broadcastCh chan message.Message,
+	eventBus *event.Bus) (TxPool, error) {
 
 	pendings := make(map[payload.Type]*linkedmap.LinkedMap)
 
@@ -37,12 +43,21 @@ func NewTxPool(
 	pendings[payload.PayloadTypeUnbond] = linkedmap.NewLinkedMap(conf.unbondPoolSize())
 	pendings[payload.PayloadTypeWithdraw] = linkedmap.NewLinkedMap(conf.withdrawPoolSize())
 	pendings[payload.PayloadTypeSortition] = linkedmap.NewLinkedMap(conf.sortitionPoolSize())
+	pendings[payload.PayloadTypeBatchSend] = linkedmap.NewLinkedMap(conf.batchSendPoolSize())
+	pendings[payload.PayloadTypeDelegate] = linkedmap.NewLinkedMap(conf.delegatePoolSize())
+	pendings[payload.PayloadTypeUndelegate] = linkedmap.NewLinkedMap(conf.undelegatePoolSize())
+	pendings[payload.PayloadTypeWithdrawDelegation] = linkedmap.NewLinkedMap(conf.withdrawDelegationPoolSize())
+	pendings[payload.PayloadTypeSlash] = linkedmap.NewLinkedMap(conf.slashPoolSize())
+	pendings[payload.PayloadTypeUnjail] = linkedmap.NewLinkedMap(conf.unjailPoolSize())
+	pendings[payload.PayloadTypeProposal] = linkedmap.NewLinkedMap(conf.proposalPoolSize())
+	pendings[payload.PayloadTypeProposalVote] = linkedmap.NewLinkedMap(conf.proposalVotePoolSize())
 
 	pool := &txPool{
 		config:      conf,
 		checker:     execution.NewChecker(),
 		pools:       pendings,
 		broadcastCh: broadcastCh,
+		eventBus:    eventBus,
 	}
 
 	pool.logger = logger.NewLogger("_pool", pool)
@@ -65,9 +80,11 @@ func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 			if err := p.checkTx(trx); err != nil {
 				p.logger.Debug("invalid transaction after rechecking", "id", trx.ID())
 				pool.Remove(trx.ID())
+				p.eventBus.Publish(&event.TransactionRemovedEvent{ID: trx.ID()})
 			}
 		}
 	}
+	p.updateMetrics()
 }
 
 /// AppendTx validates the transaction and add it into the transaction pool
@@ -107,9 +124,43 @@ func (p *txPool) appendTx(trx *tx.Tx) error {
 		return err
 	}
 
+	if pool.Full() {
+		if err := p.makeRoom(pool, trx); err != nil {
+			return err
+		}
+	}
+
 	pool.PushBack(trx.ID(), trx)
 	p.logger.Debug("transaction appended into pool", "tx", trx)
 
+	p.eventBus.Publish(&event.TransactionAddedEvent{Transaction: trx})
+	metrics.SetTxPoolSize(trx.PayloadType(), pool.Size())
+
+	return nil
+}
+
+// makeRoom evicts the transaction with the lowest fee per byte from the full pool,
+// if the new transaction pays more per byte.
+func (p *txPool) makeRoom(pool *linkedmap.LinkedMap, trx *tx.Tx) error {
+	pools := make([]*linkedmap.LinkedMap, 0, len(p.pools))
+	for _, pl := range p.pools {
+		pools = append(pools, pl)
+	}
+	lowest := lowestPriorityTx(pool, pools...)
+	if lowest == nil {
+		return errors.Error(errors.ErrTxPoolFull)
+	}
+	if !trx.IsMintbaseTx() {
+		if signer(lowest).EqualsTo(signer(trx)) ||
+			feePerByte(trx) <= feePerByte(lowest) {
+			return errors.Error(errors.ErrTxPoolFull)
+		}
+	}
+
+	pool.Remove(lowest.ID())
+	p.logger.Debug("transaction evicted from pool", "id", lowest.ID(), "by", trx.ID())
+	p.eventBus.Publish(&event.TransactionRemovedEvent{ID: lowest.ID()})
+
 	return nil
 }
 
@@ -127,9 +178,17 @@ func (p *txPool) RemoveTx(id tx.ID) {
 
 	for _, pool := range p.pools {
 		if pool.Remove(id) {
+			p.eventBus.Publish(&event.TransactionRemovedEvent{ID: id})
 			break
 		}
 	}
+	p.updateMetrics()
+}
+
+func (p *txPool) updateMetrics() {
+	for t, pool := range p.pools {
+		metrics.SetTxPoolSize(t, pool.Size())
+	}
 }
 
 /// PendingTx searches inside the transaction pool and returns the associated transaction.
@@ -149,6 +208,35 @@ func (p *txPool) PendingTx(id tx.ID) *tx.Tx {
 	return nil
 }
 
+// PendingTxsByShortID searches inside the transaction pool for the transactions with these short IDs.
+// Short IDs are keyed by the given key, like the hash of the proposed block header.
+// The result has the same order as the short IDs and it is nil for the transactions that don't exist inside the pool.
+// It returns an error if more than one transaction inside the pool has the same short ID.
+func (p *txPool) PendingTxsByShortID(key hash.Hash, shortIDs []uint64) ([]*tx.Tx, error) {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	indexes := make(map[uint64][]int)
+	for i, shortID := range shortIDs {
+		indexes[shortID] = append(indexes[shortID], i)
+	}
+
+	trxs := make([]*tx.Tx, len(shortIDs))
+	for _, pool := range p.pools {
+		for e := pool.FirstElement(); e != nil; e = e.Next() {
+			trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+			for _, i := range indexes[trx.ID().ShortID(key)] {
+				if trxs[i] != nil {
+					return nil, errors.Errorf(errors.ErrGeneric, "short ID collision: %v", shortIDs[i])
+				}
+				trxs[i] = trx
+			}
+		}
+	}
+
+	return trxs, nil
+}
+
 /// QueryTx searches inside the transaction pool and returns the associated transaction.
 /// If transaction doesn't exist inside the pool, it queries from other nodes.
 func (p *txPool) QueryTx(id tx.ID) *tx.Tx {
@@ -176,48 +264,34 @@ func (p *txPool) QueryTx(id tx.ID) *tx.Tx {
 	return nil
 }
 
+// PrepareBlockTransactions returns the pending transactions sorted by fee per byte, regardless of their types.
+// Free transactions come first, in order of their types.
 func (p *txPool) PrepareBlockTransactions() []*tx.Tx {
-	trxs := make([]*tx.Tx, 0, p.Size())
-
 	p.lk.RLock()
 	defer p.lk.RUnlock()
 
-	// Appending one sortition transaction
-	poolSortition := p.pools[payload.PayloadTypeSortition]
-	for e := poolSortition.FirstElement(); e != nil; e = e.Next() {
-		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
-		trxs = append(trxs, trx)
+	// Order of the free transaction types inside the block
+	types := []payload.Type{
+		payload.PayloadTypeSlash,
+		payload.PayloadTypeUnjail,
+		payload.PayloadTypeSortition,
+		payload.PayloadTypeBond,
+		payload.PayloadTypeUnbond,
+		payload.PayloadTypeWithdraw,
+		payload.PayloadTypeDelegate,
+		payload.PayloadTypeUndelegate,
+		payload.PayloadTypeWithdrawDelegation,
+		payload.PayloadTypeProposal,
+		payload.PayloadTypeProposalVote,
+		payload.PayloadTypeSend,
+		payload.PayloadTypeBatchSend,
 	}
-
-	// Appending bond transactions
-	poolBond := p.pools[payload.PayloadTypeBond]
-	for e := poolBond.FirstElement(); e != nil; e = e.Next() {
-		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
-		trxs = append(trxs, trx)
-	}
-
-	// Appending unbond transactions
-	poolUnbond := p.pools[payload.PayloadTypeUnbond]
-	for e := poolUnbond.FirstElement(); e != nil; e = e.Next() {
-		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
-		trxs = append(trxs, trx)
+	pools := make([]*linkedmap.LinkedMap, 0, len(types))
+	for _, t := range types {
+		pools = append(pools, p.pools[t])
 	}
 
-	// Appending withdraw transactions
-	poolWithdraw := p.pools[payload.PayloadTypeWithdraw]
-	for e := poolWithdraw.FirstElement(); e != nil; e = e.Next() {
-		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
-		trxs = append(trxs, trx)
-	}
-
-	// Appending send transactions
-	poolSend := p.pools[payload.PayloadTypeSend]
-	for e := poolSend.FirstElement(); e != nil; e = e.Next() {
-		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
-		trxs = append(trxs, trx)
-	}
-
-	return trxs
+	return sortByPriority(pools...)
 }
 
 func (p *txPool) HasTx(id tx.ID) bool {
@@ -244,12 +318,32 @@ func (p *txPool) Size() int {
 	return size
 }
 
+// Occupancy returns the ratio of the pending transactions of the given type to the capacity of their pool
+func (p *txPool) Occupancy(t payload.Type) float64 {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	pool, ok := p.pools[t]
+	if !ok || pool.Capacity() == 0 {
+		return 0
+	}
+	return float64(pool.Size()) / float64(pool.Capacity())
+}
+
 func (p *txPool) Fingerprint() string {
-	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v}",
+	return fmt.Sprintf("{💸 %v 💰 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v 🤝 %v 🔪 %v 🗝 %v 📜 %v}",
 		p.pools[payload.PayloadTypeSend].Size(),
+		p.pools[payload.PayloadTypeBatchSend].Size(),
 		p.pools[payload.PayloadTypeBond].Size(),
 		p.pools[payload.PayloadTypeUnbond].Size(),
 		p.pools[payload.PayloadTypeSortition].Size(),
 		p.pools[payload.PayloadTypeWithdraw].Size(),
+		p.pools[payload.PayloadTypeDelegate].Size()+
+			p.pools[payload.PayloadTypeUndelegate].Size()+
+			p.pools[payload.PayloadTypeWithdrawDelegation].Size(),
+		p.pools[payload.PayloadTypeSlash].Size(),
+		p.pools[payload.PayloadTypeUnjail].Size(),
+		p.pools[payload.PayloadTypeProposal].Size()+
+			p.pools[payload.PayloadTypeProposalVote].Size(),
 	)
 }
@@ -8,27 +8,33 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/consensus/evidence"
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/bls"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/sandbox"
 	"github.com/zarbchain/zarb-go/sortition"
 	"github.com/zarbchain/zarb-go/sync/bundle/message"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	"github.com/zarbchain/zarb-go/validator"
 )
 
 var tPool *txPool
 var tSandbox *sandbox.MockSandbox
 var tCh chan message.Message
+var tEventBus *event.Bus
 var tTestTx *tx.Tx
 
 func setup(t *testing.T) {
 	logger.InitLogger(logger.TestConfig())
 	tCh = make(chan message.Message, 10)
+	tEventBus = event.NewBus()
 	tSandbox = sandbox.MockingSandbox()
-	p, err := NewTxPool(TestConfig(), tCh)
+	p, err := NewTxPool(TestConfig(), tCh, tEventBus)
 	assert.NoError(t, err)
 	p.SetNewSandboxAndRecheck(tSandbox)
 	tPool = p.(*txPool)
@@ -68,6 +74,53 @@ func TestAppendAndRemove(t *testing.T) {
 	assert.False(t, tPool.HasTx(tTestTx.ID()), "Transaction should be removed")
 }
 
+func TestPublishEvents(t *testing.T) {
+	setup(t)
+
+	sub := tEventBus.Subscribe()
+	defer sub.Unsubscribe()
+
+	assert.NoError(t, tPool.AppendTx(tTestTx))
+	// Appending the same transaction again, should not publish it again
+	assert.NoError(t, tPool.AppendTx(tTestTx))
+
+	e := <-sub.Events()
+	assert.Equal(t, e.(*event.TransactionAddedEvent).Transaction.ID(), tTestTx.ID())
+	assert.Empty(t, sub.Events())
+
+	tPool.RemoveTx(tTestTx.ID())
+	// Removing the same transaction again, should not publish it again
+	tPool.RemoveTx(tTestTx.ID())
+
+	e = <-sub.Events()
+	assert.Equal(t, e.(*event.TransactionRemovedEvent).ID, tTestTx.ID())
+	assert.Empty(t, sub.Events())
+}
+
+func TestAppendBatchSendTx(t *testing.T) {
+	setup(t)
+
+	hash10000 := hash.GenerateTestHash()
+	tSandbox.AppendNewBlock(10000, hash10000)
+
+	signer := makeSigner(10000000000)
+	receivers := []payload.BatchReceiver{
+		{Address: crypto.GenerateTestAddress(), Amount: 1000},
+		{Address: crypto.GenerateTestAddress(), Amount: 2000},
+	}
+	trx1 := tx.NewBatchSendTx(hash10000.Stamp(), tSandbox.AccSeq(signer.Address())+1, signer.Address(), receivers, 1000, "ok")
+	signer.SignMsg(trx1)
+	trx2 := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 1000, "ok")
+
+	assert.NoError(t, tPool.AppendTx(trx1))
+	assert.NoError(t, tPool.AppendTx(trx2))
+	assert.Equal(t, tPool.pools[payload.PayloadTypeBatchSend].Size(), 1)
+
+	trxs := tPool.PrepareBlockTransactions()
+	assert.Equal(t, trxs[0].ID(), trx2.ID())
+	assert.Equal(t, trxs[1].ID(), trx1.ID())
+}
+
 func TestAppendInvalidTransaction(t *testing.T) {
 	setup(t)
 
@@ -97,34 +150,188 @@ func TestPending(t *testing.T) {
 	assert.Nil(t, tPool.PendingTx(invID))
 }
 
-// TestFullPool tests if the pool prunes the old transactions when it is full
+func TestPendingTxsByShortID(t *testing.T) {
+	setup(t)
+
+	assert.NoError(t, tPool.AppendTx(tTestTx))
+
+	key := hash.GenerateTestHash()
+	invShortID := hash.GenerateTestHash().ShortID(key)
+	trxs, err := tPool.PendingTxsByShortID(key, []uint64{invShortID, tTestTx.ID().ShortID(key)})
+	assert.NoError(t, err)
+	assert.Len(t, trxs, 2)
+	assert.Nil(t, trxs[0])
+	assert.Equal(t, trxs[1].ID(), tTestTx.ID())
+
+	// Short IDs are keyed, another key doesn't match the transaction
+	trxs, err = tPool.PendingTxsByShortID(hash.GenerateTestHash(), []uint64{tTestTx.ID().ShortID(key)})
+	assert.NoError(t, err)
+	assert.Nil(t, trxs[0])
+}
+
+func makeSendTx(stamp hash.Stamp, signer crypto.Signer, amount int64, memo string) *tx.Tx {
+	fee := int64(float64(amount) * tSandbox.FeeFraction())
+	if fee < tSandbox.MinFee() {
+		fee = tSandbox.MinFee()
+	}
+	trx := tx.NewSendTx(stamp, tSandbox.AccSeq(signer.Address())+1, signer.Address(), crypto.GenerateTestAddress(), amount, fee, memo)
+	signer.SignMsg(trx)
+	return trx
+}
+
+func makeSigner(balance int64) crypto.Signer {
+	signer := bls.GenerateTestSigner()
+	acc := account.NewAccount(signer.Address(), 0)
+	acc.AddToBalance(balance)
+	tSandbox.UpdateAccount(acc)
+	return signer
+}
+
+// TestFullPool tests if the pool evicts the lowest fee transactions when it is full
 func TestFullPool(t *testing.T) {
 	setup(t)
 
 	hash10000 := hash.GenerateTestHash()
 	tSandbox.AppendNewBlock(10000, hash10000)
-	trxs := make([]*tx.Tx, tPool.config.sendPoolSize()+1)
-
-	signer := bls.GenerateTestSigner()
-	acc1 := account.NewAccount(signer.Address(), 0)
-	acc1.AddToBalance(10000000000)
-	tSandbox.UpdateAccount(acc1)
+	trxs := make([]*tx.Tx, tPool.config.sendPoolSize())
 
 	// Make sure the pool is empty
 	assert.Equal(t, tPool.Size(), 0)
 
 	for i := 0; i < len(trxs); i++ {
-		trx := tx.NewSendTx(hash10000.Stamp(), tSandbox.AccSeq(signer.Address())+1, signer.Address(), crypto.GenerateTestAddress(), 1000, 1000, "ok")
-		signer.SignMsg(trx)
+		trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 1000, "ok")
 		assert.NoError(t, tPool.AppendTx(trx))
 		trxs[i] = trx
 	}
+	assert.Equal(t, tPool.Size(), tPool.config.sendPoolSize())
+
+	t.Run("Same fee per byte, should be rejected", func(t *testing.T) {
+		trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 1000, "ok")
+		err := tPool.AppendTx(trx)
+		assert.Equal(t, errors.Code(err), errors.ErrTxPoolFull)
+		assert.False(t, tPool.HasTx(trx.ID()))
+		assert.True(t, tPool.HasTx(trxs[0].ID()))
+	})
+
+	t.Run("Lower fee per byte, should be rejected", func(t *testing.T) {
+		trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 1000, "a long memo makes the transaction bigger")
+		err := tPool.AppendTx(trx)
+		assert.Equal(t, errors.Code(err), errors.ErrTxPoolFull)
+		assert.False(t, tPool.HasTx(trx.ID()))
+		assert.True(t, tPool.HasTx(trxs[0].ID()))
+	})
+
+	t.Run("Higher fee, should evict the oldest lowest fee transaction", func(t *testing.T) {
+		trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 5000000, "ok")
+		assert.NoError(t, tPool.AppendTx(trx))
+		assert.False(t, tPool.HasTx(trxs[0].ID()))
+		assert.True(t, tPool.HasTx(trxs[1].ID()))
+		assert.True(t, tPool.HasTx(trx.ID()))
+	})
+
+	t.Run("Should not evict the previous transaction of the same signer", func(t *testing.T) {
+		tPool.pools[payload.PayloadTypeSend].Clear()
+
+		signer := makeSigner(10000000000)
+		trx1 := makeSendTx(hash10000.Stamp(), signer, 9000000, "ok")
+		assert.NoError(t, tPool.AppendTx(trx1))
+		for !tPool.pools[payload.PayloadTypeSend].Full() {
+			trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 20000000, "ok")
+			assert.NoError(t, tPool.AppendTx(trx))
+		}
+
+		trx2 := makeSendTx(hash10000.Stamp(), signer, 9500000, "ok")
+		err := tPool.AppendTx(trx2)
+		assert.Equal(t, errors.Code(err), errors.ErrTxPoolFull)
+		assert.True(t, tPool.HasTx(trx1.ID()))
+	})
+
+	t.Run("Subsidy transactions should be accepted and not evicted", func(t *testing.T) {
+		subsidyTx := tx.NewMintbaseTx(hash10000.Stamp(), 10001, crypto.GenerateTestAddress(), 25000000, "subsidy-tx")
+		assert.NoError(t, tPool.AppendTx(subsidyTx))
+		assert.True(t, tPool.HasTx(subsidyTx.ID()))
+
+		trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 30000000, "ok")
+		assert.NoError(t, tPool.AppendTx(trx))
+		assert.True(t, tPool.HasTx(subsidyTx.ID()))
+	})
 
-	assert.Nil(t, tPool.QueryTx(trxs[0].ID()))
-	assert.NotNil(t, tPool.QueryTx(trxs[1].ID()))
 	assert.Equal(t, tPool.Size(), tPool.config.sendPoolSize())
 }
 
+// TestFullPoolAcrossPools tests if the pool doesn't evict a transaction
+// when the next sequence of the signer is inside another pool
+func TestFullPoolAcrossPools(t *testing.T) {
+	setup(t)
+
+	hash10000 := hash.GenerateTestHash()
+	tSandbox.AppendNewBlock(10000, hash10000)
+
+	signer := makeSigner(10000000000)
+	trx1 := makeSendTx(hash10000.Stamp(), signer, 1000, "ok")
+	assert.NoError(t, tPool.AppendTx(trx1))
+
+	receivers := []payload.BatchReceiver{
+		{Address: crypto.GenerateTestAddress(), Amount: 1000},
+	}
+	trx2 := tx.NewBatchSendTx(hash10000.Stamp(), tSandbox.AccSeq(signer.Address())+1, signer.Address(), receivers, 1000, "ok")
+	signer.SignMsg(trx2)
+	assert.NoError(t, tPool.AppendTx(trx2))
+
+	others := []*tx.Tx{}
+	for !tPool.pools[payload.PayloadTypeSend].Full() {
+		trx := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 2000000, "ok")
+		assert.NoError(t, tPool.AppendTx(trx))
+		others = append(others, trx)
+	}
+
+	trx3 := makeSendTx(hash10000.Stamp(), makeSigner(10000000000), 5000000, "ok")
+	assert.NoError(t, tPool.AppendTx(trx3))
+
+	// trx1 has the lowest fee, but trx2 depends on it
+	assert.True(t, tPool.HasTx(trx1.ID()))
+	assert.True(t, tPool.HasTx(trx2.ID()))
+	assert.False(t, tPool.HasTx(others[0].ID()))
+	assert.True(t, tPool.HasTx(trx3.ID()))
+}
+
+func TestPrepareBlockTransactionsByFee(t *testing.T) {
+	setup(t)
+
+	hash10000 := hash.GenerateTestHash()
+	tSandbox.AppendNewBlock(10000, hash10000)
+
+	signerA := makeSigner(10000000000)
+	signerB := makeSigner(10000000000)
+	signerC := makeSigner(10000000000)
+
+	a1 := makeSendTx(hash10000.Stamp(), signerA, 1000, "")
+	assert.NoError(t, tPool.AppendTx(a1))
+	a2 := makeSendTx(hash10000.Stamp(), signerA, 9000000, "")
+	assert.NoError(t, tPool.AppendTx(a2))
+	b1 := makeSendTx(hash10000.Stamp(), signerB, 5000000, "")
+	assert.NoError(t, tPool.AppendTx(b1))
+	c1 := makeSendTx(hash10000.Stamp(), signerC, 2000000, "")
+	assert.NoError(t, tPool.AppendTx(c1))
+
+	// a2 has the highest fee, but it should come after a1
+	trxs := tPool.PrepareBlockTransactions()
+	require.Len(t, trxs, 4)
+	assert.Equal(t, trxs[0].ID(), b1.ID())
+	assert.Equal(t, trxs[1].ID(), c1.ID())
+	assert.Equal(t, trxs[2].ID(), a1.ID())
+	assert.Equal(t, trxs[3].ID(), a2.ID())
+}
+
+func TestOccupancy(t *testing.T) {
+	setup(t)
+
+	assert.Zero(t, tPool.Occupancy(payload.PayloadTypeSend))
+	assert.NoError(t, tPool.AppendTx(tTestTx))
+	assert.Equal(t, tPool.Occupancy(payload.PayloadTypeSend), 1/float64(TestConfig().sendPoolSize()))
+	assert.Zero(t, tPool.Occupancy(payload.PayloadTypeBond))
+}
+
 func TestEmptyPool(t *testing.T) {
 	setup(t)
 
@@ -161,6 +368,13 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	val3.AddToStake(10000000000)
 	tSandbox.UpdateValidator(val3)
 
+	val4Signer := bls.GenerateTestSigner()
+	val4Pub := val4Signer.PublicKey().(*bls.PublicKey)
+	val4 := validator.NewValidator(val4Pub, 0)
+	val4.AddToStake(10000000000)
+	val4.Jail(1)
+	tSandbox.UpdateValidator(val4)
+
 	sendTx := tx.NewSendTx(hash1000000.Stamp(), tSandbox.AccSeq(acc1.Address())+1, acc1.Address(), crypto.GenerateTestAddress(), 1000, 1000, "send-tx")
 	acc1Signer.SignMsg(sendTx)
 
@@ -171,26 +385,41 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	unbondTx := tx.NewUnbondTx(hash1000000.Stamp(), tSandbox.ValSeq(val1.Address())+1, val1.Address(), "unbond-tx")
 	val1Signer.SignMsg(unbondTx)
 
-	withdrawTx := tx.NewWithdrawTx(hash1000000.Stamp(), tSandbox.ValSeq(val2.Address())+1, val2.Address(), crypto.GenerateTestAddress(), 1000, 1000, "withdraw-tx")
+	withdrawTx := tx.NewWithdrawTx(hash1000000.Stamp(), tSandbox.ValSeq(val2.Address())+1, val2.Address(), crypto.GenerateTestAddress(), 1000, 2000, "withdraw-tx")
 	val2Signer.SignMsg(withdrawTx)
 
 	tSandbox.AcceptSortition = true
 	sortitionTx := tx.NewSortitionTx(hash1000000.Stamp(), tSandbox.ValSeq(val3.Address())+1, val3.Address(), sortition.GenerateRandomProof())
 	val3Signer.SignMsg(sortitionTx)
 
+	ev, offenderSigner := evidence.GenerateTestEvidence(999999, 0)
+	offender := validator.NewValidator(offenderSigner.PublicKey().(*bls.PublicKey), 0)
+	tSandbox.UpdateValidator(offender)
+	slashTx := tx.NewSlashTx(hash1000000.Stamp(), tSandbox.ValSeq(val3.Address())+1, val3.Address(), ev)
+	val3Signer.SignMsg(slashTx)
+
+	unjailTx := tx.NewUnjailTx(hash1000000.Stamp(), tSandbox.ValSeq(val4.Address())+1, val4.Address(), "unjail-tx")
+	val4Signer.SignMsg(unjailTx)
+
 	assert.NoError(t, tPool.AppendTx(sendTx))
 	assert.NoError(t, tPool.AppendTx(unbondTx))
 	assert.NoError(t, tPool.AppendTx(withdrawTx))
 	assert.NoError(t, tPool.AppendTx(bondTx))
 	assert.NoError(t, tPool.AppendTx(sortitionTx))
+	assert.NoError(t, tPool.AppendTx(slashTx))
+	assert.NoError(t, tPool.AppendTx(unjailTx))
 
+	// Free transactions come first, then the others by fee per byte.
+	// The bond transaction should come after the send transaction of the same account.
 	trxs := tPool.PrepareBlockTransactions()
-	assert.Len(t, trxs, 5)
-	assert.Equal(t, trxs[0].ID(), sortitionTx.ID())
-	assert.Equal(t, trxs[1].ID(), bondTx.ID())
-	assert.Equal(t, trxs[2].ID(), unbondTx.ID())
-	assert.Equal(t, trxs[3].ID(), withdrawTx.ID())
-	assert.Equal(t, trxs[4].ID(), sendTx.ID())
+	assert.Len(t, trxs, 7)
+	assert.Equal(t, trxs[0].ID(), slashTx.ID())
+	assert.Equal(t, trxs[1].ID(), unjailTx.ID())
+	assert.Equal(t, trxs[2].ID(), sortitionTx.ID())
+	assert.Equal(t, trxs[3].ID(), unbondTx.ID())
+	assert.Equal(t, trxs[4].ID(), withdrawTx.ID())
+	assert.Equal(t, trxs[5].ID(), sendTx.ID())
+	assert.Equal(t, trxs[6].ID(), bondTx.ID())
 }
 
 func TestAppendAndBroadcast(t *testing.T) {
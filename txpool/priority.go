@@ -0,0 +1,151 @@
+package txpool
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/libs/linkedmap"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/tx/payload"
+)
+
+// feePerByte is the priority of the transaction inside the pool
+func feePerByte(trx *tx.Tx) float64 {
+	return float64(trx.Fee()) / float64(trx.SerializeSize())
+}
+
+func signer(trx *tx.Tx) crypto.Address {
+	return trx.Payload().Signer()
+}
+
+// sequenceOwner is the owner of the sequence that a transaction increases.
+// Accounts and validators have their own sequences, even with the same address.
+type sequenceOwner struct {
+	addr      crypto.Address
+	validator bool
+}
+
+func owner(trx *tx.Tx) sequenceOwner {
+	switch trx.PayloadType() {
+	case payload.PayloadTypeSortition,
+		payload.PayloadTypeUnbond,
+		payload.PayloadTypeWithdraw,
+		payload.PayloadTypeSlash,
+		payload.PayloadTypeUnjail,
+		payload.PayloadTypeProposal,
+		payload.PayloadTypeProposalVote:
+		return sequenceOwner{addr: signer(trx), validator: true}
+	}
+	return sequenceOwner{addr: signer(trx)}
+}
+
+type pendingTx struct {
+	trx   *tx.Tx
+	order int // order of arriving into the pool
+}
+
+// priorityQueue keeps the first pending transaction of each sequence owner.
+// Free transactions come first, then the transaction with the highest fee per byte.
+type priorityQueue []*pendingTx
+
+func (pq priorityQueue) Len() int { return len(pq) }
+func (pq priorityQueue) Less(i, j int) bool {
+	freeI := pq[i].trx.IsFreeTx()
+	freeJ := pq[j].trx.IsFreeTx()
+	if freeI != freeJ {
+		return freeI
+	}
+	fi := feePerByte(pq[i].trx)
+	fj := feePerByte(pq[j].trx)
+	if fi != fj {
+		return fi > fj
+	}
+	return pq[i].order < pq[j].order
+}
+func (pq priorityQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *priorityQueue) Push(x interface{}) {
+	*pq = append(*pq, x.(*pendingTx))
+}
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+// sortByPriority returns the transactions of the pools sorted by fee per byte.
+// Free transactions come first, in order of the pools.
+// Transactions of the same sequence owner are kept in order of their sequences.
+func sortByPriority(pools ...*linkedmap.LinkedMap) []*tx.Tx {
+	owners := make(map[sequenceOwner][]*pendingTx)
+	order := 0
+	for _, pool := range pools {
+		for e := pool.FirstElement(); e != nil; e = e.Next() {
+			trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+			o := owner(trx)
+			owners[o] = append(owners[o], &pendingTx{trx: trx, order: order})
+			order++
+		}
+	}
+
+	pq := make(priorityQueue, 0, len(owners))
+	for _, pendings := range owners {
+		sort.SliceStable(pendings, func(i, j int) bool {
+			return pendings[i].trx.Sequence() < pendings[j].trx.Sequence()
+		})
+		pq = append(pq, pendings[0])
+	}
+	heap.Init(&pq)
+
+	trxs := make([]*tx.Tx, 0, order)
+	for pq.Len() > 0 {
+		item := heap.Pop(&pq).(*pendingTx)
+		trxs = append(trxs, item.trx)
+
+		o := owner(item.trx)
+		pendings := owners[o][1:]
+		owners[o] = pendings
+		if len(pendings) > 0 {
+			heap.Push(&pq, pendings[0])
+		}
+	}
+
+	return trxs
+}
+
+// lowestPriorityTx returns the transaction with the lowest fee per byte that can be evicted from the pool.
+// Only the last transaction of each sequence owner, among all the pools, can be evicted.
+// Otherwise the next transactions become invalid.
+// Subsidy transactions are never evicted.
+func lowestPriorityTx(pool *linkedmap.LinkedMap, pools ...*linkedmap.LinkedMap) *tx.Tx {
+	lasts := make(map[sequenceOwner]*tx.Tx)
+	for _, p := range pools {
+		for e := p.FirstElement(); e != nil; e = e.Next() {
+			trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+			if trx.IsMintbaseTx() {
+				continue
+			}
+			o := owner(trx)
+			last, ok := lasts[o]
+			if !ok || trx.Sequence() > last.Sequence() {
+				lasts[o] = trx
+			}
+		}
+	}
+
+	var lowest *tx.Tx
+	for e := pool.FirstElement(); e != nil; e = e.Next() {
+		trx := e.Value.(*linkedmap.Pair).Second.(*tx.Tx)
+		if lasts[owner(trx)] != trx {
+			continue
+		}
+		// In case of the same fee, the oldest transaction is evicted
+		if lowest == nil || feePerByte(trx) < feePerByte(lowest) {
+			lowest = trx
+		}
+	}
+
+	return lowest
+}
@@ -0,0 +1,123 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/util"
+)
+
+// DelegationKey identifies a delegation by its delegator and validator addresses
+type DelegationKey struct {
+	Delegator crypto.Address
+	Validator crypto.Address
+}
+
+// Delegation keeps the stake that a delegator has delegated to a validator,
+// and the rewards that the delegator has earned.
+type Delegation struct {
+	data delegationData
+}
+
+type delegationData struct {
+	Number            int            `cbor:"1,keyasint"`
+	Delegator         crypto.Address `cbor:"2,keyasint"`
+	Validator         crypto.Address `cbor:"3,keyasint"`
+	Stake             int64          `cbor:"4,keyasint"`
+	Reward            int64          `cbor:"5,keyasint"`
+	UnbondingHeight   int            `cbor:"6,keyasint"`
+	LastBondingHeight int            `cbor:"7,keyasint,omitempty"`
+}
+
+func NewDelegation(delegator, validator crypto.Address, number int) *Delegation {
+	return &Delegation{
+		data: delegationData{
+			Number:    number,
+			Delegator: delegator,
+			Validator: validator,
+		},
+	}
+}
+
+func (d *Delegation) Number() int               { return d.data.Number }
+func (d *Delegation) Delegator() crypto.Address { return d.data.Delegator }
+func (d *Delegation) Validator() crypto.Address { return d.data.Validator }
+func (d *Delegation) Stake() int64              { return d.data.Stake }
+func (d *Delegation) Reward() int64             { return d.data.Reward }
+func (d *Delegation) UnbondingHeight() int      { return d.data.UnbondingHeight }
+func (d *Delegation) LastBondingHeight() int    { return d.data.LastBondingHeight }
+
+func (d *Delegation) Key() DelegationKey {
+	return DelegationKey{Delegator: d.data.Delegator, Validator: d.data.Validator}
+}
+
+// AddToStake increases the delegated stake
+func (d *Delegation) AddToStake(amt int64) {
+	d.data.Stake += amt
+}
+
+// AddToReward increases the earned rewards
+func (d *Delegation) AddToReward(amt int64) {
+	d.data.Reward += amt
+}
+
+// UpdateLastBondingHeight updates the last height that the delegator delegated some stakes
+func (d *Delegation) UpdateLastBondingHeight(height int) {
+	d.data.LastBondingHeight = height
+}
+
+// UpdateUnbondingHeight updates the height that the delegator requested unbonding the stake
+func (d *Delegation) UpdateUnbondingHeight(height int) {
+	d.data.UnbondingHeight = height
+}
+
+// Hash return the hash of this delegation
+func (d *Delegation) Hash() hash.Hash {
+	bs, err := d.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return hash.CalcHash(bs)
+}
+
+func (d Delegation) Encode() ([]byte, error) {
+	return cbor.Marshal(d.data)
+}
+
+func (d *Delegation) Decode(bs []byte) error {
+	return cbor.Unmarshal(bs, &d.data)
+}
+
+func (d *Delegation) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(d.data)
+}
+
+func (d *Delegation) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &d.data)
+}
+
+func (d Delegation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.data)
+}
+
+func (d *Delegation) UnmarshalJSON(bs []byte) error {
+	return json.Unmarshal(bs, &d.data)
+}
+
+func (d Delegation) Fingerprint() string {
+	return fmt.Sprintf("{%s->%s %v}",
+		d.data.Delegator.Fingerprint(),
+		d.data.Validator.Fingerprint(),
+		d.data.Stake)
+}
+
+// GenerateTestDelegation generates a delegation for testing purpose
+func GenerateTestDelegation(number int) *Delegation {
+	d := NewDelegation(crypto.GenerateTestAddress(), crypto.GenerateTestAddress(), number)
+	d.data.Stake = util.RandInt64(1e12)
+	d.data.Reward = util.RandInt64(1e9)
+	return d
+}
@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/crypto"
+)
+
+func TestDelegationMarshaling(t *testing.T) {
+	d1 := GenerateTestDelegation(5)
+	d1.UpdateLastBondingHeight(90)
+	d1.UpdateUnbondingHeight(100)
+
+	bs, err := d1.Encode()
+	require.NoError(t, err)
+	d2 := new(Delegation)
+	require.NoError(t, d2.Decode(bs))
+	assert.Equal(t, d1.Hash(), d2.Hash())
+	assert.Equal(t, d1, d2)
+
+	js, err := json.Marshal(d1)
+	require.NoError(t, err)
+	d3 := new(Delegation)
+	require.NoError(t, json.Unmarshal(js, d3))
+	assert.Equal(t, d1, d3)
+
+	d4 := new(Delegation)
+	assert.Error(t, d4.Decode([]byte("asdfghjkl")))
+}
+
+func TestDelegationStakeAndReward(t *testing.T) {
+	delegator := crypto.GenerateTestAddress()
+	val := crypto.GenerateTestAddress()
+	d := NewDelegation(delegator, val, 3)
+
+	assert.Equal(t, d.Number(), 3)
+	assert.Equal(t, d.Key(), DelegationKey{Delegator: delegator, Validator: val})
+	assert.Zero(t, d.Stake())
+	assert.Zero(t, d.Reward())
+
+	d.AddToStake(1000)
+	d.AddToReward(10)
+	assert.Equal(t, d.Stake(), int64(1000))
+	assert.Equal(t, d.Reward(), int64(10))
+}
+
+func TestDelegatedStake(t *testing.T) {
+	val, _ := GenerateTestValidator(1)
+	val.data.Stake = 1000
+	val.AddToStake(500)
+	val.AddToDelegatedStake(500)
+
+	assert.Equal(t, val.Stake(), int64(1500))
+	assert.Equal(t, val.DelegatedStake(), int64(500))
+	assert.Equal(t, val.SelfStake(), int64(1000))
+
+	val.UpdateCommission(1000)
+	assert.Equal(t, val.Commission(), 1000)
+}
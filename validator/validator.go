@@ -10,6 +10,10 @@ import (
 	"github.com/zarbchain/zarb-go/crypto/hash"
 )
 
+// MaxCommission is the commission of a validator that takes all the delegators' reward.
+// The commission is in basis points, so the consensus state doesn't carry floating point numbers.
+const MaxCommission = 10000
+
 type Validator struct {
 	data validatorData
 }
@@ -22,6 +26,14 @@ type validatorData struct {
 	LastBondingHeight int            `cbor:"5,keyasint"`
 	UnbondingHeight   int            `cbor:"6,keyasint"`
 	LastJoinedHeight  int            `cbor:"7,keyasint"`
+	Commission        int            `cbor:"8,keyasint,omitempty"`
+	DelegatedStake    int64          `cbor:"9,keyasint,omitempty"`
+	MissedBlocks      int            `cbor:"10,keyasint,omitempty"`
+	JailedHeight      int            `cbor:"11,keyasint,omitempty"`
+	ProtocolVersion   int            `cbor:"12,keyasint,omitempty"`
+	SlashedHeight     int            `cbor:"13,keyasint,omitempty"`
+	MissedWindow      int            `cbor:"14,keyasint,omitempty"`
+	ProposalHeight    int            `cbor:"15,keyasint,omitempty"`
 }
 
 func NewValidator(publicKey *bls.PublicKey, number int) *Validator {
@@ -42,6 +54,20 @@ func (val *Validator) Stake() int64              { return val.data.Stake }
 func (val *Validator) LastBondingHeight() int    { return val.data.LastBondingHeight }
 func (val *Validator) UnbondingHeight() int      { return val.data.UnbondingHeight }
 func (val *Validator) LastJoinedHeight() int     { return val.data.LastJoinedHeight }
+func (val *Validator) Commission() int           { return val.data.Commission }
+func (val *Validator) DelegatedStake() int64     { return val.data.DelegatedStake }
+func (val *Validator) MissedBlocks() int         { return val.data.MissedBlocks }
+func (val *Validator) JailedHeight() int         { return val.data.JailedHeight }
+func (val *Validator) ProtocolVersion() int      { return val.data.ProtocolVersion }
+func (val *Validator) SlashedHeight() int        { return val.data.SlashedHeight }
+func (val *Validator) MissedWindow() int         { return val.data.MissedWindow }
+func (val *Validator) ProposalHeight() int       { return val.data.ProposalHeight }
+
+// IsJailed returns true if the validator is jailed because of downtime
+func (val *Validator) IsJailed() bool { return val.data.JailedHeight > 0 }
+
+// SelfStake returns the stake that is bonded by the validator itself, excluding the delegated stake
+func (val *Validator) SelfStake() int64 { return val.data.Stake - val.data.DelegatedStake }
 
 func (val Validator) Power() int64 {
 	//if the validator requested to unbond ignore stake
@@ -58,6 +84,17 @@ func (val *Validator) AddToStake(amt int64) {
 	val.data.Stake += amt
 }
 
+// AddToDelegatedStake increases the delegated stake by delegate transactions.
+// The delegated stake is part of the validator stake, so it should be added to the stake too.
+func (val *Validator) AddToDelegatedStake(amt int64) {
+	val.data.DelegatedStake += amt
+}
+
+// UpdateCommission updates the part of the delegators' reward that the validator takes, in basis points
+func (val *Validator) UpdateCommission(commission int) {
+	val.data.Commission = commission
+}
+
 // IncSequence increases the sequence anytime this validator signs a transaction
 func (val *Validator) IncSequence() {
 	val.data.Sequence++
@@ -78,6 +115,43 @@ func (val *Validator) UpdateUnbondingHeight(height int) {
 	val.data.UnbondingHeight = height
 }
 
+// IncMissedBlocks increases the number of blocks that this validator was absent in their certificates.
+// The counter belongs to a downtime window and it restarts from zero in a new window.
+func (val *Validator) IncMissedBlocks(window int) {
+	if val.data.MissedWindow != window {
+		val.data.MissedWindow = window
+		val.data.MissedBlocks = 0
+	}
+	val.data.MissedBlocks++
+}
+
+// Jail jails the validator at the given height. Jailed validators can't join the committee
+func (val *Validator) Jail(height int) {
+	val.data.JailedHeight = height
+	val.data.MissedBlocks = 0
+}
+
+// Unjail releases the validator from the jail
+func (val *Validator) Unjail() {
+	val.data.JailedHeight = 0
+	val.data.MissedBlocks = 0
+}
+
+// SetProtocolVersion records the latest protocol version that the validator signalled in its proposed blocks
+func (val *Validator) SetProtocolVersion(version int) {
+	val.data.ProtocolVersion = version
+}
+
+// UpdateSlashedHeight updates the height of the last offence that this validator is slashed for
+func (val *Validator) UpdateSlashedHeight(height int) {
+	val.data.SlashedHeight = height
+}
+
+// UpdateProposalHeight updates the activation height of the last proposal of this validator
+func (val *Validator) UpdateProposalHeight(height int) {
+	val.data.ProposalHeight = height
+}
+
 // Hash return the hash of this validator
 func (val *Validator) Hash() hash.Hash {
 	bs, err := val.Encode()
@@ -96,6 +170,14 @@ func (val *Validator) Decode(bs []byte) error {
 	return cbor.Unmarshal(bs, &val.data)
 }
 
+func (val *Validator) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(val.data)
+}
+
+func (val *Validator) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &val.data)
+}
+
 func (val Validator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(val.data)
 }
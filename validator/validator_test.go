@@ -18,6 +18,7 @@ func TestMarshaling(t *testing.T) {
 	val1.AddToStake(1)
 	val1.IncSequence()
 	val1.UpdateLastJoinedHeight(50)
+	val1.UpdateCommission(1500)
 
 	bs, err := val1.Encode()
 	require.NoError(t, err)
@@ -70,6 +71,28 @@ func TestIncSequence(t *testing.T) {
 	assert.Equal(t, val.Sequence(), seq+1)
 }
 
+func TestProtocolVersion(t *testing.T) {
+	val, _ := GenerateTestValidator(util.RandInt(1000))
+	h := val.Hash()
+	assert.Zero(t, val.ProtocolVersion())
+	val.SetProtocolVersion(2)
+	assert.Equal(t, val.ProtocolVersion(), 2)
+	assert.NotEqual(t, val.Hash(), h)
+}
+
+func TestMissedBlocks(t *testing.T) {
+	val, _ := GenerateTestValidator(util.RandInt(1000))
+	val.IncMissedBlocks(1)
+	val.IncMissedBlocks(1)
+	assert.Equal(t, val.MissedBlocks(), 2)
+	assert.Equal(t, val.MissedWindow(), 1)
+
+	// New window
+	val.IncMissedBlocks(3)
+	assert.Equal(t, val.MissedBlocks(), 1)
+	assert.Equal(t, val.MissedWindow(), 3)
+}
+
 func TestNumber(t *testing.T) {
 	val, _ := GenerateTestValidator(5)
 	assert.Equal(t, val.Number(), 5)
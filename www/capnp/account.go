@@ -21,3 +21,32 @@ func (zs zarbServer) GetAccount(args ZarbServer_getAccount) error {
 	res, _ := args.Results.NewResult()
 	return res.SetData(d)
 }
+
+func (zs *zarbServer) GetAccountTransactions(args ZarbServer_getAccountTransactions) error {
+	s, _ := args.Params.Address()
+	addr, err := crypto.AddressFromString(string(s))
+	if err != nil {
+		return fmt.Errorf("invalid address: %s", err)
+	}
+	page := int(args.Params.Page())
+	if page < 0 {
+		return fmt.Errorf("invalid page: %v", page)
+	}
+	trxs := zs.state.AccountTransactions(addr, page)
+
+	res, _ := args.Results.NewResult()
+	list, err := res.NewTransactions(int32(len(trxs)))
+	if err != nil {
+		return err
+	}
+	for i, trx := range trxs {
+		trxData, _ := trx.Encode()
+		if err := list.At(i).SetData(trxData); err != nil {
+			return err
+		}
+		if err := list.At(i).SetId(trx.ID().RawBytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -39,6 +39,8 @@ func (zs *zarbServer) GetNetworkInfo(args ZarbServer_getNetworkInfo) error {
 		p.SetReceivedMessages(int32(peer.ReceivedBundles))
 		p.SetInvalidMessages(int32(peer.InvalidBundles))
 		p.SetReceivedBytes(int32(peer.ReceivedBytes))
+		p.SetDroppedMessages(int32(peer.DroppedBundles))
+		p.SetScore(int32(peer.Score))
 	}
 
 	return nil
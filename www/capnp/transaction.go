@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/tx"
 )
 
@@ -26,6 +27,53 @@ func (zs *zarbServer) GetTransaction(args ZarbServer_getTransaction) error {
 	return res.SetId(trx.ID().RawBytes())
 }
 
+func (zs *zarbServer) GetTransactionProof(args ZarbServer_getTransactionProof) error {
+	s, _ := args.Params.Id()
+	h, err := hash.FromString(string(s))
+	if err != nil {
+		return fmt.Errorf("invalid transaction id: %s", err)
+	}
+	trx := zs.state.Transaction(h)
+	if trx == nil {
+		return fmt.Errorf("transaction not found")
+	}
+	height := zs.state.TransactionBlockHeight(h)
+	b := zs.state.Block(height)
+	if b == nil {
+		return fmt.Errorf("block not found")
+	}
+	proof := b.TxIDs().Proof(h)
+	if proof == nil {
+		return fmt.Errorf("unable to create proof")
+	}
+
+	res, _ := args.Results.NewResult()
+	trxData, _ := trx.Encode()
+	if err := res.SetData(trxData); err != nil {
+		return err
+	}
+	if err := res.SetId(trx.ID().RawBytes()); err != nil {
+		return err
+	}
+	if err := res.SetBlockHash(b.Hash().RawBytes()); err != nil {
+		return err
+	}
+	res.SetHeight(int64(height))
+	headerData, _ := b.Header().MarshalCBOR()
+	if err := res.SetHeader(headerData); err != nil {
+		return err
+	}
+	p, _ := res.NewProof()
+	p.SetIndex(int64(proof.Index))
+	siblings, _ := p.NewSiblings(int32(len(proof.Siblings)))
+	for i, sibling := range proof.Siblings {
+		if err := siblings.Set(i, sibling.RawBytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //Send the raw transaction
 func (zs *zarbServer) SendRawTransaction(args ZarbServer_sendRawTransaction) error {
 	rawTx, _ := args.Params.RawTx()
@@ -52,3 +100,45 @@ func (zs *zarbServer) SendRawTransaction(args ZarbServer_sendRawTransaction) err
 	return nil
 
 }
+
+// Simulate the raw transaction against the current state
+func (zs *zarbServer) SimulateTransaction(args ZarbServer_simulateTransaction) error {
+	rawTx, _ := args.Params.RawTx()
+
+	var trx tx.Tx
+	if err := trx.Decode(rawTx); err != nil {
+		return err
+	}
+
+	res, _ := args.Results.NewResult()
+	if err := res.SetId(trx.ID().RawBytes()); err != nil {
+		return err
+	}
+	sim, err := zs.state.SimulateTx(&trx)
+	if err != nil {
+		res.SetErrorCode(int32(errors.Code(err)))
+		return res.SetError(err.Error())
+	}
+	res.SetFee(sim.Fee)
+	accs, err := res.NewAccounts(int32(len(sim.Accounts)))
+	if err != nil {
+		return err
+	}
+	for i, acc := range sim.Accounts {
+		d, _ := acc.Encode()
+		if err := accs.Set(i, d); err != nil {
+			return err
+		}
+	}
+	vals, err := res.NewValidators(int32(len(sim.Validators)))
+	if err != nil {
+		return err
+	}
+	for i, val := range sim.Validators {
+		d, _ := val.Encode()
+		if err := vals.Set(i, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -778,6 +778,318 @@ func (p TransactionResult_Promise) Struct() (TransactionResult, error) {
 	return TransactionResult{s}, err
 }
 
+type AccountTransactionsResult struct{ capnp.Struct }
+
+// AccountTransactionsResult_TypeID is the unique identifier for the type AccountTransactionsResult.
+const AccountTransactionsResult_TypeID = 0x81e5278bffda3b25
+
+func NewAccountTransactionsResult(s *capnp.Segment) (AccountTransactionsResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return AccountTransactionsResult{st}, err
+}
+
+func NewRootAccountTransactionsResult(s *capnp.Segment) (AccountTransactionsResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return AccountTransactionsResult{st}, err
+}
+
+func ReadRootAccountTransactionsResult(msg *capnp.Message) (AccountTransactionsResult, error) {
+	root, err := msg.RootPtr()
+	return AccountTransactionsResult{root.Struct()}, err
+}
+
+func (s AccountTransactionsResult) String() string {
+	str, _ := text.Marshal(0x81e5278bffda3b25, s.Struct)
+	return str
+}
+
+func (s AccountTransactionsResult) Transactions() (TransactionResult_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return TransactionResult_List{List: p.List()}, err
+}
+
+func (s AccountTransactionsResult) HasTransactions() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s AccountTransactionsResult) SetTransactions(v TransactionResult_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewTransactions sets the transactions field to a newly
+// allocated TransactionResult_List, preferring placement in s's segment.
+func (s AccountTransactionsResult) NewTransactions(n int32) (TransactionResult_List, error) {
+	l, err := NewTransactionResult_List(s.Struct.Segment(), n)
+	if err != nil {
+		return TransactionResult_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// AccountTransactionsResult_List is a list of AccountTransactionsResult.
+type AccountTransactionsResult_List struct{ capnp.List }
+
+// NewAccountTransactionsResult creates a new list of AccountTransactionsResult.
+func NewAccountTransactionsResult_List(s *capnp.Segment, sz int32) (AccountTransactionsResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return AccountTransactionsResult_List{l}, err
+}
+
+func (s AccountTransactionsResult_List) At(i int) AccountTransactionsResult {
+	return AccountTransactionsResult{s.List.Struct(i)}
+}
+
+func (s AccountTransactionsResult_List) Set(i int, v AccountTransactionsResult) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s AccountTransactionsResult_List) String() string {
+	str, _ := text.MarshalList(0x81e5278bffda3b25, s.List)
+	return str
+}
+
+// AccountTransactionsResult_Promise is a wrapper for a AccountTransactionsResult promised by a client call.
+type AccountTransactionsResult_Promise struct{ *capnp.Pipeline }
+
+func (p AccountTransactionsResult_Promise) Struct() (AccountTransactionsResult, error) {
+	s, err := p.Pipeline.Struct()
+	return AccountTransactionsResult{s}, err
+}
+
+type MerkleProof struct{ capnp.Struct }
+
+// MerkleProof_TypeID is the unique identifier for the type MerkleProof.
+const MerkleProof_TypeID = 0x80095d19659782fb
+
+func NewMerkleProof(s *capnp.Segment) (MerkleProof, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return MerkleProof{st}, err
+}
+
+func NewRootMerkleProof(s *capnp.Segment) (MerkleProof, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return MerkleProof{st}, err
+}
+
+func ReadRootMerkleProof(msg *capnp.Message) (MerkleProof, error) {
+	root, err := msg.RootPtr()
+	return MerkleProof{root.Struct()}, err
+}
+
+func (s MerkleProof) String() string {
+	str, _ := text.Marshal(0x80095d19659782fb, s.Struct)
+	return str
+}
+
+func (s MerkleProof) Index() int64 {
+	return int64(s.Struct.Uint64(0))
+}
+
+func (s MerkleProof) SetIndex(v int64) {
+	s.Struct.SetUint64(0, uint64(v))
+}
+
+func (s MerkleProof) Siblings() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.DataList{List: p.List()}, err
+}
+
+func (s MerkleProof) HasSiblings() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s MerkleProof) SetSiblings(v capnp.DataList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewSiblings sets the siblings field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s MerkleProof) NewSiblings(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// MerkleProof_List is a list of MerkleProof.
+type MerkleProof_List struct{ capnp.List }
+
+// NewMerkleProof creates a new list of MerkleProof.
+func NewMerkleProof_List(s *capnp.Segment, sz int32) (MerkleProof_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return MerkleProof_List{l}, err
+}
+
+func (s MerkleProof_List) At(i int) MerkleProof { return MerkleProof{s.List.Struct(i)} }
+
+func (s MerkleProof_List) Set(i int, v MerkleProof) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s MerkleProof_List) String() string {
+	str, _ := text.MarshalList(0x80095d19659782fb, s.List)
+	return str
+}
+
+// MerkleProof_Promise is a wrapper for a MerkleProof promised by a client call.
+type MerkleProof_Promise struct{ *capnp.Pipeline }
+
+func (p MerkleProof_Promise) Struct() (MerkleProof, error) {
+	s, err := p.Pipeline.Struct()
+	return MerkleProof{s}, err
+}
+
+type TransactionProofResult struct{ capnp.Struct }
+
+// TransactionProofResult_TypeID is the unique identifier for the type TransactionProofResult.
+const TransactionProofResult_TypeID = 0x89d9da0594fd121e
+
+func NewTransactionProofResult(s *capnp.Segment) (TransactionProofResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	return TransactionProofResult{st}, err
+}
+
+func NewRootTransactionProofResult(s *capnp.Segment) (TransactionProofResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	return TransactionProofResult{st}, err
+}
+
+func ReadRootTransactionProofResult(msg *capnp.Message) (TransactionProofResult, error) {
+	root, err := msg.RootPtr()
+	return TransactionProofResult{root.Struct()}, err
+}
+
+func (s TransactionProofResult) String() string {
+	str, _ := text.Marshal(0x89d9da0594fd121e, s.Struct)
+	return str
+}
+
+func (s TransactionProofResult) Id() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s TransactionProofResult) HasId() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s TransactionProofResult) SetId(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s TransactionProofResult) Data() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s TransactionProofResult) HasData() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s TransactionProofResult) SetData(v []byte) error {
+	return s.Struct.SetData(1, v)
+}
+
+func (s TransactionProofResult) BlockHash() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return []byte(p.Data()), err
+}
+
+func (s TransactionProofResult) HasBlockHash() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
+
+func (s TransactionProofResult) SetBlockHash(v []byte) error {
+	return s.Struct.SetData(2, v)
+}
+
+func (s TransactionProofResult) Height() int64 {
+	return int64(s.Struct.Uint64(0))
+}
+
+func (s TransactionProofResult) SetHeight(v int64) {
+	s.Struct.SetUint64(0, uint64(v))
+}
+
+func (s TransactionProofResult) Header() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return []byte(p.Data()), err
+}
+
+func (s TransactionProofResult) HasHeader() bool {
+	p, err := s.Struct.Ptr(3)
+	return p.IsValid() || err != nil
+}
+
+func (s TransactionProofResult) SetHeader(v []byte) error {
+	return s.Struct.SetData(3, v)
+}
+
+func (s TransactionProofResult) Proof() (MerkleProof, error) {
+	p, err := s.Struct.Ptr(4)
+	return MerkleProof{Struct: p.Struct()}, err
+}
+
+func (s TransactionProofResult) HasProof() bool {
+	p, err := s.Struct.Ptr(4)
+	return p.IsValid() || err != nil
+}
+
+func (s TransactionProofResult) SetProof(v MerkleProof) error {
+	return s.Struct.SetPtr(4, v.Struct.ToPtr())
+}
+
+// NewProof sets the proof field to a newly
+// allocated MerkleProof struct, preferring placement in s's segment.
+func (s TransactionProofResult) NewProof() (MerkleProof, error) {
+	ss, err := NewMerkleProof(s.Struct.Segment())
+	if err != nil {
+		return MerkleProof{}, err
+	}
+	err = s.Struct.SetPtr(4, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// TransactionProofResult_List is a list of TransactionProofResult.
+type TransactionProofResult_List struct{ capnp.List }
+
+// NewTransactionProofResult creates a new list of TransactionProofResult.
+func NewTransactionProofResult_List(s *capnp.Segment, sz int32) (TransactionProofResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
+	return TransactionProofResult_List{l}, err
+}
+
+func (s TransactionProofResult_List) At(i int) TransactionProofResult {
+	return TransactionProofResult{s.List.Struct(i)}
+}
+
+func (s TransactionProofResult_List) Set(i int, v TransactionProofResult) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s TransactionProofResult_List) String() string {
+	str, _ := text.MarshalList(0x89d9da0594fd121e, s.List)
+	return str
+}
+
+// TransactionProofResult_Promise is a wrapper for a TransactionProofResult promised by a client call.
+type TransactionProofResult_Promise struct{ *capnp.Pipeline }
+
+func (p TransactionProofResult_Promise) Struct() (TransactionProofResult, error) {
+	s, err := p.Pipeline.Struct()
+	return TransactionProofResult{s}, err
+}
+
+func (p TransactionProofResult_Promise) Proof() MerkleProof_Promise {
+	return MerkleProof_Promise{Pipeline: p.Pipeline.GetPipeline(4)}
+}
+
 type AccountResult struct{ capnp.Struct }
 
 // AccountResult_TypeID is the unique identifier for the type AccountResult.
@@ -916,12 +1228,12 @@ type Peer struct{ capnp.Struct }
 const Peer_TypeID = 0xdeb9cfe7754f053f
 
 func NewPeer(s *capnp.Segment) (Peer, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 4})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 4})
 	return Peer{st}, err
 }
 
 func NewRootPeer(s *capnp.Segment) (Peer, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 4})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 4})
 	return Peer{st}, err
 }
 
@@ -1059,12 +1371,28 @@ func (s Peer) SetReceivedBytes(v int32) {
 	s.Struct.SetUint32(20, uint32(v))
 }
 
+func (s Peer) DroppedMessages() int32 {
+	return int32(s.Struct.Uint32(24))
+}
+
+func (s Peer) SetDroppedMessages(v int32) {
+	s.Struct.SetUint32(24, uint32(v))
+}
+
+func (s Peer) Score() int32 {
+	return int32(s.Struct.Uint32(28))
+}
+
+func (s Peer) SetScore(v int32) {
+	s.Struct.SetUint32(28, uint32(v))
+}
+
 // Peer_List is a list of Peer.
 type Peer_List struct{ capnp.List }
 
 // NewPeer creates a new list of Peer.
 func NewPeer_List(s *capnp.Segment, sz int32) (Peer_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 4}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 4}, sz)
 	return Peer_List{l}, err
 }
 
@@ -1257,65 +1585,219 @@ func (p SendTransactionResult_Promise) Struct() (SendTransactionResult, error) {
 	return SendTransactionResult{s}, err
 }
 
-type ZarbServer struct{ Client capnp.Client }
+type SimulateTransactionResult struct{ capnp.Struct }
 
-// ZarbServer_TypeID is the unique identifier for the type ZarbServer.
-const ZarbServer_TypeID = 0xf906e2ae0dd37fe4
+// SimulateTransactionResult_TypeID is the unique identifier for the type SimulateTransactionResult.
+const SimulateTransactionResult_TypeID = 0xef790ead838510c2
 
-func (c ZarbServer) GetBlock(ctx context.Context, params func(ZarbServer_getBlock_Params) error, opts ...capnp.CallOption) ZarbServer_getBlock_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      0,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlock",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlock_Params{Struct: s}) }
-	}
-	return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+func NewSimulateTransactionResult(s *capnp.Segment) (SimulateTransactionResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return SimulateTransactionResult{st}, err
 }
-func (c ZarbServer) GetBlockHeight(ctx context.Context, params func(ZarbServer_getBlockHeight_Params) error, opts ...capnp.CallOption) ZarbServer_getBlockHeight_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      1,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getBlockHeight",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlockHeight_Params{Struct: s}) }
-	}
-	return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func NewRootSimulateTransactionResult(s *capnp.Segment) (SimulateTransactionResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return SimulateTransactionResult{st}, err
 }
-func (c ZarbServer) GetTransaction(ctx context.Context, params func(ZarbServer_getTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_getTransaction_Results_Promise {
-	if c.Client == nil {
-		return ZarbServer_getTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xf906e2ae0dd37fe4,
-			MethodID:      2,
-			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
-			MethodName:    "getTransaction",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
+
+func ReadRootSimulateTransactionResult(msg *capnp.Message) (SimulateTransactionResult, error) {
+	root, err := msg.RootPtr()
+	return SimulateTransactionResult{root.Struct()}, err
+}
+
+func (s SimulateTransactionResult) String() string {
+	str, _ := text.Marshal(0xef790ead838510c2, s.Struct)
+	return str
+}
+
+func (s SimulateTransactionResult) Id() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s SimulateTransactionResult) HasId() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s SimulateTransactionResult) SetId(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s SimulateTransactionResult) ErrorCode() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s SimulateTransactionResult) SetErrorCode(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+func (s SimulateTransactionResult) Error() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s SimulateTransactionResult) HasError() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s SimulateTransactionResult) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s SimulateTransactionResult) SetError(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s SimulateTransactionResult) Fee() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s SimulateTransactionResult) SetFee(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+func (s SimulateTransactionResult) Accounts() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.DataList{List: p.List()}, err
+}
+
+func (s SimulateTransactionResult) HasAccounts() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
+
+func (s SimulateTransactionResult) SetAccounts(v capnp.DataList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewAccounts sets the accounts field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s SimulateTransactionResult) NewAccounts(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+func (s SimulateTransactionResult) Validators() (capnp.DataList, error) {
+	p, err := s.Struct.Ptr(3)
+	return capnp.DataList{List: p.List()}, err
+}
+
+func (s SimulateTransactionResult) HasValidators() bool {
+	p, err := s.Struct.Ptr(3)
+	return p.IsValid() || err != nil
+}
+
+func (s SimulateTransactionResult) SetValidators(v capnp.DataList) error {
+	return s.Struct.SetPtr(3, v.List.ToPtr())
+}
+
+// NewValidators sets the validators field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s SimulateTransactionResult) NewValidators(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(3, l.List.ToPtr())
+	return l, err
+}
+
+// SimulateTransactionResult_List is a list of SimulateTransactionResult.
+type SimulateTransactionResult_List struct{ capnp.List }
+
+// NewSimulateTransactionResult creates a new list of SimulateTransactionResult.
+func NewSimulateTransactionResult_List(s *capnp.Segment, sz int32) (SimulateTransactionResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4}, sz)
+	return SimulateTransactionResult_List{l}, err
+}
+
+func (s SimulateTransactionResult_List) At(i int) SimulateTransactionResult {
+	return SimulateTransactionResult{s.List.Struct(i)}
+}
+
+func (s SimulateTransactionResult_List) Set(i int, v SimulateTransactionResult) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s SimulateTransactionResult_List) String() string {
+	str, _ := text.MarshalList(0xef790ead838510c2, s.List)
+	return str
+}
+
+// SimulateTransactionResult_Promise is a wrapper for a SimulateTransactionResult promised by a client call.
+type SimulateTransactionResult_Promise struct{ *capnp.Pipeline }
+
+func (p SimulateTransactionResult_Promise) Struct() (SimulateTransactionResult, error) {
+	s, err := p.Pipeline.Struct()
+	return SimulateTransactionResult{s}, err
+}
+
+type ZarbServer struct{ Client capnp.Client }
+
+// ZarbServer_TypeID is the unique identifier for the type ZarbServer.
+const ZarbServer_TypeID = 0xf906e2ae0dd37fe4
+
+func (c ZarbServer) GetBlock(ctx context.Context, params func(ZarbServer_getBlock_Params) error, opts ...capnp.CallOption) ZarbServer_getBlock_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      0,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlock",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlock_Params{Struct: s}) }
+	}
+	return ZarbServer_getBlock_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetBlockHeight(ctx context.Context, params func(ZarbServer_getBlockHeight_Params) error, opts ...capnp.CallOption) ZarbServer_getBlockHeight_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      1,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getBlockHeight",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getBlockHeight_Params{Struct: s}) }
+	}
+	return ZarbServer_getBlockHeight_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetTransaction(ctx context.Context, params func(ZarbServer_getTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_getTransaction_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      2,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransaction",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
 	if params != nil {
 		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
 		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getTransaction_Params{Struct: s}) }
@@ -1422,6 +1904,66 @@ func (c ZarbServer) SendRawTransaction(ctx context.Context, params func(ZarbServ
 	}
 	return ZarbServer_sendRawTransaction_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
+func (c ZarbServer) GetTransactionProof(ctx context.Context, params func(ZarbServer_getTransactionProof_Params) error, opts ...capnp.CallOption) ZarbServer_getTransactionProof_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getTransactionProof_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      8,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransactionProof",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getTransactionProof_Params{Struct: s}) }
+	}
+	return ZarbServer_getTransactionProof_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) GetAccountTransactions(ctx context.Context, params func(ZarbServer_getAccountTransactions_Params) error, opts ...capnp.CallOption) ZarbServer_getAccountTransactions_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_getAccountTransactions_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      9,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getAccountTransactions",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_getAccountTransactions_Params{Struct: s}) }
+	}
+	return ZarbServer_getAccountTransactions_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c ZarbServer) SimulateTransaction(ctx context.Context, params func(ZarbServer_simulateTransaction_Params) error, opts ...capnp.CallOption) ZarbServer_simulateTransaction_Results_Promise {
+	if c.Client == nil {
+		return ZarbServer_simulateTransaction_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      10,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "simulateTransaction",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(ZarbServer_simulateTransaction_Params{Struct: s}) }
+	}
+	return ZarbServer_simulateTransaction_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
 
 type ZarbServer_Server interface {
 	GetBlock(ZarbServer_getBlock) error
@@ -1439,6 +1981,12 @@ type ZarbServer_Server interface {
 	GetNetworkInfo(ZarbServer_getNetworkInfo) error
 
 	SendRawTransaction(ZarbServer_sendRawTransaction) error
+
+	GetTransactionProof(ZarbServer_getTransactionProof) error
+
+	GetAccountTransactions(ZarbServer_getAccountTransactions) error
+
+	SimulateTransaction(ZarbServer_simulateTransaction) error
 }
 
 func ZarbServer_ServerToClient(s ZarbServer_Server) ZarbServer {
@@ -1448,7 +1996,7 @@ func ZarbServer_ServerToClient(s ZarbServer_Server) ZarbServer {
 
 func ZarbServer_Methods(methods []server.Method, s ZarbServer_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 8)
+		methods = make([]server.Method, 0, 11)
 	}
 
 	methods = append(methods, server.Method{
@@ -1563,6 +2111,48 @@ func ZarbServer_Methods(methods []server.Method, s ZarbServer_Server) []server.M
 		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      8,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getTransactionProof",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getTransactionProof{c, opts, ZarbServer_getTransactionProof_Params{Struct: p}, ZarbServer_getTransactionProof_Results{Struct: r}}
+			return s.GetTransactionProof(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      9,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "getAccountTransactions",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_getAccountTransactions{c, opts, ZarbServer_getAccountTransactions_Params{Struct: p}, ZarbServer_getAccountTransactions_Results{Struct: r}}
+			return s.GetAccountTransactions(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf906e2ae0dd37fe4,
+			MethodID:      10,
+			InterfaceName: "www/capnp/zarb.capnp:ZarbServer",
+			MethodName:    "simulateTransaction",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := ZarbServer_simulateTransaction{c, opts, ZarbServer_simulateTransaction_Params{Struct: p}, ZarbServer_simulateTransaction_Results{Struct: r}}
+			return s.SimulateTransaction(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
 	return methods
 }
 
@@ -1630,6 +2220,30 @@ type ZarbServer_sendRawTransaction struct {
 	Results ZarbServer_sendRawTransaction_Results
 }
 
+// ZarbServer_getTransactionProof holds the arguments for a server call to ZarbServer.getTransactionProof.
+type ZarbServer_getTransactionProof struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getTransactionProof_Params
+	Results ZarbServer_getTransactionProof_Results
+}
+
+// ZarbServer_getAccountTransactions holds the arguments for a server call to ZarbServer.getAccountTransactions.
+type ZarbServer_getAccountTransactions struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_getAccountTransactions_Params
+	Results ZarbServer_getAccountTransactions_Results
+}
+
+// ZarbServer_simulateTransaction holds the arguments for a server call to ZarbServer.simulateTransaction.
+type ZarbServer_simulateTransaction struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  ZarbServer_simulateTransaction_Params
+	Results ZarbServer_simulateTransaction_Results
+}
+
 type ZarbServer_getBlock_Params struct{ capnp.Struct }
 
 // ZarbServer_getBlock_Params_TypeID is the unique identifier for the type ZarbServer_getBlock_Params.
@@ -2831,157 +3445,693 @@ func (p ZarbServer_sendRawTransaction_Results_Promise) Result() SendTransactionR
 	return SendTransactionResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
 }
 
-const schema_84b56bd0975dfd33 = "x\xda\xacX}\x8c\x14g\x19\x7f\x9e\xf7\xdd\xd9\x8f\xbb" +
-	"\xbd\xdb\x9d\xce^\xda\x12\xe8\x1e\xe60\x80\xd0R\xae\xa4" +
-	"\x0dQ\x97\x9e\xb4\x1e-\xe8\xcd-\x1a{Zun\xf7" +
-	"\xe5n\xb9c\xf7\x98\x99\xbb\xa3(9\xbd\xda\x08X\xa8" +
-	"b\xb5\xb6\x11Rh\x8di+m\x8a\xa2=\"\xd1\x8b" +
-	"\xd5\x06\xd2\xa6\x14B,\x0d\xd4J\xa8\xb6M\x11\xd0\\" +
-	",QX\xf3\xbc\xb33;\xbb\xdc\x17\xc4\xffv\x9fy" +
-	"\xe6\xf9\xfc=\xbf\xe7\x9dw\x11\x06\x97\xb1[\x95b\x04" +
-	"@\xb7\x95`\xf1\xbd\x8b\x0f\xdf\x17L%\x1e\x04\xb5\x01" +
-	"\x8b\xcd\x97\xee\x7f\xecH\xcf\xfe\xef\x80\xc2C\x00\xcd\xef" +
-	"\x06jP\x1b\x0b\x84\x00\xb4\x0b\x81\x17\x00\x8b\x07\xce\x7f" +
-	"a\xed\xbb\x1f\\\xff \xa8\x8d\x08\xa0 )mQ\x8e" +
-	"\"\xa0\xb6SI\x01\x16\x8f\xb6\xffa\xf4\xa6O\xccy" +
-	"\xc8\xafpP\xd9G\x0a\xafI\x85\xdf<\xf2\xdc\xae\x85" +
-	"G6n\xf3+\x9cU\x0e\x90\xc2%R\xb8|\xf8\xe8" +
-	"G\xf3^\xb9\xb0MoD\x06@\xae\x9bg\x05\x87\xe9" +
-	"\xf1\xbc\xe0 `\xd1P\xd7\xbej\xfc\xf8\xb1\xed\x15\x11" +
-	"\x04\x9f \x85\xc7\x83\xe4\xe0\xfc\xb3?\x19\xda\x7f\xf2\xed" +
-	"\xed\xa07 \xf3%\x14\xa4,F\x82\xa7\xb5?\xd2\xaf" +
-	"\xe6\xd1\xe0+\x08X\\\xd4\xf1\x95c\xf7\xce\xfa\xd5#" +
-	"\x8e9\xe9ng\xf80B\xa0\x18\xd6~\xf1\xd5\xf5\xc9" +
-	"\x9eGAoD\xf7\xd1\x0f\xc22\xd0\xddar\xf4\xf2" +
-	"u\x1f\x8f\x0e\\\x9e\xbb\xdb\x1f\xc9hX\xd6\xe2\xb8T" +
-	"\xf8\xf2\xe0s\xe7z\xf1\xc5=~\x851\xc7\x82\x12!" +
-	"\x85\xeb\xdbj>}r\xd5\xc1\xa7\xaaJ/\x15\xe7D" +
-	"f\xa0\xb6$BA\xdf*\x95\x97G\x87\x0a\x97~\xf8" +
-	"\xaf\x97\xc6\xe9\x93\xa6G\xde\xd2\xee\x97\xba\xf7E\xa8M" +
-	"\xdd\xbf\xbe\x9c\x98y\xfb\xe0\xc1\xf1z:\x16Y\x8a\x9a" +
-	"RC\xcaXC\xca\xcf\xf4\x0c\xff\xf2\x83#\x9b\x0fR" +
-	"\xc1|\xdaN-j\x16\xa3\xb6W*?SCQ\xec" +
-	"|\xf4w\xcd_\xdb\xd5\xfd{\x7fN\x87j\xde\xa2\x9c" +
-	"NI\x85yc\xf3\xcf\xed=\xdd8:NN\xda\xa5" +
-	"\x9a\xc3Z\xa4\x96~)\xb5\xd4\xea\xb9\xd9\xef~\xd6\xea" +
-	"}\xcd)\xb0ckI\xed\x0e\xb2uW-\xf5\xfa\x9e" +
-	"\xf9\x7f\xda7\x12\xf8\xf3\xebU\x919\x9a?\xab\xed@" +
-	"mDZ\xdb/\xb5G:v\xcc1\xb6\xfe\xe5\x18\xf8" +
-	"\xed\xa9Q\x89\xbd\xd9Q\xd2H)\x9f\xef\xff\xfb\xeb#" +
-	"o\x93=\xee\xb3'\x01\xfe\xed\xe8Qm[T\xc2)" +
-	"z;\x03,\xee\x1a:\xd4\xd7\xf7\xb4\xfe\x8e\x0f\x1a'" +
-	"\xea\xf7\x114\x9e*~\xef\xf9m\xc33?\x1c\xafo" +
-	"\x87\xea\xe7\xa3v\xa2\x9e,\x1e\xaf\xa7\x82l\x9f\xf5\xb7" +
-	"\x9e\x7f\xaf<\xf1\x8f\x8a\xb0\xc6\xea\xf7H\x18\xc4(\xac" +
-	"o|\x7f\xcfl|\xf2\xc0\xb9*s\x8c\x14El\x06" +
-	"j\xfd12\xb7^*\xcf\xbc\xe9\xe7\xc3?m{o" +
-	"\xcc\xdf\x80S\xb1\xadd\xedl\x8c\xfc\x9d\x19:V\xf7" +
-	"\xfc\xe9\xe0EP\x1b|9\x026\xd7\xc5\x19j7\xc6" +
-	"\xe9\x85\x86x\x88i\x9b\xd4\x10@\xb1\xb6\xe1\xeb?z" +
-	"\xe1\xee\xbb/V\xd7X\xe6+\xd4\x1a\xd4\xfaI\xafy" +
-	"\xbd\x9a\xa4yiX\x19\xea\xf9\xe7\x1b-\xff\xf1\xbb\x7f" +
-	"\xf1\xbag\xc9\xfd\xe8u\xe4~pp\xf0\x96\x8c\xd1\x97" +
-	"\xe7}\xb7l4\xcc\xce\x9b\xe9w\xdf\xd2\x96\xdeB\xa6" +
-	"\xa7]X\xfd\xbd6@\x1b\xa2\x1e\xe5\x01\x80\x00\x02\xa8" +
-	"w\xcd\x07\xd0\x97q\xd4W2T\x11\x13T$u\x05" +
-	"\x09\x97s\xd4\xdb\x18\xaa\x8c%\x88\x0b\xd4U\x8b\x01\xf4" +
-	"V\x8e\xfaj\x86\xb1n\xc3\xea\xc6:`X\x07\x18\xcb" +
-	"\x1a\xb6\xe1\xfeIv\x92/\x8c\x97\x07\x06\x10\xe3\xbe\xc8" +
-	"\x82\x15\x91u\x18fgZ\x98\x03\xc2\xbc\xd9\x12\xf9l" +
-	"\xbb1\xb8\xda4\xf2\x96\x91\xb1s\x85|S\x9ba\x1a" +
-	"\xeb\xd0\xd2\x03^\xb8u\x14D\x98\xa3\x9e`\x984\x8d" +
-	"\xc1\xd5\x1b\\\xc7\x9e\x03e\"\x07]\xc2\x96\x85h\x15" +
-	"\xb9\xaen\xbb\xa9-I\xd6+\x8c\xcf/\x1b\xaf\xc8p" +
-	"Z\xb6?'\xec\xc1\x82\xd9\xb3\"\xbf\xa6\xd0\xd4\x9e\x92" +
-	"\xc5\xae0\xbe\xb4l<e\xca\xc7\x18/\x83\xaf\xaaJ" +
-	"S&\xe1\xd4\xc6\"\x93\xae\x87y\xe4\xa1\x89\xa3\xbe\x88" +
-	"Z\xc9\x9cV.l\x07\xd0\x17p\xd4\xef`\x98\xea\x96" +
-	"\x99c\x04\x18F\x00\x8b\x03\xc2\xec,X9\x1b\xf0\x01" +
-	"\x0c\x00\xc3\xc04\x03\xb83\x93)\xf4\xe7\xed&\x89(" +
-	">\x8d,=\xa6\xad\xca\x92U8i\x15F(+L" +
-	"\xc2g\xa3g\xf0\x8d\x16\x00\xfdU\x8e\xfa\x9b>|\x1e" +
-	"\xa7F\x1d\xe1\xa8\x9fd\x88%x\x9e0\x01\xf479" +
-	"\xeag\x18\xaa\x1c\x13\xc8\x01\xd4\xbfR\xf6\xefp\xd4?" +
-	"d\xa8\x06X\x02\x03\x00\xea\xfbd\xf2\x0cG\xfd<C" +
-	"U\xe1\x09T\x00\xd4\xb3{\x00\xf4\xf3\x1c\xf5\xff2T" +
-	"\x83\x81\x04\x06\x01\xd4\x8bd\xf3#\x8e\xe9\x002TC" +
-	"JB\xf2(\xe20@;rLG\x91\xe1\xd0\x800" +
-	"\xad\\!\xefV0f\xe7\xd6\x09T\x80\xa1\x02X\xec" +
-	"3\xc5\x00\xf5\x0b\x92=\xad~@Y\xb6a\x8bV\xc3" +
-	"\x02\xf4dC\xf6\x06\xabB\x87\xde\xfd\x8c0m\xcc\xad" +
-	"\xc9eH=Ta\xa1`\xda9;W\x80d>-" +
-	"D\xd6\xf7V\xa1\xaf`\x09\x13\xef\xccfMaYp" +
-	"\x05\x88\x83Sa+\xd3m\xe4\xf2\x12\xc7%\x90\xc1U" +
-	"\x0f\xd74\x07\xc0E\xe25\x84\xe6\xd0\x19N\x0d>o" +
-	"\xc1^\xc5\x88\xf9Ih\xda\xb3\xec\xad\xfd*G\x95\\" +
-	"\\\x1a\x1e\x0a\x9f\xf7\xda\x04\xf6\x09\x08\xc8\xcf\xaa\x13L" +
-	"LKo\x81gz\xaa\x08}\xe9x\x84>\\\xe6n" +
-	"\x8f\xd0\xf5\x8f\x01\xe8+9\xea_\x92\xd4`d\x85\x89" +
-	"\xf1\xf2\x01\xae\x94E5\x0a\x01\xe3\xe5\xad\xe5\xe8\x84\xec" +
-	"\x0d\x16\xc6\xcbg\x8fI\xf3\xf7\x956\xe5\xf4\xb0*\xfe" +
-	"\x19\xd3^H\x9d\xe5\xf8y.;\xee:*\xda%w" +
-	"\x10\xa2\x19\xad.\xe78kR\"\xac=\xe9E6A" +
-	"\xd7KT\xea\xce\xf9\xb5l\xb7i\xd3\xa7w\x10\x9b\x94" +
-	">Wo\xb0\x9c\xe5>\x8e\xb9&\x8a\xd8\xb0\xba\x85\x85" +
-	"\xf5\x80m\x1ce%\xea\xaf\x92\xef\xddu\xec[9-" +
-	"\xe5\x95\xe3\xf6\xca\xbfq\x86\x0c\x87\x84\xbc\xc2O\xb6r" +
-	"\x02\x15!\xa4E>\xeb\xab\x96o^\xc6\xdfx\x9e{" +
-	"\xc2\xcf\\\x8e\xfam\x0cSD\xb3\xfd\x96\xeb\xc9\x07\x92" +
-	"\xabf\x01\xf7\xb4\xe0s>c\xf2\xdc\xfd\xde&K\xbb" +
-	"\xb2\x8bmB\xa0\\\x81s]GZ\x04\x97\x02\xa4\x03" +
-	"\xb4p\xe2\xe8\xf9\xd2\xea\xb0\x05 \x1d&q\x82\xd6\x13" +
-	"C9\x16\x9a\x8a\x8b\x01\xd2Q\x92\xdf@r\xce\xe4." +
-	"\xd4\x1a\xa4\x998\xc9g\x92<\xc0\xe5:\xd4n\xc4v" +
-	"\x80\xf4\x0d$o\"\xb9\x82r#j\xb3\xa5\x9d\x99$" +
-	"\x9fK\xf2 \x93KQ\x9b#\xed4\x92|\x81\\\x8b" +
-	"\xdcY\x8b\xf3p+@z\x01\xc9\xef y8\x90\xc0" +
-	"0\x80\xb6\x84\xd6e\xfa6\x92/#yDI`\x04" +
-	"@\xfb\x14\x9a\x00\xe9O\x92\xbc\x15\xaf\xe8\xd5\xd0\xbaB" +
-	">\xd7#L\x8c\x02\xc3(`\xd2\xe8\x12y\xdb\xfd\x97" +
-	"\xea\x13\xc2\\\xb1\xdc\xfd[\xec\xeb\xef\xec\xcde\xee\x15" +
-	"T_\xf7\x855\xbdF\x97g\xce\x9dW\xb7\xf8\xa6\xc8" +
-	"\x88\xdc\x80\xc8\xe2*aYF\x97\xb0\x00\xbcg\xb9\xfc" +
-	"\x80\xd1\x9b\xcb\xaeB\xf7\xd1\x15oA\xb2\xe5\x01[X" +
-	"Wuh\xf2\x1f\x0fK`\x9a\x80\x86\xbeH\xde\x0d\xbb" +
-	"`\xb6\x8b\xd88,4\xe5\x8e\x98,\x0a\xcf6\x0ds" +
-	"\xa8\x0a\xd0\xff\xcfa\xaeL\xa9\x94\xfc\x94C\xec\x11\xfe" +
-	"\xc2\xc5\xa5)^\xce\xaa\x9b\x9d\xa4\xbf\x1e\x8f\xc5\xcb\x9f" +
-	"\x95\x80\xd3f4\xe7\x08\xed\xac\x1f\x0b`J\x06\xf6n" +
-	"i&]me\x1f\xe0\x1cb\x15\x00\xf7B\xc5\xf7\xe5" +
-	"x\xe1\x1e`\xea\xfb!,_\xd7\xa0{\xdb\xa1\x9e\xda" +
-	"\x08L=\x1eB\xe6}O\xa3{\x8f\xa1\x1e\xa2g\xa3" +
-	"!\xe4\xee\x97\xbb\xef6f\x7f\x070uo\x08\x03\xde" +
-	"\x07/\xba\xdf\x8a\xea\xee\xb5\xc0\xd4\xc7C\xa8x\xd7-" +
-	"\xe8^\x9e\xa8\xdbv\x00S\xb7\x840\xe8}o\xa3{" +
-	"E\xa4n\"\x7f\xfd!\x0cy\xf7N\xe8\xde?\xa8\xb9" +
-	"'\x80\xa9\"Ttk\x09\x00\xcb\xb0\xfc/\xe5\x1c\x03" +
-	"\x1d\x91\xa4QH9D\xea\x88\xe4F\x01\x9e/iH" +
-	"TB\x8cp\xe9\xb3\x82\xeea\x0f\x0b\x8eT\xa2\x08R" +
-	"\xce\x10-\xc3\xa2\xbbS\xd1\xe5iN\xf6\xdbp\xa2\xfe" +
-	"\xd01\xc6=\xc5\xc8\x0e%\xbc\xb6o\"\x9c\x7f\x93\xa3" +
-	"\xbe\xb9\x8c\xfd\x87\x08\x83\xdf\xe2\xa8?\\fWuK" +
-	"\x07\x80\xbe\x99\xa3\xfet\x99Z\xd5\xdd\xf4\xf6\x93\x1c\xf5" +
-	"\xdf\x96yU\x1d!\xe1K\x1c\xf5\x97\x19\x16\xe5Wq" +
-	"\xe5y?i\x16\xfa\xf3Y\x0c\x03\xc30`1SX" +
-	"\xb7.g\xdb\x02x\x19\xdc\x81\xd2\x926:-\x91\xb7" +
-	"\x85\x00\xbc\xe2\x91\x95\xeb\xca\x1bv\xbf\x09(\xae\x91\x08" +
-	"\xda\x85\x15\x9b\xd6\xf9\xd6\xbbwq\x86\xe0\x7f\x01\x00\x00" +
-	"\xff\xffT\x9f_X"
+type ZarbServer_getTransactionProof_Params struct{ capnp.Struct }
+
+// ZarbServer_getTransactionProof_Params_TypeID is the unique identifier for the type ZarbServer_getTransactionProof_Params.
+const ZarbServer_getTransactionProof_Params_TypeID = 0xfe238774e8fa0fd9
+
+func NewZarbServer_getTransactionProof_Params(s *capnp.Segment) (ZarbServer_getTransactionProof_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransactionProof_Params{st}, err
+}
+
+func NewRootZarbServer_getTransactionProof_Params(s *capnp.Segment) (ZarbServer_getTransactionProof_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransactionProof_Params{st}, err
+}
+
+func ReadRootZarbServer_getTransactionProof_Params(msg *capnp.Message) (ZarbServer_getTransactionProof_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getTransactionProof_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getTransactionProof_Params) String() string {
+	str, _ := text.Marshal(0xfe238774e8fa0fd9, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getTransactionProof_Params) Id() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_getTransactionProof_Params) HasId() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getTransactionProof_Params) SetId(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// ZarbServer_getTransactionProof_Params_List is a list of ZarbServer_getTransactionProof_Params.
+type ZarbServer_getTransactionProof_Params_List struct{ capnp.List }
+
+// NewZarbServer_getTransactionProof_Params creates a new list of ZarbServer_getTransactionProof_Params.
+func NewZarbServer_getTransactionProof_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getTransactionProof_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getTransactionProof_Params_List{l}, err
+}
+
+func (s ZarbServer_getTransactionProof_Params_List) At(i int) ZarbServer_getTransactionProof_Params {
+	return ZarbServer_getTransactionProof_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getTransactionProof_Params_List) Set(i int, v ZarbServer_getTransactionProof_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getTransactionProof_Params_List) String() string {
+	str, _ := text.MarshalList(0xfe238774e8fa0fd9, s.List)
+	return str
+}
+
+// ZarbServer_getTransactionProof_Params_Promise is a wrapper for a ZarbServer_getTransactionProof_Params promised by a client call.
+type ZarbServer_getTransactionProof_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getTransactionProof_Params_Promise) Struct() (ZarbServer_getTransactionProof_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getTransactionProof_Params{s}, err
+}
+
+type ZarbServer_getTransactionProof_Results struct{ capnp.Struct }
+
+// ZarbServer_getTransactionProof_Results_TypeID is the unique identifier for the type ZarbServer_getTransactionProof_Results.
+const ZarbServer_getTransactionProof_Results_TypeID = 0x9090e4cdf26bda5a
+
+func NewZarbServer_getTransactionProof_Results(s *capnp.Segment) (ZarbServer_getTransactionProof_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransactionProof_Results{st}, err
+}
+
+func NewRootZarbServer_getTransactionProof_Results(s *capnp.Segment) (ZarbServer_getTransactionProof_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getTransactionProof_Results{st}, err
+}
+
+func ReadRootZarbServer_getTransactionProof_Results(msg *capnp.Message) (ZarbServer_getTransactionProof_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getTransactionProof_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getTransactionProof_Results) String() string {
+	str, _ := text.Marshal(0x9090e4cdf26bda5a, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getTransactionProof_Results) Result() (TransactionProofResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return TransactionProofResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_getTransactionProof_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getTransactionProof_Results) SetResult(v TransactionProofResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated TransactionProofResult struct, preferring placement in s's segment.
+func (s ZarbServer_getTransactionProof_Results) NewResult() (TransactionProofResult, error) {
+	ss, err := NewTransactionProofResult(s.Struct.Segment())
+	if err != nil {
+		return TransactionProofResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_getTransactionProof_Results_List is a list of ZarbServer_getTransactionProof_Results.
+type ZarbServer_getTransactionProof_Results_List struct{ capnp.List }
+
+// NewZarbServer_getTransactionProof_Results creates a new list of ZarbServer_getTransactionProof_Results.
+func NewZarbServer_getTransactionProof_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getTransactionProof_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getTransactionProof_Results_List{l}, err
+}
+
+func (s ZarbServer_getTransactionProof_Results_List) At(i int) ZarbServer_getTransactionProof_Results {
+	return ZarbServer_getTransactionProof_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getTransactionProof_Results_List) Set(i int, v ZarbServer_getTransactionProof_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getTransactionProof_Results_List) String() string {
+	str, _ := text.MarshalList(0x9090e4cdf26bda5a, s.List)
+	return str
+}
+
+// ZarbServer_getTransactionProof_Results_Promise is a wrapper for a ZarbServer_getTransactionProof_Results promised by a client call.
+type ZarbServer_getTransactionProof_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getTransactionProof_Results_Promise) Struct() (ZarbServer_getTransactionProof_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getTransactionProof_Results{s}, err
+}
+
+func (p ZarbServer_getTransactionProof_Results_Promise) Result() TransactionProofResult_Promise {
+	return TransactionProofResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type ZarbServer_getAccountTransactions_Params struct{ capnp.Struct }
+
+// ZarbServer_getAccountTransactions_Params_TypeID is the unique identifier for the type ZarbServer_getAccountTransactions_Params.
+const ZarbServer_getAccountTransactions_Params_TypeID = 0xb3f44a65c55cceec
+
+func NewZarbServer_getAccountTransactions_Params(s *capnp.Segment) (ZarbServer_getAccountTransactions_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getAccountTransactions_Params{st}, err
+}
+
+func NewRootZarbServer_getAccountTransactions_Params(s *capnp.Segment) (ZarbServer_getAccountTransactions_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ZarbServer_getAccountTransactions_Params{st}, err
+}
+
+func ReadRootZarbServer_getAccountTransactions_Params(msg *capnp.Message) (ZarbServer_getAccountTransactions_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getAccountTransactions_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_getAccountTransactions_Params) String() string {
+	str, _ := text.Marshal(0xb3f44a65c55cceec, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getAccountTransactions_Params) Address() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_getAccountTransactions_Params) HasAddress() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getAccountTransactions_Params) SetAddress(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s ZarbServer_getAccountTransactions_Params) Page() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s ZarbServer_getAccountTransactions_Params) SetPage(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+// ZarbServer_getAccountTransactions_Params_List is a list of ZarbServer_getAccountTransactions_Params.
+type ZarbServer_getAccountTransactions_Params_List struct{ capnp.List }
+
+// NewZarbServer_getAccountTransactions_Params creates a new list of ZarbServer_getAccountTransactions_Params.
+func NewZarbServer_getAccountTransactions_Params_List(s *capnp.Segment, sz int32) (ZarbServer_getAccountTransactions_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return ZarbServer_getAccountTransactions_Params_List{l}, err
+}
+
+func (s ZarbServer_getAccountTransactions_Params_List) At(i int) ZarbServer_getAccountTransactions_Params {
+	return ZarbServer_getAccountTransactions_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getAccountTransactions_Params_List) Set(i int, v ZarbServer_getAccountTransactions_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getAccountTransactions_Params_List) String() string {
+	str, _ := text.MarshalList(0xb3f44a65c55cceec, s.List)
+	return str
+}
+
+// ZarbServer_getAccountTransactions_Params_Promise is a wrapper for a ZarbServer_getAccountTransactions_Params promised by a client call.
+type ZarbServer_getAccountTransactions_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getAccountTransactions_Params_Promise) Struct() (ZarbServer_getAccountTransactions_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getAccountTransactions_Params{s}, err
+}
+
+type ZarbServer_getAccountTransactions_Results struct{ capnp.Struct }
+
+// ZarbServer_getAccountTransactions_Results_TypeID is the unique identifier for the type ZarbServer_getAccountTransactions_Results.
+const ZarbServer_getAccountTransactions_Results_TypeID = 0xd116b8c7c465c1bf
+
+func NewZarbServer_getAccountTransactions_Results(s *capnp.Segment) (ZarbServer_getAccountTransactions_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getAccountTransactions_Results{st}, err
+}
+
+func NewRootZarbServer_getAccountTransactions_Results(s *capnp.Segment) (ZarbServer_getAccountTransactions_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_getAccountTransactions_Results{st}, err
+}
+
+func ReadRootZarbServer_getAccountTransactions_Results(msg *capnp.Message) (ZarbServer_getAccountTransactions_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_getAccountTransactions_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_getAccountTransactions_Results) String() string {
+	str, _ := text.Marshal(0xd116b8c7c465c1bf, s.Struct)
+	return str
+}
+
+func (s ZarbServer_getAccountTransactions_Results) Result() (AccountTransactionsResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return AccountTransactionsResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_getAccountTransactions_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_getAccountTransactions_Results) SetResult(v AccountTransactionsResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated AccountTransactionsResult struct, preferring placement in s's segment.
+func (s ZarbServer_getAccountTransactions_Results) NewResult() (AccountTransactionsResult, error) {
+	ss, err := NewAccountTransactionsResult(s.Struct.Segment())
+	if err != nil {
+		return AccountTransactionsResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_getAccountTransactions_Results_List is a list of ZarbServer_getAccountTransactions_Results.
+type ZarbServer_getAccountTransactions_Results_List struct{ capnp.List }
+
+// NewZarbServer_getAccountTransactions_Results creates a new list of ZarbServer_getAccountTransactions_Results.
+func NewZarbServer_getAccountTransactions_Results_List(s *capnp.Segment, sz int32) (ZarbServer_getAccountTransactions_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_getAccountTransactions_Results_List{l}, err
+}
+
+func (s ZarbServer_getAccountTransactions_Results_List) At(i int) ZarbServer_getAccountTransactions_Results {
+	return ZarbServer_getAccountTransactions_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_getAccountTransactions_Results_List) Set(i int, v ZarbServer_getAccountTransactions_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_getAccountTransactions_Results_List) String() string {
+	str, _ := text.MarshalList(0xd116b8c7c465c1bf, s.List)
+	return str
+}
+
+// ZarbServer_getAccountTransactions_Results_Promise is a wrapper for a ZarbServer_getAccountTransactions_Results promised by a client call.
+type ZarbServer_getAccountTransactions_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_getAccountTransactions_Results_Promise) Struct() (ZarbServer_getAccountTransactions_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_getAccountTransactions_Results{s}, err
+}
+
+func (p ZarbServer_getAccountTransactions_Results_Promise) Result() AccountTransactionsResult_Promise {
+	return AccountTransactionsResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type ZarbServer_simulateTransaction_Params struct{ capnp.Struct }
+
+// ZarbServer_simulateTransaction_Params_TypeID is the unique identifier for the type ZarbServer_simulateTransaction_Params.
+const ZarbServer_simulateTransaction_Params_TypeID = 0xea4f8e4e7afafcfa
+
+func NewZarbServer_simulateTransaction_Params(s *capnp.Segment) (ZarbServer_simulateTransaction_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_simulateTransaction_Params{st}, err
+}
+
+func NewRootZarbServer_simulateTransaction_Params(s *capnp.Segment) (ZarbServer_simulateTransaction_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_simulateTransaction_Params{st}, err
+}
+
+func ReadRootZarbServer_simulateTransaction_Params(msg *capnp.Message) (ZarbServer_simulateTransaction_Params, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_simulateTransaction_Params{root.Struct()}, err
+}
+
+func (s ZarbServer_simulateTransaction_Params) String() string {
+	str, _ := text.Marshal(0xea4f8e4e7afafcfa, s.Struct)
+	return str
+}
+
+func (s ZarbServer_simulateTransaction_Params) RawTx() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s ZarbServer_simulateTransaction_Params) HasRawTx() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_simulateTransaction_Params) SetRawTx(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// ZarbServer_simulateTransaction_Params_List is a list of ZarbServer_simulateTransaction_Params.
+type ZarbServer_simulateTransaction_Params_List struct{ capnp.List }
+
+// NewZarbServer_simulateTransaction_Params creates a new list of ZarbServer_simulateTransaction_Params.
+func NewZarbServer_simulateTransaction_Params_List(s *capnp.Segment, sz int32) (ZarbServer_simulateTransaction_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_simulateTransaction_Params_List{l}, err
+}
+
+func (s ZarbServer_simulateTransaction_Params_List) At(i int) ZarbServer_simulateTransaction_Params {
+	return ZarbServer_simulateTransaction_Params{s.List.Struct(i)}
+}
+
+func (s ZarbServer_simulateTransaction_Params_List) Set(i int, v ZarbServer_simulateTransaction_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_simulateTransaction_Params_List) String() string {
+	str, _ := text.MarshalList(0xea4f8e4e7afafcfa, s.List)
+	return str
+}
+
+// ZarbServer_simulateTransaction_Params_Promise is a wrapper for a ZarbServer_simulateTransaction_Params promised by a client call.
+type ZarbServer_simulateTransaction_Params_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_simulateTransaction_Params_Promise) Struct() (ZarbServer_simulateTransaction_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_simulateTransaction_Params{s}, err
+}
+
+type ZarbServer_simulateTransaction_Results struct{ capnp.Struct }
+
+// ZarbServer_simulateTransaction_Results_TypeID is the unique identifier for the type ZarbServer_simulateTransaction_Results.
+const ZarbServer_simulateTransaction_Results_TypeID = 0xffae9bfd910d3fbd
+
+func NewZarbServer_simulateTransaction_Results(s *capnp.Segment) (ZarbServer_simulateTransaction_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_simulateTransaction_Results{st}, err
+}
+
+func NewRootZarbServer_simulateTransaction_Results(s *capnp.Segment) (ZarbServer_simulateTransaction_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ZarbServer_simulateTransaction_Results{st}, err
+}
+
+func ReadRootZarbServer_simulateTransaction_Results(msg *capnp.Message) (ZarbServer_simulateTransaction_Results, error) {
+	root, err := msg.RootPtr()
+	return ZarbServer_simulateTransaction_Results{root.Struct()}, err
+}
+
+func (s ZarbServer_simulateTransaction_Results) String() string {
+	str, _ := text.Marshal(0xffae9bfd910d3fbd, s.Struct)
+	return str
+}
+
+func (s ZarbServer_simulateTransaction_Results) Result() (SimulateTransactionResult, error) {
+	p, err := s.Struct.Ptr(0)
+	return SimulateTransactionResult{Struct: p.Struct()}, err
+}
+
+func (s ZarbServer_simulateTransaction_Results) HasResult() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ZarbServer_simulateTransaction_Results) SetResult(v SimulateTransactionResult) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResult sets the result field to a newly
+// allocated SimulateTransactionResult struct, preferring placement in s's segment.
+func (s ZarbServer_simulateTransaction_Results) NewResult() (SimulateTransactionResult, error) {
+	ss, err := NewSimulateTransactionResult(s.Struct.Segment())
+	if err != nil {
+		return SimulateTransactionResult{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// ZarbServer_simulateTransaction_Results_List is a list of ZarbServer_simulateTransaction_Results.
+type ZarbServer_simulateTransaction_Results_List struct{ capnp.List }
+
+// NewZarbServer_simulateTransaction_Results creates a new list of ZarbServer_simulateTransaction_Results.
+func NewZarbServer_simulateTransaction_Results_List(s *capnp.Segment, sz int32) (ZarbServer_simulateTransaction_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return ZarbServer_simulateTransaction_Results_List{l}, err
+}
+
+func (s ZarbServer_simulateTransaction_Results_List) At(i int) ZarbServer_simulateTransaction_Results {
+	return ZarbServer_simulateTransaction_Results{s.List.Struct(i)}
+}
+
+func (s ZarbServer_simulateTransaction_Results_List) Set(i int, v ZarbServer_simulateTransaction_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ZarbServer_simulateTransaction_Results_List) String() string {
+	str, _ := text.MarshalList(0xffae9bfd910d3fbd, s.List)
+	return str
+}
+
+// ZarbServer_simulateTransaction_Results_Promise is a wrapper for a ZarbServer_simulateTransaction_Results promised by a client call.
+type ZarbServer_simulateTransaction_Results_Promise struct{ *capnp.Pipeline }
+
+func (p ZarbServer_simulateTransaction_Results_Promise) Struct() (ZarbServer_simulateTransaction_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return ZarbServer_simulateTransaction_Results{s}, err
+}
+
+func (p ZarbServer_simulateTransaction_Results_Promise) Result() SimulateTransactionResult_Promise {
+	return SimulateTransactionResult_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+const schema_84b56bd0975dfd33 = "x\xda\xacY\x7fpT\xd5\xf5\xbf\xe7\xbd\xdd\x9cM\xc2" +
+	"&y\xb9\x9b`2\x98\x0d~\xf1\xfb%\xf9\x8aB\xa2" +
+	"\xd3\x99\xf4G\x80\x88\x05\x04\xcd\xdd%-\xa4\xa4\xf5%" +
+	"\xfbH\x96$\xbb\xf1\xbdM\x02\xb4N$\xca(*\x08" +
+	"\x16\xea\x8f\x82#\xa8\xa3\xa8\xa8\xa0\xb4\xc2\x98QZ)" +
+	"\xd5\x91\x0a8L\x0dCme\xa0U\xc7\x16\xb4u*" +
+	"(\xbc\xce}\xfb\xde\xcb\xdb\xcd[\xf2\x83\xfeq2\x9b" +
+	"w\xce\xbd\xe7\xdcs\xcf\xf9\xdcs\xcf\x9d\xbe\"{\xa6" +
+	"0\xc3\x1b\xf7\x13\xc2\xb6x\xb3\xf4\xaf\xfb\x1fVJ\x9a" +
+	"\xb2\xef$\xac\x08@\xaf\xbe\xd0\xf4\xf0\xe1\xf6=w\x13" +
+	"/ !\xd5[\xb3r\x80\xee\xccB\x93z\x09\xa1\xdf" +
+	"E\xd4\xaf\xfe\xf6q\xfd\xfe\xff;\xbd\x9aHE\xc3\x86" +
+	"T\xe0*\xe0B&\xd5\x12B7#\xea\x1f\x9f{`" +
+	"IVm\xe0\xae\xb4!\"\x1f\xb2\x1as\x80nD4" +
+	"\xe9%Bh\x83\x0f\xf5}g\x1b\x96\x9f\xfet\xe2]" +
+	"D*\x07b\xce>\xcbw\x14h\x93\x0fM\xe2\xb3?" +
+	"\xeaC\xfdh\xe8\xb7\xfb\xcb\xfe\xff\xea5N\xd15\xbe" +
+	"\xdd@\xb7\xfa\xd0$.:\xe8C\xbd\xac\xf0\xc2&\xef" +
+	"\xf1\xc1\xb5\xe9\xeb\xf5\xf21\x07|\xcd\xc0\xa58U\x0f" +
+	"\xfa~\x08\x84P9\x07\xf5_?\xf8\xfc\xe3\xd3\x0e\xaf" +
+	"Z\xe7\x9c\x7fa\xce>\xa0J\x0e\x9a\xc4\xe7\xdf\x91\x83" +
+	"\x17\xdf9\xfaU\xc5\xc1\xcf\xd7\xb1r\x10\x08\xf1p\xc1" +
+	"\xcd9\xfd\xc0Y&q'V\xe4\xa2.K\xcb\xdf\x95" +
+	"\x7f\xf1\xf0z\xe7\x9cE\xb9\x8f\x01\x9d\x96\x8b&\xf19" +
+	"o\xcfE\xfd\xecs\x8f\xf4\xed9\xf1\xe1zn\xb3\xe0" +
+	"\xb09\x0b\x09\xa1M\xb9'i4\x179UGs\x0f" +
+	"r\x93\xef\xf0\xa3>\xbdq\xe9\xfb7_\xf9\xea\x83\xc9" +
+	"\xe9\x0dC\xa2\xfew\x80\xae\xf1\xa3E\x84\xd0\xd5~\xd4" +
+	"\x1b\x8f\xb7\x7fq\xe8\xd4\x86\x0dNC:\xfd'\x81\xae" +
+	"\xf5\xa3I\xdc\x90C~\xd4}\xf4\x85\x1f\xdf\x1el\xdf" +
+	"DX9X\xb3\xee\xf5\xef\x03z\xc4\x8f&qQ\x7f" +
+	"\x1e\xeao\x15\xfe\xef\x84\x9e\x8bS\xb79g=\xe7?" +
+	"\x0a\xb4(\x0fM\xe2\xa2K\xf2P\xffQ\xef\xf3g:" +
+	"`\xd7v\xa7\xe8\x9c\xbc}@\x9b\xf2\xd0$.\xba-" +
+	"\x0f\xf5\x89\xf59\xdf;\xb1p\xe0I\xb7\xc8[\x97W" +
+	"\x0atk\x1e\x9adlx\x1e\xea\x9f\xfda\xe9\x01e" +
+	"\xfe\xbf^1mN\xca\x1e\xc8\xfb\x02\xe8Gyh\x12" +
+	"\xdf\x93\xa6|\xd4o\x9c\xd0\x17\xbf\xf0\xf3\x7f\xbe\xe6\x12" +
+	"\xa5t^\xfeq\xda\x90\x8f&\xf1 \x9dV\x80z\xdb" +
+	"\xaf.\x06&}\xabw\xc0-\xaeK\x0aj\x80V\x14" +
+	"\xa0I|\xc8'\x05\xa8\xefh\xef\x7f\xe5\xd3\xc3\xf7\x0e" +
+	"\xa4E\xa0\xe1\xccc\x05U@O\x17\xa0I|\x0d\x93" +
+	"%\xd4\xb7nz\xa3\xfa'\x8f\xb7\xbd\xe9\xf4\x90_:" +
+	"\x0e\xb4BB\x93\xb8h\xa7\x84z\xc5\x97\x95gv\x9e" +
+	",\xdf\xef\xe2!\xbaDz\x87*\x12\x9a\xc4G\xec\x92" +
+	"\xf0\xe2\xd4\xc8=\xdf\xd7:\x0e9\xdd\xb3Uz\x08\xe8" +
+	"\x1e\x09M\xe2\xee\xb9\xa1\x10\xf5\xf9\x95\xbf\xdb\xbd\xd7\xf3" +
+	"\xc7\xf7\\\xb1bra#p)\x93\xf8\x98]\x85\xa8" +
+	"\xbf\xb1_y\xeb\xe0k\xc5G\x9c\xa6o-<\x0ft" +
+	"o!\x9a\xc4\x0d\xb9P\x88\xfa\xde\xc6\x87\xae\x96\xef\xfb" +
+	"\xf3\xfb);\xf5I\xe1n\xa0@\xd1$>\xedj\x8a" +
+	"z\xad\xf7\xd6\xee\xbf\xbd\xb7\xf7Cn\x8a\xc7a\x0a\xf7" +
+	"\"\xed\xa4G\xe9J\x8a\x9c\xaaW\xd2g\x04B\xe8\xba" +
+	"b\xd4\x1f\xef{\xbb\xab\xeb)\xf6\x17GJ\xac,\xde" +
+	"\x0dtc1ZdJ\x9e\xff\xe6\xfc\xaa[\xd6\xdf\xfa" +
+	"\xa9\xd3\xe8\x95\xc5\xc7\x81n.F\x93\xb8\xd1G\x8aQ" +
+	"\x7fR\xbf\xff\xc5u\xfd\x93>s\x8b\xc8\x81\xe2J\xa0" +
+	"\x87\x8a\xd1$>${\"\xea\xeb\xaf\xfck\xfb\xbf\x17" +
+	"\x0c\xfe#e\x9d_\x16o\x07\xea\x9f\x88&\xf1u\xae" +
+	"\x9b\x88\xfao\x0a\xd6\xdc\xb53o\xe5\x99\xf4\xd4OZ" +
+	"?q\x15p)N\xd5\xeb&\x1apuC\x09\xea?" +
+	"\xdd\xb0}2<\xb1\xefL\x9aM\x82\xb1M%\xa5@" +
+	"g\x94\xa0I\\\xcf\x81\x12\xd4'\x95=\xd3\xbf\xa5\xfe" +
+	"\xe3/\x9d+\xdeUr\x1f\xd0\xb7K\xd0$#\xb3K" +
+	"Q?\xd5\xf7\xbe\xff\xc5\x93Y\xe7\x88T$\x0e\xcdN" +
+	"\xa0\xfa\\\x89\x00\xd4[\x8a\x9c\xaa\xbd\xa5\x07\x05:8" +
+	"\x099\xe9\xb9E\xb7m~\xe9\xa6\x9b\xce\xa5\x07\x8e\xb1" +
+	"\x8a\x03\x93r\x80\x1e\x9b\x84\x9c\xaa\x8fM\x0a\xf2U\xcc" +
+	"*C\xbdh\x01\xb6\x7fqd\xf6\xd7N\x93\xa6\x95=" +
+	"\x07tN\x19\x9a\xc4MZ[\x86\xfa`\xfe\xf9\x8f\x13" +
+	"\xf7\xfc\xcfE\xa7hw\xd9q\xa0\x1b\xcb\xd0$\x03\xc2" +
+	"\xcaP\x1f\xa8\xf5o\xbc\xf0\xcb\x17u\xa7\xe8\xde\xb2\x93" +
+	"@\x8f\x95\xa1I\\\xb4$\x88zoo\xefu-r" +
+	"WL\xec\xban\x95\xac6_\xcb\x7fw\xd5,T\xd4" +
+	"\xf6\x0e\xa5^\x8d\xc7\x97\x11R\x0fP\x0f\x02\xf3\x89\x1e" +
+	"B<@\x88TQ%U \x9b*\x02\xbb^\x00\x80" +
+	"\x00\xf0\x8f3\xe6K7 \xbb^\x04V/@0\x1a" +
+	"\x8b(+\xeaA\x00/\xe1\x04\xba\x16m\xee\x88\xc6Z" +
+	"5\xc2\xe7\x13 \x8f@\xbd\x08\xe0'\xc6\xcf\x99`\xdb" +
+	"\xe1I\xb1cVKK\xbc;\x96X\xa4\xca1Mn" +
+	"ID\xe31\xad6\xa4h\xdd\x1d\x09\xd3(\x8fm\x94" +
+	"\x7f\xb9$!+H\x1a\xa5'\xcc\x11$\x9f\x8fqh" +
+	",\x18B3Bf\x82\x04X/@\x9a\x0d\xa9\xbe\x98" +
+	"\xdd\x11oiO*\xb5}1\xc1V;\xa7R\x9a\x83" +
+	"\xec\xc6\xe4\xb2%\xcb\x19\x0b+\xa5\x85\xc8\x16\x88\xc0\x16" +
+	"\x0b \x09B\x00\x04B\xa4\x86*\xa9\x01\xd9\"\x11X" +
+	"\x9b\x00\xf9m\xb2\xd6\xc6\x0d\xe3>\xf0\x13\xc8\x8f\xc8\x09" +
+	"\xd9\xf1\x7f\xb0\x99\xeb\xe5\x1f\x0a\x86 \xdbarA\x8a" +
+	"\xc9Y)&7\xcajsXQ{\x14\xf5ZM\x89" +
+	"EBr\xaf\xc3\x83S\xeaeU\xee\x04m\xb8\x07\xab" +
+	"$?\xb2\x09\"\xb0+\x04\x08\xaar\xef\xa2\x15\x0e{" +
+	"\x1c\xca\xbc\x99\x94\xb5*\x09\xc3[s\x95hk[b" +
+	"J}\x90krQT\xe9P\x94\xee\x87\x8c\xb1\xe0X" +
+	"\x82\x11\x98!E\xc3\xa1@\xb8\xc2\x9e\xfd\xd1R\xe9Q" +
+	"d\x8f\x88\xc0\x9er\xec\xc8\xb6Ji\x1b\xb2'D`" +
+	"/8vdGH\xda\x89\xec\x05\x11\xd8k\x02\x80\x18" +
+	"\x00\x91\x10iO\x8d\xb4\x07\xd9\xab\"\xb07\x05\x90<" +
+	"b\x00<\x84H\x035\xd2\x00\xb2\xd7E`\xbf\x17@" +
+	"\xf2z\x02\xe0%D:P%\x1d@\xf6\x96\x08\xec\x03" +
+	"\x01\xc4h\xe4\x12\x1b\xaa\x1b\x1b:W\xd6\x088\xd7[" +
+	"\xdbf8\xcb\x91+\xb5m\x8a\x1cQTg,t\xf1" +
+	"\x05'c\xc1\xaee3\xc6\xc2\xa5\xb6\xe7\x16%\xd1\x1b" +
+	"W\xdb\xe7\xc5\x96\xc5\xa7\x84j\x8d\xa0v\xd9\x9f\x1ak" +
+	"\x7f\xca\x05\xa8U\xcd|\xe3\xcam\xd0\x1d\x97r#6" +
+	"\x92\xe1\xa7\xb9\xa0J\x8d\x03U$\x10LX\x09Y\xb0" +
+	"2Sp\xfa*\x9bp\x02\xbdGQ\x9b\xe3Z4A" +
+	"`%\xff\xec!\x9cFk\x90\x09/S\x8c\xec\x16\xc7" +
+	"\xe4\x09\xbbH\xcb\xe8\x09!E\xf1\\EFcS\x0d" +
+	"\x0d\xe5\xb6\x86#\xb3\xa5#\xc8\x0e\x8b\xc0N8\xa2u" +
+	"\xb0R\x1aD\xf6\x81\x08\xec\x94\x00`\x06\xebG\xaat" +
+	"\x1a\xd9)\x11\xd8Y\x01$\x11\x92\xd1\xfa\xf7\x90\xf49" +
+	"\xb2\xb3\"\xb0ox\xb4\x0a\xc9h=7[:\x87\xec" +
+	"+\x11\xc2\x1e\xe0\xe1*\x1a\xe1J\x01\xb6\xd3l\xc0\xb0" +
+	"\x0fD\x08\x078'\xcb\x13\x80,B\xa8\x04*-\x02" +
+	"\x0c\x078\xa7\x9cs\xd0\x1b0\x0a\xaa+\xa1\x9fN\x06" +
+	"\x0c\x97s\xce5 @_\x8f\xa2j<\x0b\x87\xfc\x9d" +
+	"\x9f\x88v*N\xb8\xefR\x95\x1e\xbe\xdd$\xc8#\xde" +
+	"\x19\xef\xba\x96\x90\x13\xca\xb04\xe8K\xac\xd0\xd2%\xf9" +
+	"$u\x8a\x9a\x80\xe8\xb2h\x0b\x1f\x84\xe9S\xc5\xd5D" +
+	"4\x11\x8d\x93`,\xac(\x91\xd4\xb1\xf1\xae\xb8\xa6\xa8" +
+	"0+\x12Q\x15M#\xee\x10\x935R\xb8\xb6\xb4\xc9" +
+	"\xd1\x98\x91.f\xdc\x9a\x81[/zF9M:n" +
+	"\x19\xd1\x86c\xcb;\xfbB7\xfe\xbc31y\x14I" +
+	"\x7fE\x8ar3\xd1\xc6\xe7\xb3\xe4\xb1\x09cY\xaa}" +
+	"s\x18\xd7R\x9d\xe7\xdc8\xf0\xcd\xad6(\xb8Dm" +
+	"`\x02\x08_\xa6\xd8\x91\x18\xe1\xa0K;\x0fF\xe9Q" +
+	"\x97\x12(\xed`u@\xe8l\xb7\xc2\xacR\x9a\x81l" +
+	"\xba\x08\xec;\x02\xf4\xc9\xc9tp\x9eS]r\xab\xe2" +
+	"\x8e\x9dBz!$\xb6\xb4\x9bJ\x1d\x15P\x8dU\x01" +
+	"-v XC\xbf\xb4\x04\xd9b\x11X\x87\xe3\xbc\x8d" +
+	"^%E\x91\xb5\x89\xc0~&8\xcf\xb9\x82\xa1\xeb\x7f" +
+	"\xaa\xe7\x87a\x00I\x8a\xdb\xc5v\xaa8&VhI" +
+	"\x01\xfb\x8a8\xca\x9dt\xf87\xb5\xc2t,\xb4t\x0c" +
+	"\xa5^\xb3\xb5\xfc\xc8\x88u\x81]\xab\xa2\x09\xaa\xc3\x03" +
+	"\xc4\xa5\"5\x92,\x14\xccP\x0c\xa7$\xf3\xb0\x0ac" +
+	"\x9c\x85\xe38\x8eI\xfb6=\xcacr\xd1\x0a\x1ba" +
+	"]\xe7\x9f\xca\x97#km\x8av\xc9[\xc4h\x0e\xfd" +
+	"\xd42x\xa4$r\x96!.I4r\x1d\x92Z\xcc" +
+	"\x86\x95X\xc4\xe1\xdaT\x00\xc9P\x13\xd9\xb6\x94:\x12" +
+	"\xba\x96\x1f\xa7\xdd\x9aCgj\xb4]\x06\xca\x8c\x03?" +
+	"\xed\xa6\xe9e\x83wF\x88+\x1diwR\xd7?\xf2" +
+	"\xc6\xa4\x06`\xbd\xa2\x80U\xa5M\xb7\xb4\xd2YPC" +
+	"g\x01\x86g\xf2\x1ah\x01\xd8\x9a\xe9<\x98M\xe7\x01" +
+	"\x86\xe7r\xc6\"\xe0\x08\x00\x06\x02P\x06U\x94\x01\x86" +
+	"\xeb9g)\xe7\x88\x82Q\xb1\xd1%PC\x97\x00\x86" +
+	"\x17sN\x04\x86\xee\x18T\x86\x10U\x00\xc3\x11\xce\xe9" +
+	"\xe2\x1c/$\x0b\xb7N\xa8\xa2\x9d\x80\xe1\x0e\xceYa" +
+	"\x14nB\xb2p\xeb\x86\x1a\xda\x0d\x18Np\xce\x9d\x9c" +
+	"\x83b\xb2p\xbb\x03\xee\xa3k\x00\xc3ws\xce\x06\xce" +
+	"\xf1y\x02\xe0\xe3M\x17\xe8\xa7\x1b\x01\xc3\x1b8g\x0b" +
+	"\xe7d{\x03\x90\xcd\x1b\xcd\xa0\xd2\xad\x80\xe1-\x9c\xf3" +
+	",\xe7\xe4d\x05 \x87\x10\xfa4\xf4\xd3\x1d\x80\xe1g" +
+	"9\xe7U\xce\xc9\xc5\x00\xe4\xf2\x0e\x18T\xd1]\x80\xe1" +
+	"\x979\xe7up\x8b\xc9\xbe\xcex,\xda\x9e\xc4\xfa\x09" +
+	"\x84\x13\x04\xe5V%\x96p|\xa8\xedR\x14u\xde\x8d" +
+	"\x8e/zWwsG\xb4\xe5f\xc5\xdc;k\xe4\xb2" +
+	"\x0e\xb9\xd59\xbb\x03\xe0\xcc/\xba\xaa\xb4(\xd1\x1e%" +
+	"\x02\x0b\x15M\x93[\x15\x8d\x10';\x1a\xeb\x91;\xa2" +
+	"\x91\x85`q\xdd\xc6\x92\xe0\xec\x95\x09\xc5\xa9H\x8f\xa8" +
+	"\xf1\xae.\xc5}\\Pk\x89\xab\xca\xd8/!\xce+" +
+	"YJ\xd4\x8f\xb2\xc4\xd4\xa2\x9d\xdd\x1drBI\xbf\xe2" +
+	"\x8b\x9d\x97q\xc5O=p~\xc0\x9d%'\xe2jH" +
+	"\xc9\xd7\xba/\xa3\xd0\xb9\x94\x1fl%\xdcz\x1c}y" +
+	"\xf3\xdfF\xe6\xe1\xeeL-\x082t\x1a\xecFCH" +
+	"z\x1a\xd9S\"\xb0\x97\x87\xd0@\xdaY\xe5h4\xd8" +
+	"w\xb7=W\xa5t\x1a\xcc\xbb\xdb\xc0|i?\xb27" +
+	"\xcd+\xa1yu\x93\x06\x1b\xa5?!;!\x02\xfbj" +
+	"\x18\xc6)\xaa\x1aW\xeb\xe2\x11\x02\xce\xf8\x0b\x1a\x9f\x1d" +
+	"\x89\x83\xcb\x94\x94K\x9a\x9c\x04}\xf7\x9e\x9c\xdec\xee" +
+	"\x07\x11\xd5K\x1f\xb6\xa9\xb1b\x86\xf3\xa8N4\xbb\x88" +
+	"\x9aQe\x1di\x8b\x04\x17 \x08\xf2/\xa9]<\xbb" +
+	"7\x9e\xb1\x8b7r'\"\xb9\xad\x97.8\xd2O8" +
+	"\xfb\x8do\x94u\xe5\x90^K\xcb5\xa2\x97\x10\xeb\x09" +
+	"m\xa8'M\x19\xcc\xa7\x0d\x80u\x8b\x00\xea\x16\x03\xd0" +
+	"&@\x00\xfb\xd5\x0f\xacg)\xca`\xd509\xc1~" +
+	"W\x00\xeb\xa1\xc9UN\xb4\x1eB\x86^\xe6(\x83\xc6" +
+	"ar\x1e\xbb\x7f\x0fV\x87\x9a2X>L\xcek?" +
+	"\xc1\x81\xf5\x16F\x19<\xc4\x8f7.S\xb7\x14\x80\xca" +
+	"\x80\x90e\xbfK\x80\xf5\xccH\x1b`\xd50\xb9\xa1\x07" +
+	"Q\xb0\x9e\x83h\x03<\xc6uq\x99\xba\xdb\x00\xf8\x01" +
+	"\x09>\xbb\x1b\x0e\xd6\xcb\x1e]\x02\xdb\xf9\x1c\\\xa6." +
+	"\x02@\xa3\x80\x90m\xbf\x8c\x81\xf5FC\x9b`\x1f\x9f" +
+	"\x83\xcb\xd4\xb5\x01\xf0c\x15r\xec\xd7\x10\xb0z\xe7T" +
+	"\x86\xed|\x0e.S\xd7\x01@o\x07\xd4\xad\xd0If" +
+	"Lr\xfb\x93\x7fg\xc2\x10\xb3v\xaeu\x1e\x0d\x970" +
+	"`\x85\xd4\x9a\xfd\x007\x09\xa3\x14#b\xcc}\xbc\x81" +
+	"\x92$\x9f\xe3df\x0b\xc0\xba\x81C\xdcU\xc8\xc8Q" +
+	"R\x9b<t\x86KX\xa5?X\x10(\xc6c\x99\x17" +
+	"\x03\xe6ZTL\xf6(3\xac\x08\xac\xea\xb2\xd6\x10\xd7" +
+	"\\\xd4\x9a\xc8\x0b\x16\xf4\xa2\x9b\x8b\xea!s\xb2\xf1\x8b" +
+	"\xa2uO\xb4\xd2-`'\xf5\x1d!i5\xb2;E" +
+	"`\x0f\x0c\xc1\xf5\xda*i-\xb2{E`\x9b\x1cp" +
+	"\xbd\xb1Q\xda\x8cl\x93\x09\xe2f\xe1&\xed\x0cI\xbb" +
+	"\x90\xbd,\x02{\xd7\xd1\x18~;$\x1dB\xf6n\xb2" +
+	"\x05\x9c\xa1\xc9\x1bT\xe3\xdd1\x03\xb2}\x84\x13\xe8-" +
+	"\xf1\xce\xceh\"\xa1\xa4\xa1\xab\xc7\x04_\xb9YSb" +
+	"\x09E!\xe0\xc6\xd5\xa2\xad19\xd1\xad\x9ax?\xde" +
+	"s6\xa4h\xf9c,\xf0\xed\x97\xc0\xb1\xbfD\xb8\xb5" +
+	"\xc22\xd6)\xa5\x8ezb\xec\x17\x1a\xb7\x92h\x1c]" +
+	"7\xfb]2}\xb1\xff\x19\x00>\x8e[~"
 
 func init() {
 	schemas.Register(schema_84b56bd0975dfd33,
+		0x80095d19659782fb,
+		0x81e5278bffda3b25,
 		0x83143f06598cf9e8,
 		0x8317eae56a55f0ba,
 		0x85252b1ec1c352d2,
+		0x89d9da0594fd121e,
 		0x8d7ad02d9eab8fb7,
 		0x8df1c729f8d2ca00,
 		0x8e979661cc6a1161,
 		0x8ededcb57f98aaf0,
 		0x8fb41d4bd35c5a30,
+		0x9090e4cdf26bda5a,
 		0x946b1f715eac1308,
 		0xa128fe760c2612c4,
 		0xa2b1016cefab775b,
 		0xa3bd4ddc3e0a5017,
+		0xb3f44a65c55cceec,
 		0xb8f393fd6f7f0c44,
 		0xbd77371c14feb668,
 		0xbd88d0eab3826ba9,
@@ -2989,14 +4139,19 @@ func init() {
 		0xc120e2adef2af529,
 		0xcd6c734787642800,
 		0xcfd704b9b2c62a4a,
+		0xd116b8c7c465c1bf,
 		0xd3df8a6125925ab9,
 		0xdeb9cfe7754f053f,
 		0xe051a47070c97f9e,
+		0xea4f8e4e7afafcfa,
 		0xec1c828dae8bffa3,
 		0xeed94cf76be61d8e,
+		0xef790ead838510c2,
 		0xefbaa00121a2907b,
 		0xf5e8509c82a71e1c,
 		0xf906e2ae0dd37fe4,
 		0xf94646af9560150b,
-		0xfb42d1f26b074c15)
+		0xfb42d1f26b074c15,
+		0xfe238774e8fa0fd9,
+		0xffae9bfd910d3fbd)
 }
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 	"google.golang.org/grpc/codes"
@@ -17,16 +18,70 @@ func (zs *zarbServer) GetAccount(ctx context.Context, request *zarb.AccountReque
 	}
 	acc := zs.state.Account(addr)
 	if acc == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Account not found")
+		return nil, status.Errorf(codes.NotFound, "Account not found")
 	}
 	res := &zarb.AccountResponse{
-		Account: &zarb.AccountInfo{
-			Address:  acc.Address().String(),
-			Number:   int32(acc.Number()),
-			Sequence: int64(acc.Sequence()),
-			Balance:  acc.Balance(),
-		},
+		Account: zs.encodeAccount(acc),
 	}
 
 	return res, nil
 }
+
+func (zs *zarbServer) GetAccountProof(ctx context.Context, request *zarb.AccountProofRequest) (*zarb.AccountProofResponse, error) {
+	addr, err := crypto.AddressFromString(request.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid address: %v", err)
+	}
+	acc, proof, height := zs.state.AccountProof(addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "Account not found")
+	}
+	if proof == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create proof")
+	}
+	data, err := acc.Encode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+	res := &zarb.AccountProofResponse{
+		Account:   zs.encodeAccount(acc),
+		Data:      data,
+		Proof:     merkleProofToProto(proof),
+		StateHash: proof.Root(acc.Hash()).String(),
+		Height:    int64(height),
+	}
+
+	return res, nil
+}
+
+func (zs *zarbServer) GetAccountTransactions(ctx context.Context, request *zarb.AccountTransactionsRequest) (*zarb.AccountTransactionsResponse, error) {
+	addr, err := crypto.AddressFromString(request.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid address: %v", err)
+	}
+	if request.Page < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page: %v", request.Page)
+	}
+	trxs := zs.state.AccountTransactions(addr, int(request.Page))
+	res := &zarb.AccountTransactionsResponse{
+		Transactions: make([]*zarb.TransactionInfo, 0, len(trxs)),
+	}
+	for _, trx := range trxs {
+		res.Transactions = append(res.Transactions, zs.encodeTransaction(trx))
+	}
+
+	return res, nil
+}
+
+func (zs *zarbServer) encodeAccount(acc *account.Account) *zarb.AccountInfo {
+	height := zs.state.LastBlockHeight() + 1
+	locked := acc.LockedBalance(height)
+	return &zarb.AccountInfo{
+		Address:  acc.Address().String(),
+		Number:   int32(acc.Number()),
+		Sequence: int64(acc.Sequence()),
+		Balance:  acc.Balance(),
+		Locked:   locked,
+		Unlocked: acc.Balance() - locked,
+	}
+}
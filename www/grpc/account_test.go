@@ -5,7 +5,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/util"
+	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 )
 
@@ -34,7 +37,108 @@ func TestGetAccount(t *testing.T) {
 		assert.Equal(t, res.Account.Balance, acc1.Balance())
 		assert.Equal(t, int(res.Account.Number), acc1.Number())
 		assert.Equal(t, int(res.Account.Sequence), acc1.Sequence())
+		assert.Equal(t, res.Account.Locked, int64(0))
+		assert.Equal(t, res.Account.Unlocked, acc1.Balance())
+	})
+
+	t.Run("Should return locked and unlocked balance", func(t *testing.T) {
+		height := tMockState.LastBlockHeight() + 1
+		acc1.AddToBalance(account.MinVestingAmount)
+		assert.NoError(t, acc1.AddVesting(account.Vesting{Amount: account.MinVestingAmount, StartHeight: height - 50, CliffHeight: height - 50, EndHeight: height + 50}, height))
+		tMockState.Store.UpdateAccount(acc1)
+
+		res, err := client.GetAccount(tCtx, &zarb.AccountRequest{Address: acc1.Address().String()})
+		assert.Nil(t, err)
+		assert.Equal(t, res.Account.Balance, acc1.Balance())
+		assert.Equal(t, res.Account.Locked, account.MinVestingAmount/2)
+		assert.Equal(t, res.Account.Unlocked, acc1.Balance()-account.MinVestingAmount/2)
+	})
+	conn.Close()
+
+}
+
+func TestGetAccountProof(t *testing.T) {
+	conn, client := callServer(t)
+	acc1, _ := account.GenerateTestAccount(0)
+
+	t.Run("Should return error for non-parsable address ", func(t *testing.T) {
+		res, err := client.GetAccountProof(tCtx, &zarb.AccountProofRequest{Address: "NON_EXISTING_ADDRESS"})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return nil for non existing account ", func(t *testing.T) {
+		res, err := client.GetAccountProof(tCtx, &zarb.AccountProofRequest{Address: acc1.Address().String()})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	tMockState.Store.UpdateAccount(acc1)
+
+	t.Run("Should return account proof", func(t *testing.T) {
+		res, err := client.GetAccountProof(tCtx, &zarb.AccountProofRequest{Address: acc1.Address().String()})
+		assert.NoError(t, err)
+		assert.Equal(t, res.Account.Address, acc1.Address().String())
+		assert.Equal(t, int(res.Height), tMockState.LastBlockHeight()+1)
+
+		stateHash, _ := hash.FromString(res.StateHash)
+		acc2, err := grpcclient.VerifyAccountProof(res, stateHash)
+		assert.NoError(t, err)
+		assert.Equal(t, acc1.Hash(), acc2.Hash())
+
+		_, err = grpcclient.VerifyAccountProof(res, hash.GenerateTestHash())
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return the proof for the current state", func(t *testing.T) {
+		res, err := client.GetAccountProof(tCtx, &zarb.AccountProofRequest{Address: acc1.Address().String()})
+		assert.NoError(t, err)
+		assert.Equal(t, res.Height, int64(tMockState.LastBlockHeight())+1)
 	})
 	conn.Close()
+}
 
+func TestGetAccountTransactions(t *testing.T) {
+	conn, client := callServer(t)
+	trx1, _ := tx.GenerateTestSendTx()
+	trx2, _ := tx.GenerateTestSendTx()
+	addr := trx1.Payload().Signer()
+
+	t.Run("Should return error for non-parsable address ", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(tCtx, &zarb.AccountTransactionsRequest{Address: "NON_EXISTING_ADDRESS"})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid page ", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(tCtx, &zarb.AccountTransactionsRequest{Address: addr.String(), Page: -1})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return empty list for an address without transactions", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(tCtx, &zarb.AccountTransactionsRequest{Address: addr.String()})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Transactions)
+	})
+
+	tMockState.Store.SaveTransaction(trx1)
+	tMockState.Store.SaveTransaction(trx2)
+	tMockState.Store.SaveAddressTransaction(addr, 1, trx1.ID())
+	tMockState.Store.SaveAddressTransaction(addr, 2, trx2.ID())
+
+	t.Run("Should return the transactions, newest first", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(tCtx, &zarb.AccountTransactionsRequest{Address: addr.String()})
+		assert.NoError(t, err)
+		assert.Len(t, res.Transactions, 2)
+		assert.Equal(t, res.Transactions[0].Id, trx2.ID().String())
+		assert.Equal(t, res.Transactions[1].Id, trx1.ID().String())
+	})
+
+	t.Run("Should return empty list for the next page", func(t *testing.T) {
+		res, err := client.GetAccountTransactions(tCtx, &zarb.AccountTransactionsRequest{Address: addr.String(), Page: 1})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Transactions)
+	})
+	conn.Close()
 }
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 	"google.golang.org/grpc/codes"
@@ -30,6 +31,11 @@ func (zs *zarbServer) GetBlock(ctx context.Context, request *zarb.BlockRequest)
 	if block == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Block not found")
 	}
+
+	return zs.encodeBlock(block, request.Verbosity), nil
+}
+
+func (zs *zarbServer) encodeBlock(block *block.Block, verbosity zarb.BlockVerbosity) *zarb.BlockResponse {
 	hash := block.Hash().String()
 	timestamp := timestamppb.New(block.Header().Time())
 	header := &zarb.BlockHeaderInfo{}
@@ -37,7 +43,7 @@ func (zs *zarbServer) GetBlock(ctx context.Context, request *zarb.BlockRequest)
 	var prevCert *zarb.CertificateInfo
 
 	//populate BLOCK_DATA
-	if request.Verbosity.Number() > 0 {
+	if verbosity.Number() > 0 {
 		seed := block.Header().SortitionSeed()
 
 		sortitionSeed, err := seed.MarshalText()
@@ -76,7 +82,7 @@ func (zs *zarbServer) GetBlock(ctx context.Context, request *zarb.BlockRequest)
 
 	//TODO: Cache for better performance
 	//populate BLOCK_TRANSACTIONS
-	if request.Verbosity.Number() > 1 {
+	if verbosity.Number() > 1 {
 		for _, id := range block.TxIDs().IDs() {
 			t := zs.state.Transaction(id)
 			tranactions = append(tranactions, zs.encodeTransaction(t))
@@ -91,5 +97,5 @@ func (zs *zarbServer) GetBlock(ctx context.Context, request *zarb.BlockRequest)
 		PreviousCertificate: prevCert,
 	}
 
-	return res, nil
+	return res
 }
@@ -40,6 +40,8 @@ func (zs *zarbServer) GetNetworkInfo(ctx context.Context, request *zarb.NetworkI
 		p.ReceivedMessages = int32(peer.ReceivedBundles)
 		p.InvalidMessages = int32(peer.InvalidBundles)
 		p.ReceivedBytes = int32(peer.ReceivedBytes)
+		p.DroppedMessages = int32(peer.DroppedBundles)
+		p.Score = int32(peer.Score)
 	}
 
 	return &zarb.NetworkInfoResponse{
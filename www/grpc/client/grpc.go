@@ -6,6 +6,7 @@ import (
 
 	"github.com/zarbchain/zarb-go/crypto"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/tx/payload"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 	"google.golang.org/grpc"
 )
@@ -41,6 +42,24 @@ func GetSequence(rpcEndpoint string, addr crypto.Address) (int, error) {
 	return int(acc.Account.Sequence) + 1, nil
 }
 
+// EstimateFee returns the recommended fee for a transaction with the given amount and payload type
+func EstimateFee(rpcEndpoint string, amount int64, payloadType payload.Type) (int64, error) {
+	client, err := GetRPCClient(rpcEndpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := client.EstimateFee(context.Background(), &zarb.EstimateFeeRequest{
+		Amount:      amount,
+		PayloadType: zarb.PayloadType(payloadType),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RecommendedFee, nil
+}
+
 func SendTx(rpcEndpoint string, payload []byte) (string, error) {
 	client, err := GetRPCClient(rpcEndpoint)
 	if err != nil {
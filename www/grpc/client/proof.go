@@ -0,0 +1,111 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/proof"
+	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
+	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
+)
+
+func GetAccountProof(rpcEndpoint string, addr crypto.Address) (*zarb.AccountProofResponse, error) {
+	client, err := GetRPCClient(rpcEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetAccountProof(context.Background(), &zarb.AccountProofRequest{Address: addr.String()})
+}
+
+func GetValidatorProof(rpcEndpoint string, addr crypto.Address) (*zarb.ValidatorProofResponse, error) {
+	client, err := GetRPCClient(rpcEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetValidatorProof(context.Background(), &zarb.ValidatorProofRequest{Address: addr.String()})
+}
+
+// VerifyAccountProof verifies the account proof against the state hash.
+// The state hash should be taken from a trusted block header.
+func VerifyAccountProof(res *zarb.AccountProofResponse, stateHash hash.Hash) (*account.Account, error) {
+	p, err := merkleProofFromProto(res.Proof)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := proof.VerifyAccount(res.Data, p, stateHash)
+	if err != nil {
+		return nil, err
+	}
+	if acc.Address().String() != res.Account.Address {
+		return nil, fmt.Errorf("account data doesn't match the address")
+	}
+	return acc, nil
+}
+
+// VerifyValidatorProof verifies the validator proof against the state hash.
+// The state hash should be taken from a trusted block header.
+func VerifyValidatorProof(res *zarb.ValidatorProofResponse, stateHash hash.Hash) (*validator.Validator, error) {
+	p, err := merkleProofFromProto(res.Proof)
+	if err != nil {
+		return nil, err
+	}
+	val, err := proof.VerifyValidator(res.Data, p, stateHash)
+	if err != nil {
+		return nil, err
+	}
+	if val.Address().String() != res.Validator.Address {
+		return nil, fmt.Errorf("validator data doesn't match the address")
+	}
+	return val, nil
+}
+
+func GetTransactionProof(rpcEndpoint string, id tx.ID) (*zarb.TransactionProofResponse, error) {
+	client, err := GetRPCClient(rpcEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetTransactionProof(context.Background(), &zarb.TransactionProofRequest{Id: id.String()})
+}
+
+// VerifyTransactionProof verifies that the transaction is included in the block.
+// The block hash should be taken from a trusted source, like a certified block.
+func VerifyTransactionProof(res *zarb.TransactionProofResponse, blockHash hash.Hash) (*tx.Tx, error) {
+	p, err := merkleProofFromProto(res.Proof)
+	if err != nil {
+		return nil, err
+	}
+	trx, err := proof.VerifyTransaction(res.Data, res.Header, p, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if trx.ID().String() != res.Transaction.Id {
+		return nil, fmt.Errorf("transaction data doesn't match the id")
+	}
+	return trx, nil
+}
+
+func merkleProofFromProto(p *zarb.MerkleProof) (*simplemerkle.Proof, error) {
+	if p == nil {
+		return nil, fmt.Errorf("no proof")
+	}
+	siblings := make([]hash.Hash, len(p.Siblings))
+	for i, s := range p.Siblings {
+		h, err := hash.FromString(s)
+		if err != nil {
+			return nil, err
+		}
+		siblings[i] = h
+	}
+	return &simplemerkle.Proof{
+		Index:    int(p.Index),
+		Siblings: siblings,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package grpcclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/crypto/hash"
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	"github.com/zarbchain/zarb-go/validator"
+	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
+)
+
+func merkleProofToProto(p *simplemerkle.Proof) *zarb.MerkleProof {
+	siblings := make([]string, len(p.Siblings))
+	for i, s := range p.Siblings {
+		siblings[i] = s.String()
+	}
+	return &zarb.MerkleProof{
+		Index:    int64(p.Index),
+		Siblings: siblings,
+	}
+}
+
+func testTree(leaf hash.Hash, index int) *simplemerkle.Tree {
+	hashes := make([]hash.Hash, 4)
+	for i := range hashes {
+		hashes[i] = hash.GenerateTestHash()
+	}
+	hashes[index] = leaf
+	return simplemerkle.NewTreeFromHashes(hashes)
+}
+
+func TestVerifyAccountProof(t *testing.T) {
+	acc, _ := account.GenerateTestAccount(1)
+	data, err := acc.Encode()
+	require.NoError(t, err)
+	tree := testTree(acc.Hash(), 2)
+	root := tree.Root()
+
+	makeRes := func() *zarb.AccountProofResponse {
+		return &zarb.AccountProofResponse{
+			Account: &zarb.AccountInfo{Address: acc.Address().String()},
+			Data:    data,
+			Proof:   merkleProofToProto(tree.Proof(2)),
+		}
+	}
+
+	t.Run("Valid proof", func(t *testing.T) {
+		acc2, err := VerifyAccountProof(makeRes(), root)
+		assert.NoError(t, err)
+		assert.Equal(t, acc.Hash(), acc2.Hash())
+	})
+
+	t.Run("Invalid sibling", func(t *testing.T) {
+		res := makeRes()
+		res.Proof.Siblings[1] = "invalid"
+		_, err := VerifyAccountProof(res, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("Mismatched address", func(t *testing.T) {
+		res := makeRes()
+		acc2, _ := account.GenerateTestAccount(2)
+		res.Account.Address = acc2.Address().String()
+		_, err := VerifyAccountProof(res, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("No proof", func(t *testing.T) {
+		res := makeRes()
+		res.Proof = nil
+		_, err := VerifyAccountProof(res, root)
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyValidatorProof(t *testing.T) {
+	val, _ := validator.GenerateTestValidator(1)
+	data, err := val.Encode()
+	require.NoError(t, err)
+	tree := testTree(val.Hash(), 1)
+	root := tree.Root()
+
+	makeRes := func() *zarb.ValidatorProofResponse {
+		return &zarb.ValidatorProofResponse{
+			Validator: &zarb.ValidatorInfo{Address: val.Address().String()},
+			Data:      data,
+			Proof:     merkleProofToProto(tree.Proof(1)),
+		}
+	}
+
+	t.Run("Valid proof", func(t *testing.T) {
+		val2, err := VerifyValidatorProof(makeRes(), root)
+		assert.NoError(t, err)
+		assert.Equal(t, val.Hash(), val2.Hash())
+	})
+
+	t.Run("Tampered sibling", func(t *testing.T) {
+		res := makeRes()
+		res.Proof.Siblings[0] = hash.GenerateTestHash().String()
+		_, err := VerifyValidatorProof(res, root)
+		assert.Error(t, err)
+	})
+
+	t.Run("Mismatched address", func(t *testing.T) {
+		res := makeRes()
+		val2, _ := validator.GenerateTestValidator(2)
+		res.Validator.Address = val2.Address().String()
+		_, err := VerifyValidatorProof(res, root)
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyTransactionProof(t *testing.T) {
+	b, txs := block.GenerateTestBlock(nil, nil)
+	trx := txs[1]
+	data, err := trx.Encode()
+	require.NoError(t, err)
+	header, err := b.Header().MarshalCBOR()
+	require.NoError(t, err)
+	blockHash := b.Hash()
+
+	makeRes := func() *zarb.TransactionProofResponse {
+		return &zarb.TransactionProofResponse{
+			Transaction: &zarb.TransactionInfo{Id: trx.ID().String()},
+			Data:        data,
+			Proof:       merkleProofToProto(b.TxIDs().Proof(trx.ID())),
+			Header:      header,
+		}
+	}
+
+	t.Run("Valid proof", func(t *testing.T) {
+		trx2, err := VerifyTransactionProof(makeRes(), blockHash)
+		assert.NoError(t, err)
+		assert.Equal(t, trx.ID(), trx2.ID())
+	})
+
+	t.Run("Tampered sibling", func(t *testing.T) {
+		res := makeRes()
+		res.Proof.Siblings[0] = hash.GenerateTestHash().String()
+		_, err := VerifyTransactionProof(res, blockHash)
+		assert.Error(t, err)
+	})
+
+	t.Run("Mismatched id", func(t *testing.T) {
+		res := makeRes()
+		res.Transaction.Id = txs[0].ID().String()
+		_, err := VerifyTransactionProof(res, blockHash)
+		assert.Error(t, err)
+	})
+}
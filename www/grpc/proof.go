@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	simplemerkle "github.com/zarbchain/zarb-go/libs/merkle"
+	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
+)
+
+func merkleProofToProto(proof *simplemerkle.Proof) *zarb.MerkleProof {
+	siblings := make([]string, len(proof.Siblings))
+	for i, s := range proof.Siblings {
+		siblings[i] = s.String()
+	}
+	return &zarb.MerkleProof{
+		Index:    int64(proof.Index),
+		Siblings: siblings,
+	}
+}
@@ -20,20 +20,84 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VESTING_SCHEDULE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	CliffHeight int64 `protobuf:"varint,2,opt,name=cliff_height,json=cliffHeight,proto3" json:"cliff_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (x *VESTING_SCHEDULE) Reset() {
+	*x = VESTING_SCHEDULE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payloads_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VESTING_SCHEDULE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VESTING_SCHEDULE) ProtoMessage() {}
+
+func (x *VESTING_SCHEDULE) ProtoReflect() protoreflect.Message {
+	mi := &file_payloads_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VESTING_SCHEDULE.ProtoReflect.Descriptor instead.
+func (*VESTING_SCHEDULE) Descriptor() ([]byte, []int) {
+	return file_payloads_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VESTING_SCHEDULE) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *VESTING_SCHEDULE) GetCliffHeight() int64 {
+	if x != nil {
+		return x.CliffHeight
+	}
+	return 0
+}
+
+func (x *VESTING_SCHEDULE) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
 type SEND_PAYLOAD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Amount   int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sender   string            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string            `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount   int64             `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Vesting  *VESTING_SCHEDULE `protobuf:"bytes,4,opt,name=vesting,proto3" json:"vesting,omitempty"`
 }
 
 func (x *SEND_PAYLOAD) Reset() {
 	*x = SEND_PAYLOAD{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payloads_proto_msgTypes[0]
+		mi := &file_payloads_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -46,7 +110,7 @@ func (x *SEND_PAYLOAD) String() string {
 func (*SEND_PAYLOAD) ProtoMessage() {}
 
 func (x *SEND_PAYLOAD) ProtoReflect() protoreflect.Message {
-	mi := &file_payloads_proto_msgTypes[0]
+	mi := &file_payloads_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +123,7 @@ func (x *SEND_PAYLOAD) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SEND_PAYLOAD.ProtoReflect.Descriptor instead.
 func (*SEND_PAYLOAD) Descriptor() ([]byte, []int) {
-	return file_payloads_proto_rawDescGZIP(), []int{0}
+	return file_payloads_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *SEND_PAYLOAD) GetSender() string {
@@ -83,6 +147,13 @@ func (x *SEND_PAYLOAD) GetAmount() int64 {
 	return 0
 }
 
+func (x *SEND_PAYLOAD) GetVesting() *VESTING_SCHEDULE {
+	if x != nil {
+		return x.Vesting
+	}
+	return nil
+}
+
 type BOND_PAYLOAD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -96,7 +167,7 @@ type BOND_PAYLOAD struct {
 func (x *BOND_PAYLOAD) Reset() {
 	*x = BOND_PAYLOAD{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payloads_proto_msgTypes[1]
+		mi := &file_payloads_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -109,7 +180,7 @@ func (x *BOND_PAYLOAD) String() string {
 func (*BOND_PAYLOAD) ProtoMessage() {}
 
 func (x *BOND_PAYLOAD) ProtoReflect() protoreflect.Message {
-	mi := &file_payloads_proto_msgTypes[1]
+	mi := &file_payloads_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,7 +193,7 @@ func (x *BOND_PAYLOAD) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BOND_PAYLOAD.ProtoReflect.Descriptor instead.
 func (*BOND_PAYLOAD) Descriptor() ([]byte, []int) {
-	return file_payloads_proto_rawDescGZIP(), []int{1}
+	return file_payloads_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BOND_PAYLOAD) GetBonder() string {
@@ -158,7 +229,7 @@ type SORTITION_PAYLOAD struct {
 func (x *SORTITION_PAYLOAD) Reset() {
 	*x = SORTITION_PAYLOAD{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payloads_proto_msgTypes[2]
+		mi := &file_payloads_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -171,7 +242,7 @@ func (x *SORTITION_PAYLOAD) String() string {
 func (*SORTITION_PAYLOAD) ProtoMessage() {}
 
 func (x *SORTITION_PAYLOAD) ProtoReflect() protoreflect.Message {
-	mi := &file_payloads_proto_msgTypes[2]
+	mi := &file_payloads_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +255,7 @@ func (x *SORTITION_PAYLOAD) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SORTITION_PAYLOAD.ProtoReflect.Descriptor instead.
 func (*SORTITION_PAYLOAD) Descriptor() ([]byte, []int) {
-	return file_payloads_proto_rawDescGZIP(), []int{2}
+	return file_payloads_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SORTITION_PAYLOAD) GetAddress() string {
@@ -205,13 +276,24 @@ var File_payloads_proto protoreflect.FileDescriptor
 
 var file_payloads_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x45,
-	0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x0c, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x50,
+	0x12, 0x08, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x77, 0x0a, 0x10, 0x56, 0x45,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59,
+	0x4c, 0x4f, 0x41, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x07, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x56, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x52, 0x07, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x5a, 0x0a, 0x0c, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x50,
 	0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c,
 	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -239,18 +321,20 @@ func file_payloads_proto_rawDescGZIP() []byte {
 	return file_payloads_proto_rawDescData
 }
 
-var file_payloads_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_payloads_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_payloads_proto_goTypes = []interface{}{
-	(*SEND_PAYLOAD)(nil),      // 0: payloads.SEND_PAYLOAD
-	(*BOND_PAYLOAD)(nil),      // 1: payloads.BOND_PAYLOAD
-	(*SORTITION_PAYLOAD)(nil), // 2: payloads.SORTITION_PAYLOAD
+	(*VESTING_SCHEDULE)(nil),  // 0: payloads.VESTING_SCHEDULE
+	(*SEND_PAYLOAD)(nil),      // 1: payloads.SEND_PAYLOAD
+	(*BOND_PAYLOAD)(nil),      // 2: payloads.BOND_PAYLOAD
+	(*SORTITION_PAYLOAD)(nil), // 3: payloads.SORTITION_PAYLOAD
 }
 var file_payloads_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: payloads.SEND_PAYLOAD.vesting:type_name -> payloads.VESTING_SCHEDULE
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_payloads_proto_init() }
@@ -260,7 +344,7 @@ func file_payloads_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_payloads_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SEND_PAYLOAD); i {
+			switch v := v.(*VESTING_SCHEDULE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -272,7 +356,7 @@ func file_payloads_proto_init() {
 			}
 		}
 		file_payloads_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BOND_PAYLOAD); i {
+			switch v := v.(*SEND_PAYLOAD); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -284,6 +368,18 @@ func file_payloads_proto_init() {
 			}
 		}
 		file_payloads_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BOND_PAYLOAD); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payloads_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SORTITION_PAYLOAD); i {
 			case 0:
 				return &v.state
@@ -302,7 +398,7 @@ func file_payloads_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_payloads_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,28 +25,55 @@ const (
 type PayloadType int32
 
 const (
-	PayloadType_UNKNOWN           PayloadType = 0
-	PayloadType_SEND_PAYLOAD      PayloadType = 1
-	PayloadType_BOND_PAYLOAD      PayloadType = 2
-	PayloadType_SORTITION_PAYLOAD PayloadType = 3
-	PayloadType_UNBOND_PAYLOAD    PayloadType = 4
+	PayloadType_UNKNOWN                     PayloadType = 0
+	PayloadType_SEND_PAYLOAD                PayloadType = 1
+	PayloadType_BOND_PAYLOAD                PayloadType = 2
+	PayloadType_SORTITION_PAYLOAD           PayloadType = 3
+	PayloadType_UNBOND_PAYLOAD              PayloadType = 4
+	PayloadType_WITHDRAW_PAYLOAD            PayloadType = 5
+	PayloadType_BATCH_SEND_PAYLOAD          PayloadType = 6
+	PayloadType_DELEGATE_PAYLOAD            PayloadType = 7
+	PayloadType_UNDELEGATE_PAYLOAD          PayloadType = 8
+	PayloadType_WITHDRAW_DELEGATION_PAYLOAD PayloadType = 9
+	PayloadType_SLASH_PAYLOAD               PayloadType = 10
+	PayloadType_UNJAIL_PAYLOAD              PayloadType = 11
+	PayloadType_PROPOSAL_PAYLOAD            PayloadType = 12
+	PayloadType_PROPOSAL_VOTE_PAYLOAD       PayloadType = 13
 )
 
 // Enum value maps for PayloadType.
 var (
 	PayloadType_name = map[int32]string{
-		0: "UNKNOWN",
-		1: "SEND_PAYLOAD",
-		2: "BOND_PAYLOAD",
-		3: "SORTITION_PAYLOAD",
-		4: "UNBOND_PAYLOAD",
+		0:  "UNKNOWN",
+		1:  "SEND_PAYLOAD",
+		2:  "BOND_PAYLOAD",
+		3:  "SORTITION_PAYLOAD",
+		4:  "UNBOND_PAYLOAD",
+		5:  "WITHDRAW_PAYLOAD",
+		6:  "BATCH_SEND_PAYLOAD",
+		7:  "DELEGATE_PAYLOAD",
+		8:  "UNDELEGATE_PAYLOAD",
+		9:  "WITHDRAW_DELEGATION_PAYLOAD",
+		10: "SLASH_PAYLOAD",
+		11: "UNJAIL_PAYLOAD",
+		12: "PROPOSAL_PAYLOAD",
+		13: "PROPOSAL_VOTE_PAYLOAD",
 	}
 	PayloadType_value = map[string]int32{
-		"UNKNOWN":           0,
-		"SEND_PAYLOAD":      1,
-		"BOND_PAYLOAD":      2,
-		"SORTITION_PAYLOAD": 3,
-		"UNBOND_PAYLOAD":    4,
+		"UNKNOWN":                     0,
+		"SEND_PAYLOAD":                1,
+		"BOND_PAYLOAD":                2,
+		"SORTITION_PAYLOAD":           3,
+		"UNBOND_PAYLOAD":              4,
+		"WITHDRAW_PAYLOAD":            5,
+		"BATCH_SEND_PAYLOAD":          6,
+		"DELEGATE_PAYLOAD":            7,
+		"UNDELEGATE_PAYLOAD":          8,
+		"WITHDRAW_DELEGATION_PAYLOAD": 9,
+		"SLASH_PAYLOAD":               10,
+		"UNJAIL_PAYLOAD":              11,
+		"PROPOSAL_PAYLOAD":            12,
+		"PROPOSAL_VOTE_PAYLOAD":       13,
 	}
 )
 
@@ -220,14 +247,16 @@ func (x *AccountResponse) GetAccount() *AccountInfo {
 	return nil
 }
 
-type ValidatorsRequest struct {
+type AccountProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *ValidatorsRequest) Reset() {
-	*x = ValidatorsRequest{}
+func (x *AccountProofRequest) Reset() {
+	*x = AccountProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,13 +264,13 @@ func (x *ValidatorsRequest) Reset() {
 	}
 }
 
-func (x *ValidatorsRequest) String() string {
+func (x *AccountProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorsRequest) ProtoMessage() {}
+func (*AccountProofRequest) ProtoMessage() {}
 
-func (x *ValidatorsRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -253,21 +282,34 @@ func (x *ValidatorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorsRequest.ProtoReflect.Descriptor instead.
-func (*ValidatorsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountProofRequest.ProtoReflect.Descriptor instead.
+func (*AccountProofRequest) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{2}
 }
 
-type ValidatorRequest struct {
+func (x *AccountProofRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// The proof is against the current state hash which will be committed
+// in the header of the block at the given height.
+type AccountProofResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Account   *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Data      []byte       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Proof     *MerkleProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	StateHash string       `protobuf:"bytes,4,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	Height    int64        `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *ValidatorRequest) Reset() {
-	*x = ValidatorRequest{}
+func (x *AccountProofResponse) Reset() {
+	*x = AccountProofResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -275,13 +317,13 @@ func (x *ValidatorRequest) Reset() {
 	}
 }
 
-func (x *ValidatorRequest) String() string {
+func (x *AccountProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorRequest) ProtoMessage() {}
+func (*AccountProofResponse) ProtoMessage() {}
 
-func (x *ValidatorRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountProofResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,28 +335,58 @@ func (x *ValidatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorRequest.ProtoReflect.Descriptor instead.
-func (*ValidatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountProofResponse.ProtoReflect.Descriptor instead.
+func (*AccountProofResponse) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ValidatorRequest) GetAddress() string {
+func (x *AccountProofResponse) GetAccount() *AccountInfo {
 	if x != nil {
-		return x.Address
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountProofResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AccountProofResponse) GetProof() *MerkleProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *AccountProofResponse) GetStateHash() string {
+	if x != nil {
+		return x.StateHash
 	}
 	return ""
 }
 
-type ValidatorByNumberRequest struct {
+func (x *AccountProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Transactions are sorted from the newest to the oldest. The first page is zero.
+type AccountTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *ValidatorByNumberRequest) Reset() {
-	*x = ValidatorByNumberRequest{}
+func (x *AccountTransactionsRequest) Reset() {
+	*x = AccountTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,13 +394,13 @@ func (x *ValidatorByNumberRequest) Reset() {
 	}
 }
 
-func (x *ValidatorByNumberRequest) String() string {
+func (x *AccountTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorByNumberRequest) ProtoMessage() {}
+func (*AccountTransactionsRequest) ProtoMessage() {}
 
-func (x *ValidatorByNumberRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -340,28 +412,35 @@ func (x *ValidatorByNumberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorByNumberRequest.ProtoReflect.Descriptor instead.
-func (*ValidatorByNumberRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*AccountTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ValidatorByNumberRequest) GetNumber() int32 {
+func (x *AccountTransactionsRequest) GetAddress() string {
 	if x != nil {
-		return x.Number
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccountTransactionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-type ValidatorsResponse struct {
+type AccountTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Validators []*ValidatorInfo `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Transactions []*TransactionInfo `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *ValidatorsResponse) Reset() {
-	*x = ValidatorsResponse{}
+func (x *AccountTransactionsResponse) Reset() {
+	*x = AccountTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,13 +448,13 @@ func (x *ValidatorsResponse) Reset() {
 	}
 }
 
-func (x *ValidatorsResponse) String() string {
+func (x *AccountTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorsResponse) ProtoMessage() {}
+func (*AccountTransactionsResponse) ProtoMessage() {}
 
-func (x *ValidatorsResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,28 +466,26 @@ func (x *ValidatorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorsResponse.ProtoReflect.Descriptor instead.
-func (*ValidatorsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*AccountTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ValidatorsResponse) GetValidators() []*ValidatorInfo {
+func (x *AccountTransactionsResponse) GetTransactions() []*TransactionInfo {
 	if x != nil {
-		return x.Validators
+		return x.Transactions
 	}
 	return nil
 }
 
-type ValidatorResponse struct {
+type ValidatorsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Validator *ValidatorInfo `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (x *ValidatorResponse) Reset() {
-	*x = ValidatorResponse{}
+func (x *ValidatorsRequest) Reset() {
+	*x = ValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -416,13 +493,13 @@ func (x *ValidatorResponse) Reset() {
 	}
 }
 
-func (x *ValidatorResponse) String() string {
+func (x *ValidatorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorResponse) ProtoMessage() {}
+func (*ValidatorsRequest) ProtoMessage() {}
 
-func (x *ValidatorResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidatorsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -434,29 +511,21 @@ func (x *ValidatorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorResponse.ProtoReflect.Descriptor instead.
-func (*ValidatorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorsRequest) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ValidatorResponse) GetValidator() *ValidatorInfo {
-	if x != nil {
-		return x.Validator
-	}
-	return nil
-}
-
-type BlockRequest struct {
+type ValidatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height    int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Verbosity BlockVerbosity `protobuf:"varint,2,opt,name=verbosity,proto3,enum=zarb.BlockVerbosity" json:"verbosity,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *BlockRequest) Reset() {
-	*x = BlockRequest{}
+func (x *ValidatorRequest) Reset() {
+	*x = ValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -464,13 +533,13 @@ func (x *BlockRequest) Reset() {
 	}
 }
 
-func (x *BlockRequest) String() string {
+func (x *ValidatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockRequest) ProtoMessage() {}
+func (*ValidatorRequest) ProtoMessage() {}
 
-func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidatorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,39 +551,28 @@ func (x *BlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
-func (*BlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorRequest) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BlockRequest) GetHeight() int64 {
-	if x != nil {
-		return x.Height
-	}
-	return 0
-}
-
-func (x *BlockRequest) GetVerbosity() BlockVerbosity {
+func (x *ValidatorRequest) GetAddress() string {
 	if x != nil {
-		return x.Verbosity
+		return x.Address
 	}
-	return BlockVerbosity_BLOCK_HASH
+	return ""
 }
 
-type BlockResponse struct {
+type ValidatorByNumberRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash                string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	BlockTime           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	Header              *BlockHeaderInfo       `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	PreviousCertificate *CertificateInfo       `protobuf:"bytes,4,opt,name=previous_certificate,json=previousCertificate,proto3" json:"previous_certificate,omitempty"`
-	Tranactions         []*TransactionInfo     `protobuf:"bytes,5,rep,name=tranactions,proto3" json:"tranactions,omitempty"`
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (x *BlockResponse) Reset() {
-	*x = BlockResponse{}
+func (x *ValidatorByNumberRequest) Reset() {
+	*x = ValidatorByNumberRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_zarb_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -522,13 +580,13 @@ func (x *BlockResponse) Reset() {
 	}
 }
 
-func (x *BlockResponse) String() string {
+func (x *ValidatorByNumberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockResponse) ProtoMessage() {}
+func (*ValidatorByNumberRequest) ProtoMessage() {}
 
-func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidatorByNumberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_zarb_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -540,71 +598,90 @@ func (x *BlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
-func (*BlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorByNumberRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorByNumberRequest) Descriptor() ([]byte, []int) {
 	return file_zarb_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BlockResponse) GetHash() string {
+func (x *ValidatorByNumberRequest) GetNumber() int32 {
 	if x != nil {
-		return x.Hash
+		return x.Number
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockResponse) GetBlockTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.BlockTime
-	}
-	return nil
+type ValidatorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validators []*ValidatorInfo `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (x *BlockResponse) GetHeader() *BlockHeaderInfo {
-	if x != nil {
-		return x.Header
+func (x *ValidatorsResponse) Reset() {
+	*x = ValidatorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *BlockResponse) GetPreviousCertificate() *CertificateInfo {
-	if x != nil {
-		return x.PreviousCertificate
+func (x *ValidatorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorsResponse) ProtoMessage() {}
+
+func (x *ValidatorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *BlockResponse) GetTranactions() []*TransactionInfo {
+// Deprecated: Use ValidatorsResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorsResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidatorsResponse) GetValidators() []*ValidatorInfo {
 	if x != nil {
-		return x.Tranactions
+		return x.Validators
 	}
 	return nil
 }
 
-type BlockHeightRequest struct {
+type ValidatorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Validator *ValidatorInfo `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (x *BlockHeightRequest) Reset() {
-	*x = BlockHeightRequest{}
+func (x *ValidatorResponse) Reset() {
+	*x = ValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[9]
+		mi := &file_zarb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockHeightRequest) String() string {
+func (x *ValidatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockHeightRequest) ProtoMessage() {}
+func (*ValidatorResponse) ProtoMessage() {}
 
-func (x *BlockHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[9]
+func (x *ValidatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,43 +692,43 @@ func (x *BlockHeightRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockHeightRequest.ProtoReflect.Descriptor instead.
-func (*BlockHeightRequest) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ValidatorResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BlockHeightRequest) GetHash() string {
+func (x *ValidatorResponse) GetValidator() *ValidatorInfo {
 	if x != nil {
-		return x.Hash
+		return x.Validator
 	}
-	return ""
+	return nil
 }
 
-type BlockHeightResponse struct {
+type ValidatorProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *BlockHeightResponse) Reset() {
-	*x = BlockHeightResponse{}
+func (x *ValidatorProofRequest) Reset() {
+	*x = ValidatorProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[10]
+		mi := &file_zarb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockHeightResponse) String() string {
+func (x *ValidatorProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockHeightResponse) ProtoMessage() {}
+func (*ValidatorProofRequest) ProtoMessage() {}
 
-func (x *BlockHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[10]
+func (x *ValidatorProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,44 +736,1004 @@ func (x *BlockHeightResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorProofRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorProofRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidatorProofRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// The proof is against the current state hash which will be committed
+// in the header of the block at the given height.
+type ValidatorProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator *ValidatorInfo `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Data      []byte         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Proof     *MerkleProof   `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	StateHash string         `protobuf:"bytes,4,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	Height    int64          `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ValidatorProofResponse) Reset() {
+	*x = ValidatorProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorProofResponse) ProtoMessage() {}
+
+func (x *ValidatorProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorProofResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorProofResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidatorProofResponse) GetValidator() *ValidatorInfo {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *ValidatorProofResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ValidatorProofResponse) GetProof() *MerkleProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *ValidatorProofResponse) GetStateHash() string {
+	if x != nil {
+		return x.StateHash
+	}
+	return ""
+}
+
+func (x *ValidatorProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Verbosity BlockVerbosity `protobuf:"varint,2,opt,name=verbosity,proto3,enum=zarb.BlockVerbosity" json:"verbosity,omitempty"`
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BlockRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockRequest) GetVerbosity() BlockVerbosity {
+	if x != nil {
+		return x.Verbosity
+	}
+	return BlockVerbosity_BLOCK_HASH
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash                string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlockTime           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	Header              *BlockHeaderInfo       `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	PreviousCertificate *CertificateInfo       `protobuf:"bytes,4,opt,name=previous_certificate,json=previousCertificate,proto3" json:"previous_certificate,omitempty"`
+	Tranactions         []*TransactionInfo     `protobuf:"bytes,5,rep,name=tranactions,proto3" json:"tranactions,omitempty"`
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BlockResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockResponse) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *BlockResponse) GetHeader() *BlockHeaderInfo {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *BlockResponse) GetPreviousCertificate() *CertificateInfo {
+	if x != nil {
+		return x.PreviousCertificate
+	}
+	return nil
+}
+
+func (x *BlockResponse) GetTranactions() []*TransactionInfo {
+	if x != nil {
+		return x.Tranactions
+	}
+	return nil
+}
+
+type BlockHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *BlockHeightRequest) Reset() {
+	*x = BlockHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeightRequest) ProtoMessage() {}
+
+func (x *BlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*BlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockHeightRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type BlockHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *BlockHeightResponse) Reset() {
+	*x = BlockHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeightResponse) ProtoMessage() {}
+
+func (x *BlockHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeightResponse.ProtoReflect.Descriptor instead.
+func (*BlockHeightResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BlockHeightResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type BlockchainInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockchainInfoRequest) Reset() {
+	*x = BlockchainInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockchainInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockchainInfoRequest) ProtoMessage() {}
+
+func (x *BlockchainInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockchainInfoRequest.ProtoReflect.Descriptor instead.
+func (*BlockchainInfoRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{17}
+}
+
+type BlockchainInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height        int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	LastBlockHash string `protobuf:"bytes,2,opt,name=last_block_hash,json=lastBlockHash,proto3" json:"last_block_hash,omitempty"`
+}
+
+func (x *BlockchainInfoResponse) Reset() {
+	*x = BlockchainInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockchainInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockchainInfoResponse) ProtoMessage() {}
+
+func (x *BlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockchainInfoResponse.ProtoReflect.Descriptor instead.
+func (*BlockchainInfoResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlockchainInfoResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockchainInfoResponse) GetLastBlockHash() string {
+	if x != nil {
+		return x.LastBlockHash
+	}
+	return ""
+}
+
+type NetworkInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NetworkInfoRequest) Reset() {
+	*x = NetworkInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInfoRequest) ProtoMessage() {}
+
+func (x *NetworkInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInfoRequest.ProtoReflect.Descriptor instead.
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{19}
+}
+
+type NetworkInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SelfId string      `protobuf:"bytes,1,opt,name=self_id,json=selfId,proto3" json:"self_id,omitempty"`
+	Peers  []*PeerInfo `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *NetworkInfoResponse) Reset() {
+	*x = NetworkInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInfoResponse) ProtoMessage() {}
+
+func (x *NetworkInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInfoResponse.ProtoReflect.Descriptor instead.
+func (*NetworkInfoResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NetworkInfoResponse) GetSelfId() string {
+	if x != nil {
+		return x.SelfId
+	}
+	return ""
+}
+
+func (x *NetworkInfoResponse) GetPeers() []*PeerInfo {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type TransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TransactionRequest) Reset() {
+	*x = TransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRequest) ProtoMessage() {}
+
+func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
+func (*TransactionRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tranaction *TransactionInfo `protobuf:"bytes,1,opt,name=tranaction,proto3" json:"tranaction,omitempty"`
+}
+
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponse) ProtoMessage() {}
+
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TransactionResponse) GetTranaction() *TransactionInfo {
+	if x != nil {
+		return x.Tranaction
+	}
+	return nil
+}
+
+type TransactionProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TransactionProofRequest) Reset() {
+	*x = TransactionProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionProofRequest) ProtoMessage() {}
+
+func (x *TransactionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionProofRequest.ProtoReflect.Descriptor instead.
+func (*TransactionProofRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TransactionProofRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// The proof is against the transactions hash in the header of the block
+// at the given height.
+type TransactionProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *TransactionInfo `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Data        []byte           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Proof       *MerkleProof     `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	BlockHash   string           `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height      int64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Header      []byte           `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *TransactionProofResponse) Reset() {
+	*x = TransactionProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionProofResponse) ProtoMessage() {}
+
+func (x *TransactionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionProofResponse.ProtoReflect.Descriptor instead.
+func (*TransactionProofResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransactionProofResponse) GetTransaction() *TransactionInfo {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionProofResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TransactionProofResponse) GetProof() *MerkleProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *TransactionProofResponse) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *TransactionProofResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TransactionProofResponse) GetHeader() []byte {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type SendRawTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SendRawTransactionRequest) Reset() {
+	*x = SendRawTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendRawTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRawTransactionRequest) ProtoMessage() {}
+
+func (x *SendRawTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRawTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SendRawTransactionRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type SendRawTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SendRawTransactionResponse) Reset() {
+	*x = SendRawTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendRawTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRawTransactionResponse) ProtoMessage() {}
+
+func (x *SendRawTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRawTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SendRawTransactionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SimulateTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SimulateTransactionRequest) Reset() {
+	*x = SimulateTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTransactionRequest) ProtoMessage() {}
+
+func (x *SimulateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SimulateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SimulateTransactionRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// The transaction is executed against the current state, but it is neither
+// committed nor broadcasted. If the transaction is not valid, error_code and
+// error show the reason. Accounts and validators are the ones that would be
+// changed by the transaction.
+type SimulateTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ErrorCode  int32            `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Error      string           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Fee        int64            `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Accounts   []*AccountInfo   `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Validators []*ValidatorInfo `protobuf:"bytes,6,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *SimulateTransactionResponse) Reset() {
+	*x = SimulateTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTransactionResponse) ProtoMessage() {}
+
+func (x *SimulateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SimulateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SimulateTransactionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SimulateTransactionResponse) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *SimulateTransactionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SimulateTransactionResponse) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
 }
 
-// Deprecated: Use BlockHeightResponse.ProtoReflect.Descriptor instead.
-func (*BlockHeightResponse) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{10}
+func (x *SimulateTransactionResponse) GetAccounts() []*AccountInfo {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
 }
 
-func (x *BlockHeightResponse) GetHeight() int64 {
+func (x *SimulateTransactionResponse) GetValidators() []*ValidatorInfo {
 	if x != nil {
-		return x.Height
+		return x.Validators
 	}
-	return 0
+	return nil
 }
 
-type BlockchainInfoRequest struct {
+type EstimateFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Amount      int64       `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	PayloadType PayloadType `protobuf:"varint,2,opt,name=payload_type,json=payloadType,proto3,enum=zarb.PayloadType" json:"payload_type,omitempty"`
 }
 
-func (x *BlockchainInfoRequest) Reset() {
-	*x = BlockchainInfoRequest{}
+func (x *EstimateFeeRequest) Reset() {
+	*x = EstimateFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[11]
+		mi := &file_zarb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockchainInfoRequest) String() string {
+func (x *EstimateFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockchainInfoRequest) ProtoMessage() {}
+func (*EstimateFeeRequest) ProtoMessage() {}
 
-func (x *BlockchainInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[11]
+func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,37 +1744,56 @@ func (x *BlockchainInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockchainInfoRequest.ProtoReflect.Descriptor instead.
-func (*BlockchainInfoRequest) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EstimateFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{29}
 }
 
-type BlockchainInfoResponse struct {
+func (x *EstimateFeeRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EstimateFeeRequest) GetPayloadType() PayloadType {
+	if x != nil {
+		return x.PayloadType
+	}
+	return PayloadType_UNKNOWN
+}
+
+// Transactions should pay at least the minimum fee. The recommended fee is
+// higher when the transaction pool or the recent blocks are congested.
+// Pool occupancy and block fullness are between 0 and 1.
+type EstimateFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height        int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	LastBlockHash string `protobuf:"bytes,2,opt,name=last_block_hash,json=lastBlockHash,proto3" json:"last_block_hash,omitempty"`
+	MinimumFee     int64   `protobuf:"varint,1,opt,name=minimum_fee,json=minimumFee,proto3" json:"minimum_fee,omitempty"`
+	RecommendedFee int64   `protobuf:"varint,2,opt,name=recommended_fee,json=recommendedFee,proto3" json:"recommended_fee,omitempty"`
+	PoolOccupancy  float64 `protobuf:"fixed64,3,opt,name=pool_occupancy,json=poolOccupancy,proto3" json:"pool_occupancy,omitempty"`
+	BlockFullness  float64 `protobuf:"fixed64,4,opt,name=block_fullness,json=blockFullness,proto3" json:"block_fullness,omitempty"`
 }
 
-func (x *BlockchainInfoResponse) Reset() {
-	*x = BlockchainInfoResponse{}
+func (x *EstimateFeeResponse) Reset() {
+	*x = EstimateFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[12]
+		mi := &file_zarb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockchainInfoResponse) String() string {
+func (x *EstimateFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockchainInfoResponse) ProtoMessage() {}
+func (*EstimateFeeResponse) ProtoMessage() {}
 
-func (x *BlockchainInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[12]
+func (x *EstimateFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,48 +1804,67 @@ func (x *BlockchainInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockchainInfoResponse.ProtoReflect.Descriptor instead.
-func (*BlockchainInfoResponse) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EstimateFeeResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BlockchainInfoResponse) GetHeight() int64 {
+func (x *EstimateFeeResponse) GetMinimumFee() int64 {
 	if x != nil {
-		return x.Height
+		return x.MinimumFee
 	}
 	return 0
 }
 
-func (x *BlockchainInfoResponse) GetLastBlockHash() string {
+func (x *EstimateFeeResponse) GetRecommendedFee() int64 {
 	if x != nil {
-		return x.LastBlockHash
+		return x.RecommendedFee
 	}
-	return ""
+	return 0
 }
 
-type NetworkInfoRequest struct {
+func (x *EstimateFeeResponse) GetPoolOccupancy() float64 {
+	if x != nil {
+		return x.PoolOccupancy
+	}
+	return 0
+}
+
+func (x *EstimateFeeResponse) GetBlockFullness() float64 {
+	if x != nil {
+		return x.BlockFullness
+	}
+	return 0
+}
+
+// If from_height is set, the committed blocks from that height are sent
+// first, so a reconnecting client doesn't miss any block.
+type SubscribeBlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	FromHeight int64          `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	Verbosity  BlockVerbosity `protobuf:"varint,2,opt,name=verbosity,proto3,enum=zarb.BlockVerbosity" json:"verbosity,omitempty"`
 }
 
-func (x *NetworkInfoRequest) Reset() {
-	*x = NetworkInfoRequest{}
+func (x *SubscribeBlocksRequest) Reset() {
+	*x = SubscribeBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[13]
+		mi := &file_zarb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetworkInfoRequest) String() string {
+func (x *SubscribeBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkInfoRequest) ProtoMessage() {}
+func (*SubscribeBlocksRequest) ProtoMessage() {}
 
-func (x *NetworkInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[13]
+func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,37 +1875,51 @@ func (x *NetworkInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkInfoRequest.ProtoReflect.Descriptor instead.
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{31}
 }
 
-type NetworkInfoResponse struct {
+func (x *SubscribeBlocksRequest) GetFromHeight() int64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *SubscribeBlocksRequest) GetVerbosity() BlockVerbosity {
+	if x != nil {
+		return x.Verbosity
+	}
+	return BlockVerbosity_BLOCK_HASH
+}
+
+type SubscribeBlocksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SelfId string      `protobuf:"bytes,1,opt,name=self_id,json=selfId,proto3" json:"self_id,omitempty"`
-	Peers  []*PeerInfo `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	Height int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Block  *BlockResponse `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *NetworkInfoResponse) Reset() {
-	*x = NetworkInfoResponse{}
+func (x *SubscribeBlocksResponse) Reset() {
+	*x = SubscribeBlocksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[14]
+		mi := &file_zarb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetworkInfoResponse) String() string {
+func (x *SubscribeBlocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkInfoResponse) ProtoMessage() {}
+func (*SubscribeBlocksResponse) ProtoMessage() {}
 
-func (x *NetworkInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[14]
+func (x *SubscribeBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,50 +1930,52 @@ func (x *NetworkInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkInfoResponse.ProtoReflect.Descriptor instead.
-func (*NetworkInfoResponse) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SubscribeBlocksResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *NetworkInfoResponse) GetSelfId() string {
+func (x *SubscribeBlocksResponse) GetHeight() int64 {
 	if x != nil {
-		return x.SelfId
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *NetworkInfoResponse) GetPeers() []*PeerInfo {
+func (x *SubscribeBlocksResponse) GetBlock() *BlockResponse {
 	if x != nil {
-		return x.Peers
+		return x.Block
 	}
 	return nil
 }
 
-type TransactionRequest struct {
+// If from_height is set, the transactions of the committed blocks from that
+// height are sent first.
+type SubscribeTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 }
 
-func (x *TransactionRequest) Reset() {
-	*x = TransactionRequest{}
+func (x *SubscribeTransactionsRequest) Reset() {
+	*x = SubscribeTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[15]
+		mi := &file_zarb_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionRequest) String() string {
+func (x *SubscribeTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionRequest) ProtoMessage() {}
+func (*SubscribeTransactionsRequest) ProtoMessage() {}
 
-func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[15]
+func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,43 +1986,44 @@ func (x *TransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionRequest.ProtoReflect.Descriptor instead.
-func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SubscribeTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TransactionRequest) GetId() string {
+func (x *SubscribeTransactionsRequest) GetFromHeight() int64 {
 	if x != nil {
-		return x.Id
+		return x.FromHeight
 	}
-	return ""
+	return 0
 }
 
-type TransactionResponse struct {
+type SubscribeTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tranaction *TransactionInfo `protobuf:"bytes,1,opt,name=tranaction,proto3" json:"tranaction,omitempty"`
+	Height      int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Transaction *TransactionInfo `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *TransactionResponse) Reset() {
-	*x = TransactionResponse{}
+func (x *SubscribeTransactionsResponse) Reset() {
+	*x = SubscribeTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[16]
+		mi := &file_zarb_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionResponse) String() string {
+func (x *SubscribeTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResponse) ProtoMessage() {}
+func (*SubscribeTransactionsResponse) ProtoMessage() {}
 
-func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[16]
+func (x *SubscribeTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -942,43 +2034,48 @@ func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
-func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SubscribeTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *TransactionResponse) GetTranaction() *TransactionInfo {
+func (x *SubscribeTransactionsResponse) GetHeight() int64 {
 	if x != nil {
-		return x.Tranaction
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SubscribeTransactionsResponse) GetTransaction() *TransactionInfo {
+	if x != nil {
+		return x.Transaction
 	}
 	return nil
 }
 
-type SendRawTransactionRequest struct {
+type SubscribePendingTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SendRawTransactionRequest) Reset() {
-	*x = SendRawTransactionRequest{}
+func (x *SubscribePendingTransactionsRequest) Reset() {
+	*x = SubscribePendingTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[17]
+		mi := &file_zarb_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendRawTransactionRequest) String() string {
+func (x *SubscribePendingTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendRawTransactionRequest) ProtoMessage() {}
+func (*SubscribePendingTransactionsRequest) ProtoMessage() {}
 
-func (x *SendRawTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[17]
+func (x *SubscribePendingTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,43 +2086,36 @@ func (x *SendRawTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendRawTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *SendRawTransactionRequest) GetData() string {
-	if x != nil {
-		return x.Data
-	}
-	return ""
+// Deprecated: Use SubscribePendingTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePendingTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{35}
 }
 
-type SendRawTransactionResponse struct {
+type SubscribePendingTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Transaction *TransactionInfo `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *SendRawTransactionResponse) Reset() {
-	*x = SendRawTransactionResponse{}
+func (x *SubscribePendingTransactionsResponse) Reset() {
+	*x = SubscribePendingTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[18]
+		mi := &file_zarb_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendRawTransactionResponse) String() string {
+func (x *SubscribePendingTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendRawTransactionResponse) ProtoMessage() {}
+func (*SubscribePendingTransactionsResponse) ProtoMessage() {}
 
-func (x *SendRawTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[18]
+func (x *SubscribePendingTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,16 +2126,16 @@ func (x *SendRawTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendRawTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SubscribePendingTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribePendingTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SendRawTransactionResponse) GetId() string {
+func (x *SubscribePendingTransactionsResponse) GetTransaction() *TransactionInfo {
 	if x != nil {
-		return x.Id
+		return x.Transaction
 	}
-	return ""
+	return nil
 }
 
 // TODO: add unbond height
@@ -1068,7 +2158,7 @@ type ValidatorInfo struct {
 func (x *ValidatorInfo) Reset() {
 	*x = ValidatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[19]
+		mi := &file_zarb_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1081,7 +2171,7 @@ func (x *ValidatorInfo) String() string {
 func (*ValidatorInfo) ProtoMessage() {}
 
 func (x *ValidatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[19]
+	mi := &file_zarb_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,7 +2184,7 @@ func (x *ValidatorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorInfo.ProtoReflect.Descriptor instead.
 func (*ValidatorInfo) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{19}
+	return file_zarb_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ValidatorInfo) GetPublicKey() string {
@@ -1167,12 +2257,14 @@ type PeerInfo struct {
 	ReceivedMessages int32  `protobuf:"varint,7,opt,name=received_messages,json=receivedMessages,proto3" json:"received_messages,omitempty"`
 	InvalidMessages  int32  `protobuf:"varint,8,opt,name=invalid_messages,json=invalidMessages,proto3" json:"invalid_messages,omitempty"`
 	ReceivedBytes    int32  `protobuf:"varint,9,opt,name=received_bytes,json=receivedBytes,proto3" json:"received_bytes,omitempty"`
+	DroppedMessages  int32  `protobuf:"varint,10,opt,name=dropped_messages,json=droppedMessages,proto3" json:"dropped_messages,omitempty"`
+	Score            int32  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
 }
 
 func (x *PeerInfo) Reset() {
 	*x = PeerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[20]
+		mi := &file_zarb_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1185,7 +2277,7 @@ func (x *PeerInfo) String() string {
 func (*PeerInfo) ProtoMessage() {}
 
 func (x *PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[20]
+	mi := &file_zarb_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +2290,7 @@ func (x *PeerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerInfo.ProtoReflect.Descriptor instead.
 func (*PeerInfo) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{20}
+	return file_zarb_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *PeerInfo) GetMoniker() string {
@@ -1264,6 +2356,22 @@ func (x *PeerInfo) GetReceivedBytes() int32 {
 	return 0
 }
 
+func (x *PeerInfo) GetDroppedMessages() int32 {
+	if x != nil {
+		return x.DroppedMessages
+	}
+	return 0
+}
+
+func (x *PeerInfo) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// The locked part of the balance is unlocked over time by the vesting schedules.
+// Locked and unlocked balances are calculated for the next block.
 type AccountInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1273,12 +2381,14 @@ type AccountInfo struct {
 	Number   int32  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 	Sequence int64  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Balance  int64  `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Locked   int64  `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`
+	Unlocked int64  `protobuf:"varint,6,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
 }
 
 func (x *AccountInfo) Reset() {
 	*x = AccountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[21]
+		mi := &file_zarb_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1291,7 +2401,7 @@ func (x *AccountInfo) String() string {
 func (*AccountInfo) ProtoMessage() {}
 
 func (x *AccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[21]
+	mi := &file_zarb_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1304,7 +2414,7 @@ func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
 func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{21}
+	return file_zarb_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AccountInfo) GetAddress() string {
@@ -1314,25 +2424,94 @@ func (x *AccountInfo) GetAddress() string {
 	return ""
 }
 
-func (x *AccountInfo) GetNumber() int32 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
+func (x *AccountInfo) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *AccountInfo) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AccountInfo) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AccountInfo) GetLocked() int64 {
+	if x != nil {
+		return x.Locked
+	}
+	return 0
+}
+
+func (x *AccountInfo) GetUnlocked() int64 {
+	if x != nil {
+		return x.Unlocked
+	}
+	return 0
+}
+
+type MerkleProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    int64    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Siblings []string `protobuf:"bytes,2,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (x *MerkleProof) Reset() {
+	*x = MerkleProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zarb_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MerkleProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerkleProof) ProtoMessage() {}
+
+func (x *MerkleProof) ProtoReflect() protoreflect.Message {
+	mi := &file_zarb_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerkleProof.ProtoReflect.Descriptor instead.
+func (*MerkleProof) Descriptor() ([]byte, []int) {
+	return file_zarb_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AccountInfo) GetSequence() int64 {
+func (x *MerkleProof) GetIndex() int64 {
 	if x != nil {
-		return x.Sequence
+		return x.Index
 	}
 	return 0
 }
 
-func (x *AccountInfo) GetBalance() int64 {
+func (x *MerkleProof) GetSiblings() []string {
 	if x != nil {
-		return x.Balance
+		return x.Siblings
 	}
-	return 0
+	return nil
 }
 
 type BlockHeaderInfo struct {
@@ -1352,7 +2531,7 @@ type BlockHeaderInfo struct {
 func (x *BlockHeaderInfo) Reset() {
 	*x = BlockHeaderInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[22]
+		mi := &file_zarb_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1365,7 +2544,7 @@ func (x *BlockHeaderInfo) String() string {
 func (*BlockHeaderInfo) ProtoMessage() {}
 
 func (x *BlockHeaderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[22]
+	mi := &file_zarb_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +2557,7 @@ func (x *BlockHeaderInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHeaderInfo.ProtoReflect.Descriptor instead.
 func (*BlockHeaderInfo) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{22}
+	return file_zarb_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *BlockHeaderInfo) GetVersion() int32 {
@@ -1444,7 +2623,7 @@ type CertificateInfo struct {
 func (x *CertificateInfo) Reset() {
 	*x = CertificateInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[23]
+		mi := &file_zarb_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1457,7 +2636,7 @@ func (x *CertificateInfo) String() string {
 func (*CertificateInfo) ProtoMessage() {}
 
 func (x *CertificateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[23]
+	mi := &file_zarb_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,7 +2649,7 @@ func (x *CertificateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CertificateInfo.ProtoReflect.Descriptor instead.
 func (*CertificateInfo) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{23}
+	return file_zarb_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CertificateInfo) GetRound() int64 {
@@ -1525,7 +2704,7 @@ type TransactionInfo struct {
 func (x *TransactionInfo) Reset() {
 	*x = TransactionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zarb_proto_msgTypes[24]
+		mi := &file_zarb_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1538,7 +2717,7 @@ func (x *TransactionInfo) String() string {
 func (*TransactionInfo) ProtoMessage() {}
 
 func (x *TransactionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_zarb_proto_msgTypes[24]
+	mi := &file_zarb_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +2730,7 @@ func (x *TransactionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionInfo.ProtoReflect.Descriptor instead.
 func (*TransactionInfo) Descriptor() ([]byte, []int) {
-	return file_zarb_proto_rawDescGZIP(), []int{24}
+	return file_zarb_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *TransactionInfo) GetId() string {
@@ -1682,255 +2861,470 @@ var file_zarb_proto_rawDesc = []byte{
 	0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x2b, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x11, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x13, 0x0a,
-	0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x2c, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a,
+	0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb7,
+	0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x32, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22,
-	0x46, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x32, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
-	0x69, 0x74, 0x79, 0x22, 0x90, 0x02, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x22, 0x2d, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x17, 0x0a, 0x15, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x24,
-	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x74,
-	0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x13,
+	0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x32, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x49, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x22, 0x46, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x16,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5a, 0x0a,
+	0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x09,
+	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x22, 0x90, 0x02, 0x0a, 0x0d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x39, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x14, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x13,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x28, 0x0a, 0x12,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58,
+	0x0a, 0x16, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54,
+	0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x7a, 0x61, 0x72, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x74, 0x72,
+	0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x2f, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x1a, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x1a, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd8, 0x01, 0x0a, 0x1b, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2d, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x22, 0x62, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x6f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x70, 0x6f, 0x6f, 0x6c, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x75, 0x6c,
+	0x6c, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x6d, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x09, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x69, 0x74, 0x79, 0x22, 0x5c, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x3f, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x70, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x23, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x24,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x02,
+	0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x08,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69,
+	0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xa9,
+	0x01, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0b, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x0f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65,
+	0x76, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x78, 0x49, 0x64, 0x73, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x70, 0x72, 0x65, 0x76, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x09, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9b, 0x03, 0x0a,
+	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x25,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x53,
+	0x45, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x48, 0x00, 0x52, 0x04, 0x73,
+	0x65, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x4f, 0x4e,
+	0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e,
+	0x64, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e,
+	0x53, 0x4f, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41,
+	0x44, 0x48, 0x00, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65,
+	0x6d, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0xbe, 0x02, 0x0a, 0x0b, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f,
+	0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4f, 0x4e,
+	0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x4f, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44,
+	0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59,
+	0x4c, 0x4f, 0x41, 0x44, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52,
+	0x41, 0x57, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f,
+	0x41, 0x44, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e,
+	0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44,
+	0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41,
+	0x44, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x50, 0x41, 0x59,
+	0x4c, 0x4f, 0x41, 0x44, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4a, 0x41, 0x49, 0x4c,
+	0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52,
+	0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x0c,
+	0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x56, 0x4f, 0x54,
+	0x45, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x0d, 0x2a, 0x48, 0x0a, 0x0e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x53, 0x10, 0x02, 0x32, 0xc0, 0x10, 0x0a, 0x04, 0x5a, 0x61, 0x72, 0x62, 0x12, 0x57,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x2e, 0x7a, 0x61, 0x72,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x67, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d,
+	0x12, 0x66, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
+	0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x69, 0x64, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x1d, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x7a, 0x61, 0x72, 0x62,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x20, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x70, 0x61, 0x67, 0x65, 0x7d, 0x12,
+	0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x17, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x70, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1e, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x12, 0x76, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b,
+	0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x7a, 0x61,
+	0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x12, 0x67, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x81, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64,
+	0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72,
 	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x2e, 0x0a, 0x13,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x42,
-	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x4a, 0x6f,
-	0x69, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e,
-	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x9f, 0x02, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
-	0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x22, 0x75, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x0f, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x70, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
-	0x0b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x78, 0x49, 0x64, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a,
-	0x15, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72,
-	0x65, 0x76, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
-	0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x09, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x0f, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x7a, 0x61, 0x72, 0x62,
-	0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x45, 0x4e, 0x44,
-	0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x48, 0x00, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x64,
-	0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x50,
-	0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x3b,
-	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x4f, 0x52,
-	0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x48, 0x00,
-	0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d,
-	0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x09, 0x0a, 0x07,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x69, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c,
-	0x4f, 0x41, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x50, 0x41,
-	0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x52, 0x54, 0x49,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x03, 0x12, 0x12,
-	0x0a, 0x0e, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44,
-	0x10, 0x04, 0x2a, 0x48, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x62, 0x6f,
-	0x73, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x49, 0x4e,
-	0x46, 0x4f, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x32, 0x97, 0x08, 0x0a,
-	0x04, 0x5a, 0x61, 0x72, 0x62, 0x12, 0x57, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x12, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x67,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x7a, 0x61, 0x72,
-	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x66, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61,
-	0x6e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x69, 0x64, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
-	0x61, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e,
-	0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x7a,
-	0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x69, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x16, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x70, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x12, 0x67, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1b, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x12, 0x81, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x7a, 0x61, 0x72, 0x62,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x7a, 0x61, 0x72,
-	0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x22, 0x1a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x72, 0x61, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x7b, 0x64, 0x61, 0x74, 0x61, 0x7d, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x61, 0x72, 0x62, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x7a,
-	0x61, 0x72, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x77, 0x77, 0x77, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
-	0x7a, 0x61, 0x72, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x1a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x64, 0x61, 0x74, 0x61, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x13,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x64, 0x61, 0x74,
+	0x61, 0x7d, 0x12, 0x75, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x18, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x7a, 0x61,
+	0x72, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x2f, 0x7b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d,
+	0x2f, 0x7b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x12, 0x50, 0x0a, 0x0f, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x2e, 0x7a,
+	0x61, 0x72, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x7a, 0x61, 0x72,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x15, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
+	0x77, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x29, 0x2e, 0x7a, 0x61, 0x72, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x7a, 0x61, 0x72,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x61, 0x72, 0x62, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x7a, 0x61, 0x72, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x77, 0x77, 0x77, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x7a, 0x61, 0x72, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1946,80 +3340,131 @@ func file_zarb_proto_rawDescGZIP() []byte {
 }
 
 var file_zarb_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_zarb_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_zarb_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_zarb_proto_goTypes = []interface{}{
-	(PayloadType)(0),                   // 0: zarb.PayloadType
-	(BlockVerbosity)(0),                // 1: zarb.BlockVerbosity
-	(*AccountRequest)(nil),             // 2: zarb.AccountRequest
-	(*AccountResponse)(nil),            // 3: zarb.AccountResponse
-	(*ValidatorsRequest)(nil),          // 4: zarb.ValidatorsRequest
-	(*ValidatorRequest)(nil),           // 5: zarb.ValidatorRequest
-	(*ValidatorByNumberRequest)(nil),   // 6: zarb.ValidatorByNumberRequest
-	(*ValidatorsResponse)(nil),         // 7: zarb.ValidatorsResponse
-	(*ValidatorResponse)(nil),          // 8: zarb.ValidatorResponse
-	(*BlockRequest)(nil),               // 9: zarb.BlockRequest
-	(*BlockResponse)(nil),              // 10: zarb.BlockResponse
-	(*BlockHeightRequest)(nil),         // 11: zarb.BlockHeightRequest
-	(*BlockHeightResponse)(nil),        // 12: zarb.BlockHeightResponse
-	(*BlockchainInfoRequest)(nil),      // 13: zarb.BlockchainInfoRequest
-	(*BlockchainInfoResponse)(nil),     // 14: zarb.BlockchainInfoResponse
-	(*NetworkInfoRequest)(nil),         // 15: zarb.NetworkInfoRequest
-	(*NetworkInfoResponse)(nil),        // 16: zarb.NetworkInfoResponse
-	(*TransactionRequest)(nil),         // 17: zarb.TransactionRequest
-	(*TransactionResponse)(nil),        // 18: zarb.TransactionResponse
-	(*SendRawTransactionRequest)(nil),  // 19: zarb.SendRawTransactionRequest
-	(*SendRawTransactionResponse)(nil), // 20: zarb.SendRawTransactionResponse
-	(*ValidatorInfo)(nil),              // 21: zarb.ValidatorInfo
-	(*PeerInfo)(nil),                   // 22: zarb.PeerInfo
-	(*AccountInfo)(nil),                // 23: zarb.AccountInfo
-	(*BlockHeaderInfo)(nil),            // 24: zarb.BlockHeaderInfo
-	(*CertificateInfo)(nil),            // 25: zarb.CertificateInfo
-	(*TransactionInfo)(nil),            // 26: zarb.TransactionInfo
-	(*timestamppb.Timestamp)(nil),      // 27: google.protobuf.Timestamp
-	(*SEND_PAYLOAD)(nil),               // 28: payloads.SEND_PAYLOAD
-	(*BOND_PAYLOAD)(nil),               // 29: payloads.BOND_PAYLOAD
-	(*SORTITION_PAYLOAD)(nil),          // 30: payloads.SORTITION_PAYLOAD
+	(PayloadType)(0),                             // 0: zarb.PayloadType
+	(BlockVerbosity)(0),                          // 1: zarb.BlockVerbosity
+	(*AccountRequest)(nil),                       // 2: zarb.AccountRequest
+	(*AccountResponse)(nil),                      // 3: zarb.AccountResponse
+	(*AccountProofRequest)(nil),                  // 4: zarb.AccountProofRequest
+	(*AccountProofResponse)(nil),                 // 5: zarb.AccountProofResponse
+	(*AccountTransactionsRequest)(nil),           // 6: zarb.AccountTransactionsRequest
+	(*AccountTransactionsResponse)(nil),          // 7: zarb.AccountTransactionsResponse
+	(*ValidatorsRequest)(nil),                    // 8: zarb.ValidatorsRequest
+	(*ValidatorRequest)(nil),                     // 9: zarb.ValidatorRequest
+	(*ValidatorByNumberRequest)(nil),             // 10: zarb.ValidatorByNumberRequest
+	(*ValidatorsResponse)(nil),                   // 11: zarb.ValidatorsResponse
+	(*ValidatorResponse)(nil),                    // 12: zarb.ValidatorResponse
+	(*ValidatorProofRequest)(nil),                // 13: zarb.ValidatorProofRequest
+	(*ValidatorProofResponse)(nil),               // 14: zarb.ValidatorProofResponse
+	(*BlockRequest)(nil),                         // 15: zarb.BlockRequest
+	(*BlockResponse)(nil),                        // 16: zarb.BlockResponse
+	(*BlockHeightRequest)(nil),                   // 17: zarb.BlockHeightRequest
+	(*BlockHeightResponse)(nil),                  // 18: zarb.BlockHeightResponse
+	(*BlockchainInfoRequest)(nil),                // 19: zarb.BlockchainInfoRequest
+	(*BlockchainInfoResponse)(nil),               // 20: zarb.BlockchainInfoResponse
+	(*NetworkInfoRequest)(nil),                   // 21: zarb.NetworkInfoRequest
+	(*NetworkInfoResponse)(nil),                  // 22: zarb.NetworkInfoResponse
+	(*TransactionRequest)(nil),                   // 23: zarb.TransactionRequest
+	(*TransactionResponse)(nil),                  // 24: zarb.TransactionResponse
+	(*TransactionProofRequest)(nil),              // 25: zarb.TransactionProofRequest
+	(*TransactionProofResponse)(nil),             // 26: zarb.TransactionProofResponse
+	(*SendRawTransactionRequest)(nil),            // 27: zarb.SendRawTransactionRequest
+	(*SendRawTransactionResponse)(nil),           // 28: zarb.SendRawTransactionResponse
+	(*SimulateTransactionRequest)(nil),           // 29: zarb.SimulateTransactionRequest
+	(*SimulateTransactionResponse)(nil),          // 30: zarb.SimulateTransactionResponse
+	(*EstimateFeeRequest)(nil),                   // 31: zarb.EstimateFeeRequest
+	(*EstimateFeeResponse)(nil),                  // 32: zarb.EstimateFeeResponse
+	(*SubscribeBlocksRequest)(nil),               // 33: zarb.SubscribeBlocksRequest
+	(*SubscribeBlocksResponse)(nil),              // 34: zarb.SubscribeBlocksResponse
+	(*SubscribeTransactionsRequest)(nil),         // 35: zarb.SubscribeTransactionsRequest
+	(*SubscribeTransactionsResponse)(nil),        // 36: zarb.SubscribeTransactionsResponse
+	(*SubscribePendingTransactionsRequest)(nil),  // 37: zarb.SubscribePendingTransactionsRequest
+	(*SubscribePendingTransactionsResponse)(nil), // 38: zarb.SubscribePendingTransactionsResponse
+	(*ValidatorInfo)(nil),                        // 39: zarb.ValidatorInfo
+	(*PeerInfo)(nil),                             // 40: zarb.PeerInfo
+	(*AccountInfo)(nil),                          // 41: zarb.AccountInfo
+	(*MerkleProof)(nil),                          // 42: zarb.MerkleProof
+	(*BlockHeaderInfo)(nil),                      // 43: zarb.BlockHeaderInfo
+	(*CertificateInfo)(nil),                      // 44: zarb.CertificateInfo
+	(*TransactionInfo)(nil),                      // 45: zarb.TransactionInfo
+	(*timestamppb.Timestamp)(nil),                // 46: google.protobuf.Timestamp
+	(*SEND_PAYLOAD)(nil),                         // 47: payloads.SEND_PAYLOAD
+	(*BOND_PAYLOAD)(nil),                         // 48: payloads.BOND_PAYLOAD
+	(*SORTITION_PAYLOAD)(nil),                    // 49: payloads.SORTITION_PAYLOAD
 }
 var file_zarb_proto_depIdxs = []int32{
-	23, // 0: zarb.AccountResponse.account:type_name -> zarb.AccountInfo
-	21, // 1: zarb.ValidatorsResponse.validators:type_name -> zarb.ValidatorInfo
-	21, // 2: zarb.ValidatorResponse.validator:type_name -> zarb.ValidatorInfo
-	1,  // 3: zarb.BlockRequest.verbosity:type_name -> zarb.BlockVerbosity
-	27, // 4: zarb.BlockResponse.block_time:type_name -> google.protobuf.Timestamp
-	24, // 5: zarb.BlockResponse.header:type_name -> zarb.BlockHeaderInfo
-	25, // 6: zarb.BlockResponse.previous_certificate:type_name -> zarb.CertificateInfo
-	26, // 7: zarb.BlockResponse.tranactions:type_name -> zarb.TransactionInfo
-	22, // 8: zarb.NetworkInfoResponse.peers:type_name -> zarb.PeerInfo
-	26, // 9: zarb.TransactionResponse.tranaction:type_name -> zarb.TransactionInfo
-	0,  // 10: zarb.TransactionInfo.Type:type_name -> zarb.PayloadType
-	28, // 11: zarb.TransactionInfo.send:type_name -> payloads.SEND_PAYLOAD
-	29, // 12: zarb.TransactionInfo.bond:type_name -> payloads.BOND_PAYLOAD
-	30, // 13: zarb.TransactionInfo.sortition:type_name -> payloads.SORTITION_PAYLOAD
-	9,  // 14: zarb.Zarb.GetBlock:input_type -> zarb.BlockRequest
-	11, // 15: zarb.Zarb.GetBlockHeight:input_type -> zarb.BlockHeightRequest
-	17, // 16: zarb.Zarb.GetTransaction:input_type -> zarb.TransactionRequest
-	2,  // 17: zarb.Zarb.GetAccount:input_type -> zarb.AccountRequest
-	4,  // 18: zarb.Zarb.GetValidators:input_type -> zarb.ValidatorsRequest
-	5,  // 19: zarb.Zarb.GetValidator:input_type -> zarb.ValidatorRequest
-	6,  // 20: zarb.Zarb.GetValidatorByNumber:input_type -> zarb.ValidatorByNumberRequest
-	13, // 21: zarb.Zarb.GetBlockchainInfo:input_type -> zarb.BlockchainInfoRequest
-	15, // 22: zarb.Zarb.GetNetworkInfo:input_type -> zarb.NetworkInfoRequest
-	19, // 23: zarb.Zarb.SendRawTransaction:input_type -> zarb.SendRawTransactionRequest
-	10, // 24: zarb.Zarb.GetBlock:output_type -> zarb.BlockResponse
-	12, // 25: zarb.Zarb.GetBlockHeight:output_type -> zarb.BlockHeightResponse
-	18, // 26: zarb.Zarb.GetTransaction:output_type -> zarb.TransactionResponse
-	3,  // 27: zarb.Zarb.GetAccount:output_type -> zarb.AccountResponse
-	7,  // 28: zarb.Zarb.GetValidators:output_type -> zarb.ValidatorsResponse
-	8,  // 29: zarb.Zarb.GetValidator:output_type -> zarb.ValidatorResponse
-	8,  // 30: zarb.Zarb.GetValidatorByNumber:output_type -> zarb.ValidatorResponse
-	14, // 31: zarb.Zarb.GetBlockchainInfo:output_type -> zarb.BlockchainInfoResponse
-	16, // 32: zarb.Zarb.GetNetworkInfo:output_type -> zarb.NetworkInfoResponse
-	20, // 33: zarb.Zarb.SendRawTransaction:output_type -> zarb.SendRawTransactionResponse
-	24, // [24:34] is the sub-list for method output_type
-	14, // [14:24] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	41, // 0: zarb.AccountResponse.account:type_name -> zarb.AccountInfo
+	41, // 1: zarb.AccountProofResponse.account:type_name -> zarb.AccountInfo
+	42, // 2: zarb.AccountProofResponse.proof:type_name -> zarb.MerkleProof
+	45, // 3: zarb.AccountTransactionsResponse.transactions:type_name -> zarb.TransactionInfo
+	39, // 4: zarb.ValidatorsResponse.validators:type_name -> zarb.ValidatorInfo
+	39, // 5: zarb.ValidatorResponse.validator:type_name -> zarb.ValidatorInfo
+	39, // 6: zarb.ValidatorProofResponse.validator:type_name -> zarb.ValidatorInfo
+	42, // 7: zarb.ValidatorProofResponse.proof:type_name -> zarb.MerkleProof
+	1,  // 8: zarb.BlockRequest.verbosity:type_name -> zarb.BlockVerbosity
+	46, // 9: zarb.BlockResponse.block_time:type_name -> google.protobuf.Timestamp
+	43, // 10: zarb.BlockResponse.header:type_name -> zarb.BlockHeaderInfo
+	44, // 11: zarb.BlockResponse.previous_certificate:type_name -> zarb.CertificateInfo
+	45, // 12: zarb.BlockResponse.tranactions:type_name -> zarb.TransactionInfo
+	40, // 13: zarb.NetworkInfoResponse.peers:type_name -> zarb.PeerInfo
+	45, // 14: zarb.TransactionResponse.tranaction:type_name -> zarb.TransactionInfo
+	45, // 15: zarb.TransactionProofResponse.transaction:type_name -> zarb.TransactionInfo
+	42, // 16: zarb.TransactionProofResponse.proof:type_name -> zarb.MerkleProof
+	41, // 17: zarb.SimulateTransactionResponse.accounts:type_name -> zarb.AccountInfo
+	39, // 18: zarb.SimulateTransactionResponse.validators:type_name -> zarb.ValidatorInfo
+	0,  // 19: zarb.EstimateFeeRequest.payload_type:type_name -> zarb.PayloadType
+	1,  // 20: zarb.SubscribeBlocksRequest.verbosity:type_name -> zarb.BlockVerbosity
+	16, // 21: zarb.SubscribeBlocksResponse.block:type_name -> zarb.BlockResponse
+	45, // 22: zarb.SubscribeTransactionsResponse.transaction:type_name -> zarb.TransactionInfo
+	45, // 23: zarb.SubscribePendingTransactionsResponse.transaction:type_name -> zarb.TransactionInfo
+	0,  // 24: zarb.TransactionInfo.Type:type_name -> zarb.PayloadType
+	47, // 25: zarb.TransactionInfo.send:type_name -> payloads.SEND_PAYLOAD
+	48, // 26: zarb.TransactionInfo.bond:type_name -> payloads.BOND_PAYLOAD
+	49, // 27: zarb.TransactionInfo.sortition:type_name -> payloads.SORTITION_PAYLOAD
+	15, // 28: zarb.Zarb.GetBlock:input_type -> zarb.BlockRequest
+	17, // 29: zarb.Zarb.GetBlockHeight:input_type -> zarb.BlockHeightRequest
+	23, // 30: zarb.Zarb.GetTransaction:input_type -> zarb.TransactionRequest
+	25, // 31: zarb.Zarb.GetTransactionProof:input_type -> zarb.TransactionProofRequest
+	2,  // 32: zarb.Zarb.GetAccount:input_type -> zarb.AccountRequest
+	4,  // 33: zarb.Zarb.GetAccountProof:input_type -> zarb.AccountProofRequest
+	6,  // 34: zarb.Zarb.GetAccountTransactions:input_type -> zarb.AccountTransactionsRequest
+	8,  // 35: zarb.Zarb.GetValidators:input_type -> zarb.ValidatorsRequest
+	9,  // 36: zarb.Zarb.GetValidator:input_type -> zarb.ValidatorRequest
+	10, // 37: zarb.Zarb.GetValidatorByNumber:input_type -> zarb.ValidatorByNumberRequest
+	13, // 38: zarb.Zarb.GetValidatorProof:input_type -> zarb.ValidatorProofRequest
+	19, // 39: zarb.Zarb.GetBlockchainInfo:input_type -> zarb.BlockchainInfoRequest
+	21, // 40: zarb.Zarb.GetNetworkInfo:input_type -> zarb.NetworkInfoRequest
+	27, // 41: zarb.Zarb.SendRawTransaction:input_type -> zarb.SendRawTransactionRequest
+	29, // 42: zarb.Zarb.SimulateTransaction:input_type -> zarb.SimulateTransactionRequest
+	31, // 43: zarb.Zarb.EstimateFee:input_type -> zarb.EstimateFeeRequest
+	33, // 44: zarb.Zarb.SubscribeBlocks:input_type -> zarb.SubscribeBlocksRequest
+	35, // 45: zarb.Zarb.SubscribeTransactions:input_type -> zarb.SubscribeTransactionsRequest
+	37, // 46: zarb.Zarb.SubscribePendingTransactions:input_type -> zarb.SubscribePendingTransactionsRequest
+	16, // 47: zarb.Zarb.GetBlock:output_type -> zarb.BlockResponse
+	18, // 48: zarb.Zarb.GetBlockHeight:output_type -> zarb.BlockHeightResponse
+	24, // 49: zarb.Zarb.GetTransaction:output_type -> zarb.TransactionResponse
+	26, // 50: zarb.Zarb.GetTransactionProof:output_type -> zarb.TransactionProofResponse
+	3,  // 51: zarb.Zarb.GetAccount:output_type -> zarb.AccountResponse
+	5,  // 52: zarb.Zarb.GetAccountProof:output_type -> zarb.AccountProofResponse
+	7,  // 53: zarb.Zarb.GetAccountTransactions:output_type -> zarb.AccountTransactionsResponse
+	11, // 54: zarb.Zarb.GetValidators:output_type -> zarb.ValidatorsResponse
+	12, // 55: zarb.Zarb.GetValidator:output_type -> zarb.ValidatorResponse
+	12, // 56: zarb.Zarb.GetValidatorByNumber:output_type -> zarb.ValidatorResponse
+	14, // 57: zarb.Zarb.GetValidatorProof:output_type -> zarb.ValidatorProofResponse
+	20, // 58: zarb.Zarb.GetBlockchainInfo:output_type -> zarb.BlockchainInfoResponse
+	22, // 59: zarb.Zarb.GetNetworkInfo:output_type -> zarb.NetworkInfoResponse
+	28, // 60: zarb.Zarb.SendRawTransaction:output_type -> zarb.SendRawTransactionResponse
+	30, // 61: zarb.Zarb.SimulateTransaction:output_type -> zarb.SimulateTransactionResponse
+	32, // 62: zarb.Zarb.EstimateFee:output_type -> zarb.EstimateFeeResponse
+	34, // 63: zarb.Zarb.SubscribeBlocks:output_type -> zarb.SubscribeBlocksResponse
+	36, // 64: zarb.Zarb.SubscribeTransactions:output_type -> zarb.SubscribeTransactionsResponse
+	38, // 65: zarb.Zarb.SubscribePendingTransactions:output_type -> zarb.SubscribePendingTransactionsResponse
+	47, // [47:66] is the sub-list for method output_type
+	28, // [28:47] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_zarb_proto_init() }
@@ -2054,7 +3499,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorsRequest); i {
+			switch v := v.(*AccountProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2066,7 +3511,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorRequest); i {
+			switch v := v.(*AccountProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2078,7 +3523,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorByNumberRequest); i {
+			switch v := v.(*AccountTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2090,7 +3535,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorsResponse); i {
+			switch v := v.(*AccountTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2102,7 +3547,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorResponse); i {
+			switch v := v.(*ValidatorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2114,7 +3559,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockRequest); i {
+			switch v := v.(*ValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2126,7 +3571,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockResponse); i {
+			switch v := v.(*ValidatorByNumberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2138,7 +3583,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightRequest); i {
+			switch v := v.(*ValidatorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2150,7 +3595,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeightResponse); i {
+			switch v := v.(*ValidatorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2162,7 +3607,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockchainInfoRequest); i {
+			switch v := v.(*ValidatorProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2174,7 +3619,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockchainInfoResponse); i {
+			switch v := v.(*ValidatorProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2186,7 +3631,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkInfoRequest); i {
+			switch v := v.(*BlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2198,7 +3643,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkInfoResponse); i {
+			switch v := v.(*BlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2210,7 +3655,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionRequest); i {
+			switch v := v.(*BlockHeightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2222,7 +3667,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionResponse); i {
+			switch v := v.(*BlockHeightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2234,7 +3679,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendRawTransactionRequest); i {
+			switch v := v.(*BlockchainInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2246,7 +3691,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendRawTransactionResponse); i {
+			switch v := v.(*BlockchainInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2258,7 +3703,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorInfo); i {
+			switch v := v.(*NetworkInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2270,7 +3715,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerInfo); i {
+			switch v := v.(*NetworkInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2282,7 +3727,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountInfo); i {
+			switch v := v.(*TransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2294,7 +3739,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeaderInfo); i {
+			switch v := v.(*TransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2306,7 +3751,7 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CertificateInfo); i {
+			switch v := v.(*TransactionProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2318,6 +3763,234 @@ func file_zarb_proto_init() {
 			}
 		}
 		file_zarb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRawTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRawTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePendingTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePendingTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MerkleProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeaderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zarb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionInfo); i {
 			case 0:
 				return &v.state
@@ -2330,7 +4003,7 @@ func file_zarb_proto_init() {
 			}
 		}
 	}
-	file_zarb_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_zarb_proto_msgTypes[43].OneofWrappers = []interface{}{
 		(*TransactionInfo_Send)(nil),
 		(*TransactionInfo_Bond)(nil),
 		(*TransactionInfo_Sortition)(nil),
@@ -2341,7 +4014,7 @@ func file_zarb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zarb_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   25,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,13 +21,22 @@ type ZarbClient interface {
 	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	GetBlockHeight(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*BlockHeightResponse, error)
 	GetTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	GetTransactionProof(ctx context.Context, in *TransactionProofRequest, opts ...grpc.CallOption) (*TransactionProofResponse, error)
 	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
+	GetAccountProof(ctx context.Context, in *AccountProofRequest, opts ...grpc.CallOption) (*AccountProofResponse, error)
+	GetAccountTransactions(ctx context.Context, in *AccountTransactionsRequest, opts ...grpc.CallOption) (*AccountTransactionsResponse, error)
 	GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error)
 	GetValidator(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
 	GetValidatorByNumber(ctx context.Context, in *ValidatorByNumberRequest, opts ...grpc.CallOption) (*ValidatorResponse, error)
+	GetValidatorProof(ctx context.Context, in *ValidatorProofRequest, opts ...grpc.CallOption) (*ValidatorProofResponse, error)
 	GetBlockchainInfo(ctx context.Context, in *BlockchainInfoRequest, opts ...grpc.CallOption) (*BlockchainInfoResponse, error)
 	GetNetworkInfo(ctx context.Context, in *NetworkInfoRequest, opts ...grpc.CallOption) (*NetworkInfoResponse, error)
 	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
+	SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulateTransactionResponse, error)
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Zarb_SubscribeBlocksClient, error)
+	SubscribeTransactions(ctx context.Context, in *SubscribeTransactionsRequest, opts ...grpc.CallOption) (Zarb_SubscribeTransactionsClient, error)
+	SubscribePendingTransactions(ctx context.Context, in *SubscribePendingTransactionsRequest, opts ...grpc.CallOption) (Zarb_SubscribePendingTransactionsClient, error)
 }
 
 type zarbClient struct {
@@ -65,6 +74,15 @@ func (c *zarbClient) GetTransaction(ctx context.Context, in *TransactionRequest,
 	return out, nil
 }
 
+func (c *zarbClient) GetTransactionProof(ctx context.Context, in *TransactionProofRequest, opts ...grpc.CallOption) (*TransactionProofResponse, error) {
+	out := new(TransactionProofResponse)
+	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetTransactionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *zarbClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error) {
 	out := new(AccountResponse)
 	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetAccount", in, out, opts...)
@@ -74,6 +92,24 @@ func (c *zarbClient) GetAccount(ctx context.Context, in *AccountRequest, opts ..
 	return out, nil
 }
 
+func (c *zarbClient) GetAccountProof(ctx context.Context, in *AccountProofRequest, opts ...grpc.CallOption) (*AccountProofResponse, error) {
+	out := new(AccountProofResponse)
+	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zarbClient) GetAccountTransactions(ctx context.Context, in *AccountTransactionsRequest, opts ...grpc.CallOption) (*AccountTransactionsResponse, error) {
+	out := new(AccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetAccountTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *zarbClient) GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsResponse, error) {
 	out := new(ValidatorsResponse)
 	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetValidators", in, out, opts...)
@@ -101,6 +137,15 @@ func (c *zarbClient) GetValidatorByNumber(ctx context.Context, in *ValidatorByNu
 	return out, nil
 }
 
+func (c *zarbClient) GetValidatorProof(ctx context.Context, in *ValidatorProofRequest, opts ...grpc.CallOption) (*ValidatorProofResponse, error) {
+	out := new(ValidatorProofResponse)
+	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetValidatorProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *zarbClient) GetBlockchainInfo(ctx context.Context, in *BlockchainInfoRequest, opts ...grpc.CallOption) (*BlockchainInfoResponse, error) {
 	out := new(BlockchainInfoResponse)
 	err := c.cc.Invoke(ctx, "/zarb.Zarb/GetBlockchainInfo", in, out, opts...)
@@ -128,6 +173,120 @@ func (c *zarbClient) SendRawTransaction(ctx context.Context, in *SendRawTransact
 	return out, nil
 }
 
+func (c *zarbClient) SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulateTransactionResponse, error) {
+	out := new(SimulateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/zarb.Zarb/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zarbClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/zarb.Zarb/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zarbClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Zarb_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Zarb_ServiceDesc.Streams[0], "/zarb.Zarb/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &zarbSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Zarb_SubscribeBlocksClient interface {
+	Recv() (*SubscribeBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type zarbSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *zarbSubscribeBlocksClient) Recv() (*SubscribeBlocksResponse, error) {
+	m := new(SubscribeBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *zarbClient) SubscribeTransactions(ctx context.Context, in *SubscribeTransactionsRequest, opts ...grpc.CallOption) (Zarb_SubscribeTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Zarb_ServiceDesc.Streams[1], "/zarb.Zarb/SubscribeTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &zarbSubscribeTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Zarb_SubscribeTransactionsClient interface {
+	Recv() (*SubscribeTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type zarbSubscribeTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *zarbSubscribeTransactionsClient) Recv() (*SubscribeTransactionsResponse, error) {
+	m := new(SubscribeTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *zarbClient) SubscribePendingTransactions(ctx context.Context, in *SubscribePendingTransactionsRequest, opts ...grpc.CallOption) (Zarb_SubscribePendingTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Zarb_ServiceDesc.Streams[2], "/zarb.Zarb/SubscribePendingTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &zarbSubscribePendingTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Zarb_SubscribePendingTransactionsClient interface {
+	Recv() (*SubscribePendingTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type zarbSubscribePendingTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *zarbSubscribePendingTransactionsClient) Recv() (*SubscribePendingTransactionsResponse, error) {
+	m := new(SubscribePendingTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ZarbServer is the server API for Zarb service.
 // All implementations should embed UnimplementedZarbServer
 // for forward compatibility
@@ -135,13 +294,22 @@ type ZarbServer interface {
 	GetBlock(context.Context, *BlockRequest) (*BlockResponse, error)
 	GetBlockHeight(context.Context, *BlockHeightRequest) (*BlockHeightResponse, error)
 	GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error)
+	GetTransactionProof(context.Context, *TransactionProofRequest) (*TransactionProofResponse, error)
 	GetAccount(context.Context, *AccountRequest) (*AccountResponse, error)
+	GetAccountProof(context.Context, *AccountProofRequest) (*AccountProofResponse, error)
+	GetAccountTransactions(context.Context, *AccountTransactionsRequest) (*AccountTransactionsResponse, error)
 	GetValidators(context.Context, *ValidatorsRequest) (*ValidatorsResponse, error)
 	GetValidator(context.Context, *ValidatorRequest) (*ValidatorResponse, error)
 	GetValidatorByNumber(context.Context, *ValidatorByNumberRequest) (*ValidatorResponse, error)
+	GetValidatorProof(context.Context, *ValidatorProofRequest) (*ValidatorProofResponse, error)
 	GetBlockchainInfo(context.Context, *BlockchainInfoRequest) (*BlockchainInfoResponse, error)
 	GetNetworkInfo(context.Context, *NetworkInfoRequest) (*NetworkInfoResponse, error)
 	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
+	SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulateTransactionResponse, error)
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	SubscribeBlocks(*SubscribeBlocksRequest, Zarb_SubscribeBlocksServer) error
+	SubscribeTransactions(*SubscribeTransactionsRequest, Zarb_SubscribeTransactionsServer) error
+	SubscribePendingTransactions(*SubscribePendingTransactionsRequest, Zarb_SubscribePendingTransactionsServer) error
 }
 
 // UnimplementedZarbServer should be embedded to have forward compatible implementations.
@@ -157,9 +325,18 @@ func (UnimplementedZarbServer) GetBlockHeight(context.Context, *BlockHeightReque
 func (UnimplementedZarbServer) GetTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
+func (UnimplementedZarbServer) GetTransactionProof(context.Context, *TransactionProofRequest) (*TransactionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionProof not implemented")
+}
 func (UnimplementedZarbServer) GetAccount(context.Context, *AccountRequest) (*AccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
+func (UnimplementedZarbServer) GetAccountProof(context.Context, *AccountProofRequest) (*AccountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountProof not implemented")
+}
+func (UnimplementedZarbServer) GetAccountTransactions(context.Context, *AccountTransactionsRequest) (*AccountTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTransactions not implemented")
+}
 func (UnimplementedZarbServer) GetValidators(context.Context, *ValidatorsRequest) (*ValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
 }
@@ -169,6 +346,9 @@ func (UnimplementedZarbServer) GetValidator(context.Context, *ValidatorRequest)
 func (UnimplementedZarbServer) GetValidatorByNumber(context.Context, *ValidatorByNumberRequest) (*ValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorByNumber not implemented")
 }
+func (UnimplementedZarbServer) GetValidatorProof(context.Context, *ValidatorProofRequest) (*ValidatorProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorProof not implemented")
+}
 func (UnimplementedZarbServer) GetBlockchainInfo(context.Context, *BlockchainInfoRequest) (*BlockchainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockchainInfo not implemented")
 }
@@ -178,6 +358,21 @@ func (UnimplementedZarbServer) GetNetworkInfo(context.Context, *NetworkInfoReque
 func (UnimplementedZarbServer) SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendRawTransaction not implemented")
 }
+func (UnimplementedZarbServer) SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (UnimplementedZarbServer) EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (UnimplementedZarbServer) SubscribeBlocks(*SubscribeBlocksRequest, Zarb_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (UnimplementedZarbServer) SubscribeTransactions(*SubscribeTransactionsRequest, Zarb_SubscribeTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTransactions not implemented")
+}
+func (UnimplementedZarbServer) SubscribePendingTransactions(*SubscribePendingTransactionsRequest, Zarb_SubscribePendingTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePendingTransactions not implemented")
+}
 
 // UnsafeZarbServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ZarbServer will
@@ -244,6 +439,24 @@ func _Zarb_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zarb_GetTransactionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZarbServer).GetTransactionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zarb.Zarb/GetTransactionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZarbServer).GetTransactionProof(ctx, req.(*TransactionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Zarb_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AccountRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +475,42 @@ func _Zarb_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zarb_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZarbServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zarb.Zarb/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZarbServer).GetAccountProof(ctx, req.(*AccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zarb_GetAccountTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZarbServer).GetAccountTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zarb.Zarb/GetAccountTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZarbServer).GetAccountTransactions(ctx, req.(*AccountTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Zarb_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidatorsRequest)
 	if err := dec(in); err != nil {
@@ -316,6 +565,24 @@ func _Zarb_GetValidatorByNumber_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zarb_GetValidatorProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZarbServer).GetValidatorProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zarb.Zarb/GetValidatorProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZarbServer).GetValidatorProof(ctx, req.(*ValidatorProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Zarb_GetBlockchainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockchainInfoRequest)
 	if err := dec(in); err != nil {
@@ -370,6 +637,105 @@ func _Zarb_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zarb_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZarbServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zarb.Zarb/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZarbServer).SimulateTransaction(ctx, req.(*SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zarb_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZarbServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zarb.Zarb/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZarbServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zarb_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZarbServer).SubscribeBlocks(m, &zarbSubscribeBlocksServer{stream})
+}
+
+type Zarb_SubscribeBlocksServer interface {
+	Send(*SubscribeBlocksResponse) error
+	grpc.ServerStream
+}
+
+type zarbSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *zarbSubscribeBlocksServer) Send(m *SubscribeBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Zarb_SubscribeTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZarbServer).SubscribeTransactions(m, &zarbSubscribeTransactionsServer{stream})
+}
+
+type Zarb_SubscribeTransactionsServer interface {
+	Send(*SubscribeTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type zarbSubscribeTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *zarbSubscribeTransactionsServer) Send(m *SubscribeTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Zarb_SubscribePendingTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePendingTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZarbServer).SubscribePendingTransactions(m, &zarbSubscribePendingTransactionsServer{stream})
+}
+
+type Zarb_SubscribePendingTransactionsServer interface {
+	Send(*SubscribePendingTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type zarbSubscribePendingTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *zarbSubscribePendingTransactionsServer) Send(m *SubscribePendingTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Zarb_ServiceDesc is the grpc.ServiceDesc for Zarb service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -389,10 +755,22 @@ var Zarb_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransaction",
 			Handler:    _Zarb_GetTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionProof",
+			Handler:    _Zarb_GetTransactionProof_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _Zarb_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _Zarb_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetAccountTransactions",
+			Handler:    _Zarb_GetAccountTransactions_Handler,
+		},
 		{
 			MethodName: "GetValidators",
 			Handler:    _Zarb_GetValidators_Handler,
@@ -405,6 +783,10 @@ var Zarb_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorByNumber",
 			Handler:    _Zarb_GetValidatorByNumber_Handler,
 		},
+		{
+			MethodName: "GetValidatorProof",
+			Handler:    _Zarb_GetValidatorProof_Handler,
+		},
 		{
 			MethodName: "GetBlockchainInfo",
 			Handler:    _Zarb_GetBlockchainInfo_Handler,
@@ -417,7 +799,31 @@ var Zarb_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendRawTransaction",
 			Handler:    _Zarb_SendRawTransaction_Handler,
 		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _Zarb_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Zarb_EstimateFee_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _Zarb_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTransactions",
+			Handler:       _Zarb_SubscribeTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePendingTransactions",
+			Handler:       _Zarb_SubscribePendingTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "zarb.proto",
 }
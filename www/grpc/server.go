@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/sync"
@@ -14,9 +15,10 @@ import (
 type zarbServer struct {
 	zarb.UnimplementedZarbServer
 
-	state  state.Facade
-	sync   sync.Synchronizer
-	logger *logger.Logger
+	state    state.Facade
+	sync     sync.Synchronizer
+	eventBus *event.Bus
+	logger   *logger.Logger
 }
 
 type Server struct {
@@ -26,17 +28,19 @@ type Server struct {
 	grpc     *grpc.Server
 	state    state.Facade
 	sync     sync.Synchronizer
+	eventBus *event.Bus
 	logger   *logger.Logger
 }
 
-func NewServer(conf *Config, state state.Facade, sync sync.Synchronizer) (*Server, error) {
+func NewServer(conf *Config, state state.Facade, sync sync.Synchronizer, eventBus *event.Bus) (*Server, error) {
 
 	return &Server{
-		ctx:    context.Background(),
-		config: conf,
-		state:  state,
-		sync:   sync,
-		logger: logger.NewLogger("_grpc", nil),
+		ctx:      context.Background(),
+		config:   conf,
+		state:    state,
+		sync:     sync,
+		eventBus: eventBus,
+		logger:   logger.NewLogger("_grpc", nil),
 	}, nil
 }
 
@@ -47,9 +51,10 @@ func (s *Server) StartServer() error {
 
 	grpc := grpc.NewServer()
 	server := &zarbServer{
-		state:  s.state,
-		sync:   s.sync,
-		logger: s.logger,
+		state:    s.state,
+		sync:     s.sync,
+		eventBus: s.eventBus,
+		logger:   s.logger,
 	}
 	zarb.RegisterZarbServer(grpc, server)
 
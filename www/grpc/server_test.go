@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/zarbchain/zarb-go/committee"
+	"github.com/zarbchain/zarb-go/event"
 	"github.com/zarbchain/zarb-go/logger"
 	"github.com/zarbchain/zarb-go/state"
 	"github.com/zarbchain/zarb-go/sync"
@@ -17,6 +18,7 @@ import (
 
 var tMockState *state.MockState
 var tMockSync *sync.MockSync
+var tEventBus *event.Bus
 var tListener *bufconn.Listener
 var tCtx context.Context
 
@@ -29,13 +31,15 @@ func init() {
 	tListener = bufconn.Listen(bufSize)
 	tMockState = state.MockingState(committee)
 	tMockSync = sync.MockingSync()
+	tEventBus = event.NewBus()
 	tCtx = context.Background()
 
 	s := grpc.NewServer()
 	server := &zarbServer{
-		state:  tMockState,
-		sync:   tMockSync,
-		logger: logger.NewLogger("_grpc", nil),
+		state:    tMockState,
+		sync:     tMockSync,
+		eventBus: tEventBus,
+		logger:   logger.NewLogger("_grpc", nil),
 	}
 	zarb.RegisterZarbServer(s, server)
 	go func() {
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/event"
+	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (zs *zarbServer) SubscribeBlocks(request *zarb.SubscribeBlocksRequest, stream zarb.Zarb_SubscribeBlocksServer) error {
+	return zs.streamCommittedBlocks(stream.Context(), int(request.FromHeight), func(height int, b *block.Block) error {
+		return stream.Send(&zarb.SubscribeBlocksResponse{
+			Height: int64(height),
+			Block:  zs.encodeBlock(b, request.Verbosity),
+		})
+	})
+}
+
+func (zs *zarbServer) SubscribeTransactions(request *zarb.SubscribeTransactionsRequest, stream zarb.Zarb_SubscribeTransactionsServer) error {
+	return zs.streamCommittedBlocks(stream.Context(), int(request.FromHeight), func(height int, b *block.Block) error {
+		for _, id := range b.TxIDs().IDs() {
+			trx := zs.state.Transaction(id)
+			if trx == nil {
+				return status.Errorf(codes.NotFound, "Transaction not found")
+			}
+			err := stream.Send(&zarb.SubscribeTransactionsResponse{
+				Height:      int64(height),
+				Transaction: zs.encodeTransaction(trx),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (zs *zarbServer) SubscribePendingTransactions(request *zarb.SubscribePendingTransactionsRequest, stream zarb.Zarb_SubscribePendingTransactionsServer) error {
+	sub := zs.eventBus.Subscribe(event.TypeTransactionAdded)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			trx := e.(*event.TransactionAddedEvent).Transaction
+			err := stream.Send(&zarb.SubscribePendingTransactionsResponse{
+				Transaction: zs.encodeTransaction(trx),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamCommittedBlocks passes the committed blocks, starting from the given height, to the send function.
+// Then it waits for new blocks until the stream is closed.
+// If the height is not set, only the new blocks are passed.
+func (zs *zarbServer) streamCommittedBlocks(ctx context.Context, from int, send func(height int, b *block.Block) error) error {
+	// Subscribing before reading the last block height, so we don't miss any block
+	sub := zs.eventBus.Subscribe(event.TypeBlockCommitted)
+	defer sub.Unsubscribe()
+
+	next := from
+	if next <= 0 {
+		next = zs.state.LastBlockHeight() + 1
+	}
+
+	for {
+		// Blocks are read from the state, so a dropped event won't make any gap
+		for ; next <= zs.state.LastBlockHeight(); next++ {
+			b := zs.state.Block(next)
+			if b == nil {
+				return status.Errorf(codes.NotFound, "Block %v not found", next)
+			}
+			if err := send(next, b); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case _, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
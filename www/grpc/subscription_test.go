@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarbchain/zarb-go/block"
+	"github.com/zarbchain/zarb-go/event"
+	"github.com/zarbchain/zarb-go/tx"
+	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
+)
+
+func TestSubscribeBlocks(t *testing.T) {
+	conn, client := callServer(t)
+
+	h := tMockState.LastBlockHeight()
+	b1, trxs1 := block.GenerateTestBlock(nil, nil)
+	b2, trxs2 := block.GenerateTestBlock(nil, nil)
+	tMockState.AddBlock(h+1, b1, trxs1)
+	tMockState.AddBlock(h+2, b2, trxs2)
+
+	t.Run("Should resume from the given height", func(t *testing.T) {
+		stream, err := client.SubscribeBlocks(tCtx, &zarb.SubscribeBlocksRequest{
+			FromHeight: int64(h + 1),
+			Verbosity:  zarb.BlockVerbosity_BLOCK_INFO,
+		})
+		require.NoError(t, err)
+
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, res.Height, int64(h+1))
+		assert.Equal(t, res.Block.Hash, b1.Hash().String())
+		assert.NotEmpty(t, res.Block.Header)
+
+		res, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, res.Height, int64(h+2))
+		assert.Equal(t, res.Block.Hash, b2.Hash().String())
+
+		b3, trxs3 := block.GenerateTestBlock(nil, nil)
+		tMockState.AddBlock(h+3, b3, trxs3)
+		tEventBus.Publish(&event.BlockCommittedEvent{Height: h + 3, Block: b3})
+
+		res, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, res.Height, int64(h+3))
+		assert.Equal(t, res.Block.Hash, b3.Hash().String())
+	})
+
+	t.Run("Should return an error for missing blocks", func(t *testing.T) {
+		// Pruned block
+		tMockState.Store.Blocks[h+1] = nil
+
+		stream, err := client.SubscribeBlocks(tCtx, &zarb.SubscribeBlocksRequest{FromHeight: int64(h + 1)})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Error(t, err)
+		tMockState.Store.Blocks[h+1] = b1
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestSubscribeTransactions(t *testing.T) {
+	conn, client := callServer(t)
+
+	h := tMockState.LastBlockHeight()
+	b1, trxs1 := block.GenerateTestBlock(nil, nil)
+	tMockState.AddBlock(h+1, b1, trxs1)
+
+	stream, err := client.SubscribeTransactions(tCtx, &zarb.SubscribeTransactionsRequest{
+		FromHeight: int64(h + 1),
+	})
+	require.NoError(t, err)
+
+	for _, trx := range trxs1 {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, res.Height, int64(h+1))
+		assert.Equal(t, res.Transaction.Id, trx.ID().String())
+	}
+
+	b2, trxs2 := block.GenerateTestBlock(nil, nil)
+	tMockState.AddBlock(h+2, b2, trxs2)
+	tEventBus.Publish(&event.BlockCommittedEvent{Height: h + 2, Block: b2})
+
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, res.Height, int64(h+2))
+	assert.Equal(t, res.Transaction.Id, trxs2[0].ID().String())
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
+
+func TestSubscribePendingTransactions(t *testing.T) {
+	conn, client := callServer(t)
+
+	stream, err := client.SubscribePendingTransactions(tCtx, &zarb.SubscribePendingTransactionsRequest{})
+	require.NoError(t, err)
+
+	// Wait for the server to subscribe
+	time.Sleep(100 * time.Millisecond)
+
+	trx1, _ := tx.GenerateTestSendTx()
+	trx2, _ := tx.GenerateTestBondTx()
+	tEventBus.Publish(&event.BlockCommittedEvent{})
+	tEventBus.Publish(&event.TransactionAddedEvent{Transaction: trx1})
+	tEventBus.Publish(&event.TransactionAddedEvent{Transaction: trx2})
+
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, res.Transaction.Id, trx1.ID().String())
+
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, res.Transaction.Id, trx2.ID().String())
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/tx/payload"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
@@ -29,6 +30,43 @@ func (zs *zarbServer) GetTransaction(ctx context.Context, request *zarb.Transact
 
 }
 
+func (zs *zarbServer) GetTransactionProof(ctx context.Context, request *zarb.TransactionProofRequest) (*zarb.TransactionProofResponse, error) {
+	id, err := hash.FromString(request.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid transaction ID: %v", err.Error())
+	}
+	trx := zs.state.Transaction(id)
+	if trx == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Transaction not found")
+	}
+	height := zs.state.TransactionBlockHeight(id)
+	b := zs.state.Block(height)
+	if b == nil {
+		return nil, status.Errorf(codes.NotFound, "Block not found")
+	}
+	proof := b.TxIDs().Proof(id)
+	if proof == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create proof")
+	}
+	data, err := trx.Encode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	header, err := b.Header().MarshalCBOR()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &zarb.TransactionProofResponse{
+		Transaction: zs.encodeTransaction(trx),
+		Data:        data,
+		Proof:       merkleProofToProto(proof),
+		BlockHash:   b.Hash().String(),
+		Height:      int64(height),
+		Header:      header,
+	}, nil
+}
+
 func (zs *zarbServer) SendRawTransaction(ctx context.Context, request *zarb.SendRawTransactionRequest) (*zarb.SendRawTransactionResponse, error) {
 	var tx tx.Tx
 
@@ -57,28 +95,88 @@ func (zs *zarbServer) SendRawTransaction(ctx context.Context, request *zarb.Send
 	}, nil
 }
 
+func (zs *zarbServer) SimulateTransaction(ctx context.Context, request *zarb.SimulateTransactionRequest) (*zarb.SimulateTransactionResponse, error) {
+	var trx tx.Tx
+
+	hexDecoded, err := hex.DecodeString(request.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Couldn't decode transaction: %s", err.Error())
+	}
+	if err := trx.Decode(hexDecoded); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Couldn't decode transaction: %s", err.Error())
+	}
+
+	res := &zarb.SimulateTransactionResponse{
+		Id: trx.ID().String(),
+	}
+	sim, err := zs.state.SimulateTx(&trx)
+	if err != nil {
+		res.ErrorCode = int32(errors.Code(err))
+		res.Error = err.Error()
+		return res, nil
+	}
+	res.Fee = sim.Fee
+	for _, acc := range sim.Accounts {
+		res.Accounts = append(res.Accounts, zs.encodeAccount(acc))
+	}
+	for _, val := range sim.Validators {
+		res.Validators = append(res.Validators, validatorToProto(val))
+	}
+
+	return res, nil
+}
+
+func (zs *zarbServer) EstimateFee(ctx context.Context, request *zarb.EstimateFeeRequest) (*zarb.EstimateFeeResponse, error) {
+	if _, ok := zarb.PayloadType_name[int32(request.PayloadType)]; !ok || request.PayloadType == zarb.PayloadType_UNKNOWN {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid payload type: %v", request.PayloadType)
+	}
+	if request.Amount < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid amount: %v", request.Amount)
+	}
+
+	est := zs.state.EstimateFee(request.Amount, payload.Type(request.PayloadType))
+	return &zarb.EstimateFeeResponse{
+		MinimumFee:     est.MinimumFee,
+		RecommendedFee: est.RecommendedFee,
+		PoolOccupancy:  est.PoolOccupancy,
+		BlockFullness:  est.BlockFullness,
+	}, nil
+}
+
 func (zs *zarbServer) encodeTransaction(trx *tx.Tx) *zarb.TransactionInfo {
 	transaction := &zarb.TransactionInfo{
-		Id:        trx.ID().String(),
-		Version:   int32(trx.Version()),
-		Stamp:     trx.Stamp().String(),
-		Sequence:  int64(trx.Sequence()),
-		Fee:       trx.Fee(),
-		Type:      zarb.PayloadType(trx.PayloadType()),
-		Memo:      trx.Memo(),
-		PublicKey: trx.PublicKey().String(),
-		Signature: trx.Signature().String(),
+		Id:       trx.ID().String(),
+		Version:  int32(trx.Version()),
+		Stamp:    trx.Stamp().String(),
+		Sequence: int64(trx.Sequence()),
+		Fee:      trx.Fee(),
+		Type:     zarb.PayloadType(trx.PayloadType()),
+		Memo:     trx.Memo(),
+	}
+	if trx.PublicKey() != nil {
+		transaction.PublicKey = trx.PublicKey().String()
+	}
+	if trx.Signature() != nil {
+		transaction.Signature = trx.Signature().String()
 	}
 
 	switch trx.PayloadType() {
 	case payload.PayloadTypeSend:
 		pld := trx.Payload().(*payload.SendPayload)
+		send := &zarb.SEND_PAYLOAD{
+			Sender:   pld.Sender.String(),
+			Receiver: pld.Receiver.String(),
+			Amount:   pld.Amount,
+		}
+		if pld.Vesting != nil {
+			send.Vesting = &zarb.VESTING_SCHEDULE{
+				StartHeight: int64(pld.Vesting.StartHeight),
+				CliffHeight: int64(pld.Vesting.CliffHeight),
+				EndHeight:   int64(pld.Vesting.EndHeight),
+			}
+		}
 		transaction.Payload = &zarb.TransactionInfo_Send{
-			Send: &zarb.SEND_PAYLOAD{
-				Sender:   pld.Sender.String(),
-				Receiver: pld.Receiver.String(),
-				Amount:   pld.Amount,
-			},
+			Send: send,
 		}
 	case payload.PayloadTypeBond:
 		pld := trx.Payload().(*payload.BondPayload)
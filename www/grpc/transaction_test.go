@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto/hash"
+	"github.com/zarbchain/zarb-go/errors"
 	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/tx/payload"
+	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 )
 
@@ -49,6 +53,47 @@ func TestGetTransaction(t *testing.T) {
 
 }
 
+func TestGetTransactionProof(t *testing.T) {
+	conn, client := callServer(t)
+	b, txs := block.GenerateTestBlock(nil, nil)
+
+	t.Run("Should return error for invalid transaction id", func(t *testing.T) {
+		res, err := client.GetTransactionProof(tCtx, &zarb.TransactionProofRequest{Id: "invalid_id"})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for non existing transaction", func(t *testing.T) {
+		res, err := client.GetTransactionProof(tCtx, &zarb.TransactionProofRequest{Id: txs[0].ID().String()})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	height := tMockState.LastBlockHeight() + 1
+	tMockState.Store.SaveBlock(height, b)
+	for _, trx := range txs {
+		tMockState.Store.SaveTransaction(trx)
+	}
+
+	t.Run("Should return transaction proof", func(t *testing.T) {
+		for _, trx := range txs {
+			res, err := client.GetTransactionProof(tCtx, &zarb.TransactionProofRequest{Id: trx.ID().String()})
+			assert.NoError(t, err)
+			assert.Equal(t, res.Transaction.Id, trx.ID().String())
+			assert.Equal(t, res.BlockHash, b.Hash().String())
+			assert.Equal(t, int(res.Height), height)
+
+			trx2, err := grpcclient.VerifyTransactionProof(res, b.Hash())
+			assert.NoError(t, err)
+			assert.Equal(t, trx.ID(), trx2.ID())
+
+			_, err = grpcclient.VerifyTransactionProof(res, hash.GenerateTestHash())
+			assert.Error(t, err)
+		}
+	})
+	conn.Close()
+}
+
 func TestSendRawTransaction(t *testing.T) {
 	conn, client := callServer(t)
 
@@ -85,3 +130,73 @@ func TestSendRawTransaction(t *testing.T) {
 	})
 	assert.NoError(t, conn.Close())
 }
+
+func TestSimulateTransaction(t *testing.T) {
+	conn, client := callServer(t)
+
+	t.Run("Should fail, Non decodable hex", func(t *testing.T) {
+		res, err := client.SimulateTransaction(tCtx, &zarb.SimulateTransactionRequest{Data: "None Decodable hex"})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error code, Non verifable trx", func(t *testing.T) {
+		trx, _ := tx.GenerateTestSendTx()
+		_, signer := tx.GenerateTestSendTx()
+		trx.SetSignature(signer.SignData(trx.SignBytes()))
+		data, _ := trx.Encode()
+		res, err := client.SimulateTransaction(tCtx, &zarb.SimulateTransactionRequest{Data: hex.EncodeToString(data)})
+		assert.NoError(t, err)
+		assert.Equal(t, res.Id, trx.ID().String())
+		assert.Equal(t, int(res.ErrorCode), errors.ErrInvalidTx)
+		assert.NotEmpty(t, res.Error)
+	})
+	t.Run("Should pass", func(t *testing.T) {
+		trx, signer := tx.GenerateTestSendTx()
+		acc := account.NewAccount(signer.Address(), 0)
+		acc.AddToBalance(10000)
+		tMockState.Store.UpdateAccount(acc)
+		data, _ := trx.Encode()
+		res, err := client.SimulateTransaction(tCtx, &zarb.SimulateTransactionRequest{Data: hex.EncodeToString(data)})
+		assert.NoError(t, err)
+		assert.Zero(t, res.ErrorCode)
+		assert.Equal(t, res.Fee, trx.Fee())
+		assert.Len(t, res.Accounts, 1)
+		assert.Equal(t, res.Accounts[0].Address, signer.Address().String())
+
+		// Simulated transaction should not be added to the pool
+		assert.False(t, tMockState.TxPool.HasTx(trx.ID()))
+	})
+	assert.NoError(t, conn.Close())
+}
+
+func TestEstimateFee(t *testing.T) {
+	conn, client := callServer(t)
+
+	t.Run("Should fail, invalid payload type", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &zarb.EstimateFeeRequest{Amount: 1000, PayloadType: zarb.PayloadType_UNKNOWN})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+
+		res, err = client.EstimateFee(tCtx, &zarb.EstimateFeeRequest{Amount: 1000, PayloadType: 100})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should fail, invalid amount", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &zarb.EstimateFeeRequest{Amount: -1, PayloadType: zarb.PayloadType_SEND_PAYLOAD})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return fee", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &zarb.EstimateFeeRequest{Amount: 1e8, PayloadType: zarb.PayloadType_SEND_PAYLOAD})
+		assert.NoError(t, err)
+		assert.Equal(t, res.MinimumFee, int64(1e5))
+		assert.GreaterOrEqual(t, res.RecommendedFee, res.MinimumFee)
+	})
+	t.Run("Should return zero for free transactions", func(t *testing.T) {
+		res, err := client.EstimateFee(tCtx, &zarb.EstimateFeeRequest{Amount: 1e8, PayloadType: zarb.PayloadType_UNBOND_PAYLOAD})
+		assert.NoError(t, err)
+		assert.Zero(t, res.MinimumFee)
+		assert.Zero(t, res.RecommendedFee)
+	})
+	assert.NoError(t, conn.Close())
+}
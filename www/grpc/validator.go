@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zarbchain/zarb-go/crypto"
+	"github.com/zarbchain/zarb-go/validator"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,19 +16,8 @@ func (zs *zarbServer) GetValidatorByNumber(ctx context.Context, request *zarb.Va
 		return nil, status.Errorf(codes.NotFound, "NotFound Validator Address")
 	}
 
-	// TODO: make a function
-	// proto validator from native validator
 	return &zarb.ValidatorResponse{
-		Validator: &zarb.ValidatorInfo{
-			PublicKey:         validator.PublicKey().String(),
-			Address:           validator.Address().String(),
-			Number:            int32(validator.Number()),
-			Sequence:          int64(validator.Sequence()),
-			Stake:             validator.Stake(),
-			LastBondingHeight: int32(validator.LastBondingHeight()),
-			LastJoinedHeight:  int32(validator.LastJoinedHeight()),
-			UnbondingHeight:   int32(validator.UnbondingHeight()),
-		},
+		Validator: validatorToProto(validator),
 	}, nil
 }
 
@@ -41,37 +31,54 @@ func (zs *zarbServer) GetValidator(ctx context.Context, request *zarb.ValidatorR
 		return nil, status.Errorf(codes.NotFound, "NotFound Validator Address")
 	}
 
-	// TODO: make a function
-	// proto validator from native validator
 	return &zarb.ValidatorResponse{
-		Validator: &zarb.ValidatorInfo{
-			PublicKey:         validator.PublicKey().String(),
-			Address:           validator.Address().String(),
-			Number:            int32(validator.Number()),
-			Sequence:          int64(validator.Sequence()),
-			Stake:             validator.Stake(),
-			LastBondingHeight: int32(validator.LastBondingHeight()),
-			LastJoinedHeight:  int32(validator.LastJoinedHeight()),
-			UnbondingHeight:   int32(validator.UnbondingHeight()),
-		},
+		Validator: validatorToProto(validator),
 	}, nil
 }
+func (zs *zarbServer) GetValidatorProof(ctx context.Context, request *zarb.ValidatorProofRequest) (*zarb.ValidatorProofResponse, error) {
+	addr, err := crypto.AddressFromString(request.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid Validator Address: %s", err.Error())
+	}
+	validator, proof, height := zs.state.ValidatorProof(addr)
+	if validator == nil {
+		return nil, status.Errorf(codes.NotFound, "NotFound Validator Address")
+	}
+	if proof == nil {
+		return nil, status.Errorf(codes.Internal, "Unable to create proof")
+	}
+	data, err := validator.Encode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err.Error())
+	}
+
+	return &zarb.ValidatorProofResponse{
+		Validator: validatorToProto(validator),
+		Data:      data,
+		Proof:     merkleProofToProto(proof),
+		StateHash: proof.Root(validator.Hash()).String(),
+		Height:    int64(height),
+	}, nil
+}
+
 func (zs *zarbServer) GetValidators(ctx context.Context, request *zarb.ValidatorsRequest) (*zarb.ValidatorsResponse, error) {
 	validators := zs.state.CommitteeValidators()
 	validatorsResp := make([]*zarb.ValidatorInfo, 0)
 	for _, v := range validators {
-		// TODO: make a function
-		// proto validator from native validator
-		validatorsResp = append(validatorsResp, &zarb.ValidatorInfo{
-			PublicKey:         v.PublicKey().String(),
-			Address:           v.Address().String(),
-			Number:            int32(v.Number()),
-			Sequence:          int64(v.Sequence()),
-			Stake:             v.Stake(),
-			LastBondingHeight: int32(v.LastBondingHeight()),
-			LastJoinedHeight:  int32(v.LastJoinedHeight()),
-			UnbondingHeight:   int32(v.UnbondingHeight()),
-		})
+		validatorsResp = append(validatorsResp, validatorToProto(v))
 	}
 	return &zarb.ValidatorsResponse{Validators: validatorsResp}, nil
 }
+
+func validatorToProto(val *validator.Validator) *zarb.ValidatorInfo {
+	return &zarb.ValidatorInfo{
+		PublicKey:         val.PublicKey().String(),
+		Address:           val.Address().String(),
+		Number:            int32(val.Number()),
+		Sequence:          int64(val.Sequence()),
+		Stake:             val.Stake(),
+		LastBondingHeight: int32(val.LastBondingHeight()),
+		LastJoinedHeight:  int32(val.LastJoinedHeight()),
+		UnbondingHeight:   int32(val.UnbondingHeight()),
+	}
+}
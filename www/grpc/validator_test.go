@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/zarbchain/zarb-go/crypto/bls"
+	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/validator"
+	grpcclient "github.com/zarbchain/zarb-go/www/grpc/client"
 	zarb "github.com/zarbchain/zarb-go/www/grpc/proto"
 )
 
@@ -105,3 +107,47 @@ func TestGetValidators(t *testing.T) {
 	err := conn.Close()
 	assert.Nil(t, err, "Error closing connection")
 }
+
+func TestGetValidatorProof(t *testing.T) {
+	conn, client := callServer(t)
+
+	pub1, _ := bls.GenerateTestKeyPair()
+	val1 := validator.NewValidator(pub1, 0)
+
+	t.Run("Should return error for invalid address", func(t *testing.T) {
+		res, err := client.GetValidatorProof(tCtx, &zarb.ValidatorProofRequest{Address: "Non existence address"})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("should return Not Found", func(t *testing.T) {
+		res, err := client.GetValidatorProof(tCtx, &zarb.ValidatorProofRequest{Address: pub1.Address().String()})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	tMockState.Store.UpdateValidator(val1)
+
+	t.Run("Should return validator proof", func(t *testing.T) {
+		res, err := client.GetValidatorProof(tCtx, &zarb.ValidatorProofRequest{Address: pub1.Address().String()})
+		assert.NoError(t, err)
+		assert.Equal(t, res.Validator.PublicKey, pub1.String())
+
+		stateHash, _ := hash.FromString(res.StateHash)
+		val2, err := grpcclient.VerifyValidatorProof(res, stateHash)
+		assert.NoError(t, err)
+		assert.Equal(t, val1.Hash(), val2.Hash())
+
+		_, err = grpcclient.VerifyValidatorProof(res, hash.GenerateTestHash())
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return the proof for the current state", func(t *testing.T) {
+		res, err := client.GetValidatorProof(tCtx, &zarb.ValidatorProofRequest{Address: pub1.Address().String()})
+		assert.NoError(t, err)
+		assert.Equal(t, res.Height, int64(tMockState.LastBlockHeight())+1)
+	})
+
+	err := conn.Close()
+	assert.Nil(t, err, "Error closing connection")
+}
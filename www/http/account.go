@@ -1,10 +1,13 @@
 package http
 
 import (
+	"encoding/hex"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/zarbchain/zarb-go/account"
+	"github.com/zarbchain/zarb-go/tx"
 	"github.com/zarbchain/zarb-go/www/capnp"
 )
 
@@ -32,3 +35,40 @@ func (s *Server) GetAccountHandler(w http.ResponseWriter, r *http.Request) {
 
 	s.writeJSON(w, acc)
 }
+
+// GetAccountTransactionsHandler returns a handler to get the transactions of an address, from the newest to the oldest
+func (s *Server) GetAccountTransactionsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	page, err := strconv.ParseInt(vars["page"], 10, 32)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	b := s.capnp.GetAccountTransactions(s.ctx, func(p capnp.ZarbServer_getAccountTransactions_Params) error {
+		p.SetPage(int32(page))
+		return p.SetAddress([]byte(vars["address"]))
+	})
+
+	a, err := b.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := a.Result()
+	list, _ := res.Transactions()
+	out := make([]TransactionResult, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		trxData, _ := list.At(i).Data()
+		trx := new(tx.Tx)
+		if err := trx.Decode(trxData); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		out[i].ID = trx.ID()
+		out[i].Tx = *trx
+		out[i].Data = hex.EncodeToString(trxData)
+	}
+
+	s.writeJSON(w, out)
+}
@@ -52,3 +52,38 @@ func TestAccount(t *testing.T) {
 		fmt.Println(w.Body)
 	})
 }
+
+func TestAccountTransactions(t *testing.T) {
+	setup(t)
+
+	t.Run("Shall return the transactions", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": tAccTestAddr.String(), "page": "0"})
+		tHTTPServer.GetAccountTransactionsHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Contains(t, w.Body.String(), tTxTestHash.String())
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error for invalid page", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": tAccTestAddr.String(), "page": "invalid-page"})
+		tHTTPServer.GetAccountTransactionsHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+
+	t.Run("Shall return an error for invalid address", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"address": "invalid-address", "page": "0"})
+		tHTTPServer.GetAccountTransactionsHandler(w, r)
+
+		assert.Equal(t, w.Code, 400)
+		fmt.Println(w.Body)
+	})
+}
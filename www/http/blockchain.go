@@ -65,6 +65,8 @@ func (s *Server) NetworkHandler(w http.ResponseWriter, r *http.Request) {
 		peer.InvalidBundles = int(p.InvalidMessages())
 		peer.ReceivedBundles = int(p.ReceivedMessages())
 		peer.ReceivedBytes = int(p.ReceivedBytes())
+		peer.DroppedBundles = int(p.DroppedMessages())
+		peer.Score = int(p.Score())
 		peer.Flags = int(p.Flags())
 
 		out.Peers[i] = peer
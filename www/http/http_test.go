@@ -51,6 +51,7 @@ func setup(t *testing.T) {
 	a, _ := account.GenerateTestAccount(888)
 	tAccTestAddr = a.Address()
 	tMockState.Store.UpdateAccount(a)
+	tMockState.Store.SaveAddressTransaction(tAccTestAddr, 1, tTxTestHash)
 
 	v, _ := validator.GenerateTestValidator(88)
 	tValTestAddr = v.Address()
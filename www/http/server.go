@@ -53,8 +53,10 @@ func (s *Server) StartServer(capnpServer string) error {
 	s.router.HandleFunc("/block_height/hash/{hash}", s.GetBlockHeightHandler)
 	s.router.HandleFunc("/transaction/id/{hash}", s.GetTransactionHandler)
 	s.router.HandleFunc("/account/address/{address}", s.GetAccountHandler)
+	s.router.HandleFunc("/account/transactions/{address}/page/{page}", s.GetAccountTransactionsHandler)
 	s.router.HandleFunc("/validator/address/{address}", s.GetValidatorHandler)
 	s.router.HandleFunc("/send_raw_transaction/{data}", s.SendRawTransactionHandler)
+	s.router.HandleFunc("/simulate_transaction/{data}", s.SimulateTransactionHandler)
 	s.router.HandleFunc("/network", s.NetworkHandler)
 	http.Handle("/", handlers.RecoveryHandler()(s.router))
 
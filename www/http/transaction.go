@@ -6,8 +6,10 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
 	"github.com/zarbchain/zarb-go/www/capnp"
 )
 
@@ -74,3 +76,62 @@ func (s *Server) SendRawTransactionHandler(w http.ResponseWriter, r *http.Reques
 
 	s.writeJSON(w, out)
 }
+
+// SimulateTransactionHandler returns a handler to execute a raw transaction against the current state,
+// without committing or broadcasting it
+func (s *Server) SimulateTransactionHandler(w http.ResponseWriter, r *http.Request) {
+	simRes := s.capnp.SimulateTransaction(s.ctx, func(p capnp.ZarbServer_simulateTransaction_Params) error {
+		vars := mux.Vars(r)
+		d, err := hex.DecodeString(vars["data"])
+		if err != nil {
+			return err
+		}
+		return p.SetRawTx(d)
+	})
+
+	st, err := simRes.Struct()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	res, _ := st.Result()
+
+	out := new(SimulateTransactionResult)
+	txID, err := res.Id()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	out.ID, err = hash.FromRawBytes(txID)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	out.ErrorCode = int(res.ErrorCode())
+	out.Error, _ = res.Error()
+	out.Fee = res.Fee()
+
+	accs, _ := res.Accounts()
+	for i := 0; i < accs.Len(); i++ {
+		d, _ := accs.At(i)
+		acc := new(account.Account)
+		if err := acc.Decode(d); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		out.Accounts = append(out.Accounts, acc)
+	}
+	vals, _ := res.Validators()
+	for i := 0; i < vals.Len(); i++ {
+		d, _ := vals.At(i)
+		val := new(validator.Validator)
+		if err := val.Decode(d); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		out.Validators = append(out.Validators, val)
+	}
+
+	s.writeJSON(w, out)
+}
@@ -83,3 +83,40 @@ func TestSendTransaction(t *testing.T) {
 	})
 
 }
+
+func TestSimulateTransaction(t *testing.T) {
+	setup(t)
+
+	t.Run("Simulate valid transaction", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		trx, _ := tx.GenerateTestSendTx()
+		data, _ := trx.Encode()
+		r = mux.SetURLVars(r, map[string]string{"data": hex.EncodeToString(data)})
+		tHTTPServer.SimulateTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.Contains(t, w.Body.String(), `"ErrorCode": 0`)
+	})
+
+	t.Run("Simulate invalid transaction", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		trx, _ := tx.GenerateTestSendTx()
+		trx.SetSignature(nil)
+		data, _ := trx.Encode()
+		r = mux.SetURLVars(r, map[string]string{"data": hex.EncodeToString(data)})
+		tHTTPServer.SimulateTransactionHandler(w, r)
+
+		assert.Equal(t, w.Code, 200)
+		assert.NotContains(t, w.Body.String(), `"ErrorCode": 0`)
+	})
+
+	t.Run("Simulate invalid input data", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := new(http.Request)
+		r = mux.SetURLVars(r, map[string]string{"data": "invalid data"})
+		tHTTPServer.SimulateTransactionHandler(w, r)
+		assert.Equal(t, w.Code, 400)
+	})
+}
@@ -4,10 +4,12 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/zarbchain/zarb-go/account"
 	"github.com/zarbchain/zarb-go/block"
 	"github.com/zarbchain/zarb-go/crypto/hash"
 	"github.com/zarbchain/zarb-go/sync/peerset"
 	"github.com/zarbchain/zarb-go/tx"
+	"github.com/zarbchain/zarb-go/validator"
 )
 
 type BlockchainResult struct {
@@ -31,6 +33,15 @@ type SendTranscationResult struct {
 	ID     hash.Hash
 }
 
+type SimulateTransactionResult struct {
+	ID         hash.Hash
+	ErrorCode  int
+	Error      string
+	Fee        int64
+	Accounts   []*account.Account
+	Validators []*validator.Validator
+}
+
 type NetworkResult struct {
 	SelfID peer.ID
 	Peers  []*peerset.Peer